@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"flag"
@@ -10,6 +11,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/auth"
@@ -17,6 +19,8 @@ import (
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/logic"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/openapi"
 	repositoryPostgres "github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/repository/postgres"
+	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/requestid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/pressly/goose/v3"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -48,7 +52,7 @@ func run() error {
 		return fmt.Errorf("init logger: %w", err)
 	}
 
-	db, err := gorm.Open(postgres.Open(cfg.Postgres.toDSN()))
+	db, err := gorm.Open(postgres.Open(cfg.Postgres.toDSN()), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return fmt.Errorf("open postgres connection: %w", err)
 	}
@@ -69,13 +73,29 @@ func run() error {
 	}
 
 	repo := repositoryPostgres.New(db)
-	logic := logic.New(repo)
+
+	var relay *outbox.Relay
+	if cfg.Outbox.Interval > 0 {
+		eventsProducer, err := outbox.NewProducer(cfg.Kafka.Brokers, cfg.Kafka.DomainEventsTopic, logger)
+		if err != nil {
+			return fmt.Errorf("init domain events producer: %w", err)
+		}
+		defer eventsProducer.Stop()
+
+		relay = outbox.NewRelay(outbox.NewPostgresStore(db, outboxLockKey), eventsProducer, cfg.Outbox.BatchSize, logger)
+	}
+
+	logic := logic.New(repo, cfg.Currency)
 
 	e := echo.New()
-	e.Use(auth.CreateMiddleware(cfg.JWKsURL, cfg.ServicePassword))
+	e.Use(requestid.CreateMiddleware())
+	e.Use(auth.CreateMiddleware(cfg.JWKsURL, cfg.ServicePassword, cfg.AdminRole))
 	server := openapi.New(logic)
 	openapiGenerated.RegisterHandlers(e, server)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
@@ -83,6 +103,8 @@ func run() error {
 
 		logger.Info("shutting down")
 
+		cancel()
+
 		rawDB, err := db.DB()
 		if err == nil {
 			rawDB.Close()
@@ -101,6 +123,15 @@ func run() error {
 		return nil
 	})
 
+	if relay != nil {
+		g.Go(func() error {
+			relay.Run(ctx, cfg.Outbox.Interval)
+			return nil
+		})
+	} else {
+		logger.Warn("outbox relay is disabled")
+	}
+
 	if err := g.Wait(); err != nil {
 		return fmt.Errorf("errgroup: %w", err)
 	}
@@ -178,4 +209,24 @@ type config struct {
 	LogLevel        string
 	JWKsURL         string
 	ServicePassword string
+	AdminRole       string
+	// Currency of car prices when it is not set in the request.
+	Currency string
+	Kafka    kafka
+	Outbox   outboxRelay
+}
+
+type kafka struct {
+	Brokers           []string
+	DomainEventsTopic string
+}
+
+// outboxLockKey is the key of the advisory lock which lets one replica publish the outbox at a time,
+// keys of the services differ in case they share a database.
+const outboxLockKey int64 = 7_265_101
+
+// outboxRelay publishes domain events, the relay is disabled when the interval is zero.
+type outboxRelay struct {
+	Interval  time.Duration
+	BatchSize int
 }
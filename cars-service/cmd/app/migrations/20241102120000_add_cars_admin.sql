@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cars
+    ADD COLUMN archived BOOLEAN NOT NULL DEFAULT false;
+
+CREATE UNIQUE INDEX cars_registration_number_idx ON cars (registration_number);
+
+SELECT setval('cars_id_seq', COALESCE((SELECT MAX(id) FROM cars), 0) + 1, false);
+
+CREATE TABLE car_changes
+(
+    id         SERIAL PRIMARY KEY,
+    car_uid    uuid                     NOT NULL REFERENCES cars (car_uid),
+    action     VARCHAR(20)              NOT NULL
+        CHECK (action IN ('CREATE', 'UPDATE', 'ARCHIVE', 'RESTORE')),
+    username   VARCHAR(80)              NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS car_changes;
+
+DROP INDEX IF EXISTS cars_registration_number_idx;
+
+ALTER TABLE cars
+    DROP COLUMN archived;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Prices are stored in minor units of the currency, e.g. kopecks.
+UPDATE cars
+SET price = price * 100;
+
+ALTER TABLE cars
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cars
+    DROP COLUMN currency;
+
+UPDATE cars
+SET price = price / 100;
+-- +goose StatementEnd
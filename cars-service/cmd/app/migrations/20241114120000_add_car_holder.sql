@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Cars booked before the holder was stored keep the empty one.
+ALTER TABLE cars
+    ADD COLUMN booked_by VARCHAR(80) NOT NULL DEFAULT '',
+    ADD COLUMN booked_at TIMESTAMP WITH TIME ZONE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cars
+    DROP COLUMN booked_by,
+    DROP COLUMN booked_at;
+-- +goose StatementEnd
@@ -11,3 +11,14 @@ func GetUsername(ctx context.Context) string {
 	value, _ := ctx.Value(usernameKey).(string)
 	return value
 }
+
+func GetRoles(ctx context.Context) []string {
+	value, _ := ctx.Value(rolesKey).([]string)
+	return value
+}
+
+// IsPrivileged tells whether the request is made by another service with the service password or by an admin.
+func IsPrivileged(ctx context.Context) bool {
+	value, _ := ctx.Value(privilegedKey).(bool)
+	return value
+}
@@ -12,16 +12,98 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CarImportRowStatus.
+const (
+	CREATED  CarImportRowStatus = "CREATED"
+	REJECTED CarImportRowStatus = "REJECTED"
+	UPDATED  CarImportRowStatus = "UPDATED"
+)
+
+// Defines values for CarRequestType.
+const (
+	CarRequestTypeMINIVAN  CarRequestType = "MINIVAN"
+	CarRequestTypeROADSTER CarRequestType = "ROADSTER"
+	CarRequestTypeSEDAN    CarRequestType = "SEDAN"
+	CarRequestTypeSUV      CarRequestType = "SUV"
+)
+
 // Defines values for CarResponseType.
 const (
-	MINIVAN  CarResponseType = "MINIVAN"
-	ROADSTER CarResponseType = "ROADSTER"
-	SEDAN    CarResponseType = "SEDAN"
-	SUV      CarResponseType = "SUV"
+	CarResponseTypeMINIVAN  CarResponseType = "MINIVAN"
+	CarResponseTypeROADSTER CarResponseType = "ROADSTER"
+	CarResponseTypeSEDAN    CarResponseType = "SEDAN"
+	CarResponseTypeSUV      CarResponseType = "SUV"
+)
+
+// Defines values for ExportParamsFormat.
+const (
+	Csv  ExportParamsFormat = "csv"
+	Json ExportParamsFormat = "json"
 )
 
+// CarImportReport defines model for CarImportReport.
+type CarImportReport struct {
+	// Created Количество добавленных автомобилей
+	Created int `json:"created"`
+
+	// Rejected Количество отклоненных строк
+	Rejected int            `json:"rejected"`
+	Rows     []CarImportRow `json:"rows"`
+
+	// Updated Количество обновленных автомобилей
+	Updated int `json:"updated"`
+}
+
+// CarImportRow defines model for CarImportRow.
+type CarImportRow struct {
+	// Errors Причины отклонения строки
+	Errors []ErrorDescription `json:"errors"`
+
+	// RegistrationNumber Регистрационный номер автомобиля
+	RegistrationNumber string `json:"registrationNumber"`
+
+	// Row Номер строки в загруженном файле, начиная с 1
+	Row int `json:"row"`
+
+	// Status Результат обработки строки
+	Status CarImportRowStatus `json:"status"`
+}
+
+// CarImportRowStatus Результат обработки строки
+type CarImportRowStatus string
+
+// CarRequest defines model for CarRequest.
+type CarRequest struct {
+	// Brand Марка автомобиля
+	Brand string `json:"brand"`
+
+	// Currency Код валюты ISO 4217, по умолчанию - валюта сервиса
+	Currency *string `json:"currency,omitempty"`
+
+	// Model Модель автомобиля
+	Model string `json:"model"`
+
+	// Power Мощность автомобиля в лошадиных силах
+	Power *int `json:"power,omitempty"`
+
+	// Price Цена автомобиля за сутки в минимальных единицах валюты (копейках)
+	Price int `json:"price"`
+
+	// RegistrationNumber Регистрационный номер автомобиля
+	RegistrationNumber string `json:"registrationNumber"`
+
+	// Type Тип автомобиля
+	Type CarRequestType `json:"type"`
+}
+
+// CarRequestType Тип автомобиля
+type CarRequestType string
+
 // CarResponse defines model for CarResponse.
 type CarResponse struct {
+	// Archived Флаг, указывающий что автомобиль убран в архив
+	Archived *bool `json:"archived,omitempty"`
+
 	// Available Флаг, указывающий что автомобиль доступен для бронирования
 	Available bool `json:"available"`
 
@@ -31,13 +113,16 @@ type CarResponse struct {
 	// CarUid UUID автомобиля
 	CarUid openapi_types.UUID `json:"carUid"`
 
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
 	// Model Модель автомобиля
 	Model string `json:"model"`
 
 	// Power Мощность автомобиля в лошадиных силах
 	Power *int `json:"power,omitempty"`
 
-	// Price Цена автомобиля за сутки
+	// Price Цена автомобиля за сутки в минимальных единицах валюты (копейках)
 	Price int `json:"price"`
 
 	// RegistrationNumber Регистрационный номер автомобиля
@@ -85,6 +170,17 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ExportParams defines parameters for Export.
+type ExportParams struct {
+	Format *ExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportParamsFormat defines parameters for Export.
+type ExportParamsFormat string
+
+// ImportJSONBody defines parameters for Import.
+type ImportJSONBody = []CarRequest
+
 // ListParams defines parameters for List.
 type ListParams struct {
 	Page    *float32 `form:"page,omitempty" json:"page,omitempty"`
@@ -92,8 +188,35 @@ type ListParams struct {
 	ShowAll *bool    `form:"showAll,omitempty" json:"showAll,omitempty"`
 }
 
+// CreateJSONRequestBody defines body for Create for application/json ContentType.
+type CreateJSONRequestBody = CarRequest
+
+// ImportJSONRequestBody defines body for Import for application/json ContentType.
+type ImportJSONRequestBody = ImportJSONBody
+
+// UpdateJSONRequestBody defines body for Update for application/json ContentType.
+type UpdateJSONRequestBody = CarRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Добавить автомобиль в автопарк
+	// (POST /api/v1/admin/cars)
+	Create(ctx echo.Context) error
+	// Выгрузить каталог автомобилей в формате CSV или JSON
+	// (GET /api/v1/admin/cars/export)
+	Export(ctx echo.Context, params ExportParams) error
+	// Загрузить список автомобилей в формате CSV или JSON
+	// (POST /api/v1/admin/cars/import)
+	Import(ctx echo.Context) error
+	// Изменить информацию об автомобиле
+	// (PUT /api/v1/admin/cars/{car_uid})
+	Update(ctx echo.Context, carUid openapi_types.UUID) error
+	// Убрать автомобиль в архив
+	// (POST /api/v1/admin/cars/{car_uid}/archive)
+	Archive(ctx echo.Context, carUid openapi_types.UUID) error
+	// Вернуть автомобиль из архива
+	// (POST /api/v1/admin/cars/{car_uid}/restore)
+	Restore(ctx echo.Context, carUid openapi_types.UUID) error
 	// Получить список всех доступных для бронирования автомобилей
 	// (GET /api/v1/cars)
 	List(ctx echo.Context, params ListParams) error
@@ -116,6 +239,90 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// Create converts echo context to params.
+func (w *ServerInterfaceWrapper) Create(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Create(ctx)
+	return err
+}
+
+// Export converts echo context to params.
+func (w *ServerInterfaceWrapper) Export(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Export(ctx, params)
+	return err
+}
+
+// Import converts echo context to params.
+func (w *ServerInterfaceWrapper) Import(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Import(ctx)
+	return err
+}
+
+// Update converts echo context to params.
+func (w *ServerInterfaceWrapper) Update(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "car_uid" -------------
+	var carUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "car_uid", ctx.Param("car_uid"), &carUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter car_uid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Update(ctx, carUid)
+	return err
+}
+
+// Archive converts echo context to params.
+func (w *ServerInterfaceWrapper) Archive(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "car_uid" -------------
+	var carUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "car_uid", ctx.Param("car_uid"), &carUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter car_uid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Archive(ctx, carUid)
+	return err
+}
+
+// Restore converts echo context to params.
+func (w *ServerInterfaceWrapper) Restore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "car_uid" -------------
+	var carUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "car_uid", ctx.Param("car_uid"), &carUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter car_uid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Restore(ctx, carUid)
+	return err
+}
+
 // List converts echo context to params.
 func (w *ServerInterfaceWrapper) List(ctx echo.Context) error {
 	var err error
@@ -233,6 +440,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/api/v1/admin/cars", wrapper.Create)
+	router.GET(baseURL+"/api/v1/admin/cars/export", wrapper.Export)
+	router.POST(baseURL+"/api/v1/admin/cars/import", wrapper.Import)
+	router.PUT(baseURL+"/api/v1/admin/cars/:car_uid", wrapper.Update)
+	router.POST(baseURL+"/api/v1/admin/cars/:car_uid/archive", wrapper.Archive)
+	router.POST(baseURL+"/api/v1/admin/cars/:car_uid/restore", wrapper.Restore)
 	router.GET(baseURL+"/api/v1/cars", wrapper.List)
 	router.GET(baseURL+"/api/v1/cars/:car_uid", wrapper.Get)
 	router.POST(baseURL+"/api/v1/cars/:car_uid/book", wrapper.Book)
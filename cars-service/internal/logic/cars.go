@@ -2,19 +2,25 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 )
 
 type Cars struct {
-	repo carsRepo
+	repo     carsRepo
+	currency string
 }
 
-func New(repo carsRepo) *Cars {
+// New creates cars logic, currency is set for cars created without one.
+func New(repo carsRepo, currency string) *Cars {
 	return &Cars{
-		repo: repo,
+		repo:     repo,
+		currency: currency,
 	}
 }
 
@@ -41,18 +47,23 @@ func (c *Cars) Get(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
 	return car, nil
 }
 
-func (c *Cars) Book(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
+// Book holds the car for the user until it is unbooked.
+func (c *Cars) Book(ctx context.Context, uid uuid.UUID, username string) (*models.Car, error) {
 	car, err := c.repo.Get(ctx, uid)
 	if err != nil {
 		return nil, fmt.Errorf("get car from repo: %w", err)
 	}
 
-	if !car.Available {
+	if !car.Available || car.Archived {
 		return nil, fmt.Errorf("check car availability: %w", models.ErrCarCantBeBooked)
 	}
 
+	now := time.Now()
+
 	car.Available = false
-	err = c.repo.Update(ctx, car)
+	car.BookedBy = username
+	car.BookedAt = &now
+	err = c.repo.Update(ctx, car, models.NewCarEvent(models.CarBookedEvent, *car, traceContext(ctx)))
 	if err != nil {
 		return nil, fmt.Errorf("update car: %w", err)
 	}
@@ -60,7 +71,8 @@ func (c *Cars) Book(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
 	return car, nil
 }
 
-func (c *Cars) Unbook(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
+// Unbook releases the car. Only the holder, other services and admins may do it.
+func (c *Cars) Unbook(ctx context.Context, uid uuid.UUID, caller models.Caller) (*models.Car, error) {
 	car, err := c.repo.Get(ctx, uid)
 	if err != nil {
 		return nil, fmt.Errorf("get car from repo: %w", err)
@@ -70,8 +82,116 @@ func (c *Cars) Unbook(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
 		return nil, fmt.Errorf("check car availability: %w", models.ErrCarIsNotBooked)
 	}
 
+	if !caller.CanUnbook(*car) {
+		return nil, fmt.Errorf("check car holder: %w", models.ErrForbidden)
+	}
+
 	car.Available = true
-	err = c.repo.Update(ctx, car)
+	car.BookedBy = ""
+	car.BookedAt = nil
+	err = c.repo.Update(ctx, car, models.NewCarEvent(models.CarUnbookedEvent, *car, traceContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("update car: %w", err)
+	}
+
+	return car, nil
+}
+
+func (c *Cars) Create(ctx context.Context, req models.CarRequest, username string) (*models.Car, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	err = c.checkRegistrationNumber(ctx, req.RegistrationNumber, uuid.Nil)
+	if err != nil {
+		return nil, fmt.Errorf("check registration number: %w", err)
+	}
+
+	carToCreate := models.Car{
+		UUID:               uuid.New(),
+		Available:          true,
+		Brand:              req.Brand,
+		Model:              req.Model,
+		Power:              req.Power,
+		Price:              req.Price,
+		Currency:           currencyOr(req.Currency, c.currency),
+		RegistrationNumber: req.RegistrationNumber,
+		Type:               req.Type,
+	}
+
+	car, err := c.repo.Create(ctx, carToCreate, newCarChange(carToCreate.UUID, models.CarCreated, username))
+	if err != nil {
+		return nil, fmt.Errorf("create car in repo: %w", err)
+	}
+
+	return car, nil
+}
+
+func (c *Cars) Update(ctx context.Context, uid uuid.UUID, req models.CarRequest, username string) (*models.Car, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	car, err := c.repo.Get(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get car from repo: %w", err)
+	}
+
+	err = c.checkRegistrationNumber(ctx, req.RegistrationNumber, uid)
+	if err != nil {
+		return nil, fmt.Errorf("check registration number: %w", err)
+	}
+
+	car.Brand = req.Brand
+	car.Model = req.Model
+	car.Power = req.Power
+	car.Price = req.Price
+	car.Currency = currencyOr(req.Currency, car.Currency)
+	car.RegistrationNumber = req.RegistrationNumber
+	car.Type = req.Type
+
+	err = c.repo.UpdateWithChange(ctx, car, newCarChange(uid, models.CarUpdated, username),
+		models.NewCarEvent(models.CarUpdatedEvent, *car, traceContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("update car: %w", err)
+	}
+
+	return car, nil
+}
+
+func (c *Cars) Archive(ctx context.Context, uid uuid.UUID, username string) (*models.Car, error) {
+	car, err := c.repo.Get(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get car from repo: %w", err)
+	}
+
+	if car.Archived {
+		return nil, fmt.Errorf("check car is not archived: %w", models.ErrCarIsArchived)
+	}
+
+	car.Archived = true
+	err = c.repo.UpdateWithChange(ctx, car, newCarChange(uid, models.CarArchived, username))
+	if err != nil {
+		return nil, fmt.Errorf("update car: %w", err)
+	}
+
+	return car, nil
+}
+
+func (c *Cars) Restore(ctx context.Context, uid uuid.UUID, username string) (*models.Car, error) {
+	car, err := c.repo.Get(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get car from repo: %w", err)
+	}
+
+	if !car.Archived {
+		return nil, fmt.Errorf("check car is archived: %w", models.ErrCarIsNotArchived)
+	}
+
+	car.Archived = false
+	err = c.repo.UpdateWithChange(ctx, car, newCarChange(uid, models.CarRestored, username))
 	if err != nil {
 		return nil, fmt.Errorf("update car: %w", err)
 	}
@@ -79,10 +199,141 @@ func (c *Cars) Unbook(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
 	return car, nil
 }
 
+// Import validates every row and upserts valid ones by registration number.
+// Rows with duplicated registration number are rejected, only the first occurrence is imported.
+func (c *Cars) Import(ctx context.Context, rows []models.CarImportRow, username string) (*models.CarImportReport, error) {
+	report := &models.CarImportReport{
+		Rows: make([]models.CarImportResult, len(rows)),
+	}
+
+	carsToImport := make([]models.Car, 0, len(rows))
+	resultIndexes := make([]int, 0, len(rows))
+	firstRows := make(map[string]int, len(rows))
+
+	for i, row := range rows {
+		result := models.CarImportResult{
+			Row:                row.Row,
+			RegistrationNumber: row.Car.RegistrationNumber,
+			Errors:             row.Errors,
+		}
+
+		if len(result.Errors) == 0 {
+			err := row.Car.Validate()
+			if err != nil {
+				result.Errors = models.FieldErrors(err)
+			}
+		}
+
+		if len(result.Errors) == 0 {
+			if firstRow, ok := firstRows[row.Car.RegistrationNumber]; ok {
+				result.Errors = []models.FieldError{{
+					Field: "RegistrationNumber",
+					Error: fmt.Sprintf("duplicates registration number from row %d", firstRow),
+				}}
+			} else {
+				firstRows[row.Car.RegistrationNumber] = row.Row
+			}
+		}
+
+		if len(result.Errors) > 0 {
+			result.Status = models.ImportRejected
+			report.Rejected++
+		} else {
+			carsToImport = append(carsToImport, models.Car{
+				UUID:               uuid.New(),
+				Available:          true,
+				Brand:              row.Car.Brand,
+				Model:              row.Car.Model,
+				Power:              row.Car.Power,
+				Price:              row.Car.Price,
+				Currency:           currencyOr(row.Car.Currency, c.currency),
+				RegistrationNumber: row.Car.RegistrationNumber,
+				Type:               row.Car.Type,
+			})
+			resultIndexes = append(resultIndexes, i)
+		}
+
+		report.Rows[i] = result
+	}
+
+	if len(carsToImport) == 0 {
+		return report, nil
+	}
+
+	actions, err := c.repo.Import(ctx, carsToImport, models.CarChange{
+		Username:  username,
+		ChangedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("import cars in repo: %w", err)
+	}
+
+	for i, action := range actions {
+		result := &report.Rows[resultIndexes[i]]
+		if action == models.CarCreated {
+			result.Status = models.ImportCreated
+			report.Created++
+		} else {
+			result.Status = models.ImportUpdated
+			report.Updated++
+		}
+	}
+
+	return report, nil
+}
+
+func (c *Cars) Export(ctx context.Context, fn func(car models.Car) error) error {
+	err := c.repo.Iterate(ctx, fn)
+	if err != nil {
+		return fmt.Errorf("iterate cars in repo: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cars) checkRegistrationNumber(ctx context.Context, number string, uid uuid.UUID) error {
+	car, err := c.repo.GetByRegistrationNumber(ctx, number)
+	if err != nil {
+		if errors.Is(err, models.ErrCarNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("get car by registration number: %w", err)
+	}
+
+	if car.UUID != uid {
+		return models.ErrCarExists
+	}
+
+	return nil
+}
+
+func currencyOr(currency, fallback string) string {
+	if currency == "" {
+		return fallback
+	}
+
+	return currency
+}
+
+func newCarChange(uid uuid.UUID, action models.CarAction, username string) models.CarChange {
+	return models.CarChange{
+		CarUUID:   uid,
+		Action:    action,
+		Username:  username,
+		ChangedAt: time.Now(),
+	}
+}
+
 //go:generate mockery --all --with-expecter --exported --output mocks/
 
 type carsRepo interface {
 	List(ctx context.Context, paginator models.CarPaginator) (*models.CarList, error)
 	Get(ctx context.Context, uid uuid.UUID) (*models.Car, error)
-	Update(ctx context.Context, car *models.Car) error
+	Update(ctx context.Context, car *models.Car, events ...outbox.DomainEvent) error
+	GetByRegistrationNumber(ctx context.Context, number string) (*models.Car, error)
+	Create(ctx context.Context, car models.Car, change models.CarChange) (*models.Car, error)
+	UpdateWithChange(ctx context.Context, car *models.Car, change models.CarChange, events ...outbox.DomainEvent) error
+	Import(ctx context.Context, cars []models.Car, change models.CarChange) ([]models.CarAction, error)
+	Iterate(ctx context.Context, fn func(car models.Car) error) error
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/logic/mocks"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/go-playground/assert.v1"
 )
@@ -33,7 +35,7 @@ func TestCarsLogic_Get(t *testing.T) {
 		repository := mocks.NewCarsRepo(t)
 		repository.EXPECT().Get(ctx, uuid).Return(want, nil)
 
-		p := New(repository)
+		p := New(repository, "RUB")
 		got, err := p.Get(ctx, uuid)
 		require.NoError(t, err)
 		assert.Equal(t, want, got)
@@ -46,9 +48,213 @@ func TestCarsLogic_Get(t *testing.T) {
 		repository := mocks.NewCarsRepo(t)
 		repository.EXPECT().Get(ctx, uuid).Return(nil, errors.New("error"))
 
-		p := New(repository)
+		p := New(repository, "RUB")
 		got, err := p.Get(ctx, uuid)
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 }
+
+func TestCarsLogic_Book(t *testing.T) {
+	ctx := context.Background()
+	car := &models.Car{UUID: uuid.New(), Available: true}
+
+	repository := mocks.NewCarsRepo(t)
+	repository.EXPECT().Get(ctx, car.UUID).Return(car, nil)
+	repository.EXPECT().Update(ctx, car, mock.MatchedBy(carEvent(models.CarBookedEvent, car.UUID))).Return(nil)
+
+	p := New(repository, "RUB")
+	got, err := p.Book(ctx, car.UUID, "user")
+	require.NoError(t, err)
+	assert.Equal(t, false, got.Available)
+	assert.Equal(t, "user", got.BookedBy)
+	require.NotNil(t, got.BookedAt)
+}
+
+func carEvent(eventType outbox.EventType, uid uuid.UUID) func(event outbox.DomainEvent) bool {
+	return func(event outbox.DomainEvent) bool {
+		data, ok := event.Data.(models.CarEventData)
+		return ok && event.Type == eventType && event.AggregateID == uid && data.CarUID == uid &&
+			event.Version == models.CarEventVersion
+	}
+}
+
+func TestCarsLogic_Unbook(t *testing.T) {
+	newCar := func(bookedBy string) *models.Car {
+		return &models.Car{UUID: uuid.New(), BookedBy: bookedBy}
+	}
+
+	tests := map[string]struct {
+		bookedBy string
+		caller   models.Caller
+	}{
+		"holder":                {"user", models.Caller{Username: "user"}},
+		"service or admin":      {"user", models.Caller{Privileged: true}},
+		"booked without holder": {"", models.Caller{Username: "other"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			car := newCar(tt.bookedBy)
+
+			repository := mocks.NewCarsRepo(t)
+			repository.EXPECT().Get(ctx, car.UUID).Return(car, nil)
+			repository.EXPECT().Update(ctx, car, mock.MatchedBy(carEvent(models.CarUnbookedEvent, car.UUID))).Return(nil)
+
+			p := New(repository, "RUB")
+			got, err := p.Unbook(ctx, car.UUID, tt.caller)
+			require.NoError(t, err)
+			assert.Equal(t, true, got.Available)
+			assert.Equal(t, "", got.BookedBy)
+		})
+	}
+
+	t.Run("another user", func(t *testing.T) {
+		ctx := context.Background()
+		car := newCar("user")
+
+		repository := mocks.NewCarsRepo(t)
+		repository.EXPECT().Get(ctx, car.UUID).Return(car, nil)
+
+		p := New(repository, "RUB")
+		got, err := p.Unbook(ctx, car.UUID, models.Caller{Username: "other"})
+		require.ErrorIs(t, err, models.ErrForbidden)
+		require.Nil(t, got)
+	})
+}
+
+func TestCarsLogic_Create(t *testing.T) {
+	req := models.CarRequest{
+		Brand:              "Mercedes Benz",
+		Model:              "GLA 250",
+		Price:              3500,
+		RegistrationNumber: "ЛО777Х799",
+		Type:               models.Sedan,
+	}
+
+	t.Run("created car", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewCarsRepo(t)
+		repository.EXPECT().GetByRegistrationNumber(ctx, req.RegistrationNumber).Return(nil, models.ErrCarNotFound)
+		repository.EXPECT().Create(ctx, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, car models.Car, change models.CarChange) (*models.Car, error) {
+				require.Equal(t, car.UUID, change.CarUUID)
+				require.Equal(t, models.CarCreated, change.Action)
+				require.Equal(t, "admin", change.Username)
+				return &car, nil
+			})
+
+		p := New(repository, "RUB")
+		got, err := p.Create(ctx, req, "admin")
+		require.NoError(t, err)
+		assert.Equal(t, req.RegistrationNumber, got.RegistrationNumber)
+		assert.Equal(t, true, got.Available)
+		assert.Equal(t, "RUB", got.Currency)
+	})
+
+	t.Run("registration number is taken", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewCarsRepo(t)
+		repository.EXPECT().GetByRegistrationNumber(ctx, req.RegistrationNumber).Return(&models.Car{UUID: uuid.New()}, nil)
+
+		p := New(repository, "RUB")
+		got, err := p.Create(ctx, req, "admin")
+		require.ErrorIs(t, err, models.ErrCarExists)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid price", func(t *testing.T) {
+		ctx := context.Background()
+
+		invalidReq := req
+		invalidReq.Price = 0
+
+		p := New(mocks.NewCarsRepo(t), "RUB")
+		got, err := p.Create(ctx, invalidReq, "admin")
+		require.ErrorIs(t, err, models.ErrInvalidData)
+		require.Nil(t, got)
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		ctx := context.Background()
+
+		invalidReq := req
+		invalidReq.Currency = "RUR"
+
+		p := New(mocks.NewCarsRepo(t), "RUB")
+		got, err := p.Create(ctx, invalidReq, "admin")
+		require.ErrorIs(t, err, models.ErrInvalidData)
+		require.Nil(t, got)
+	})
+}
+
+func TestCarsLogic_Import(t *testing.T) {
+	t.Run("imported valid rows", func(t *testing.T) {
+		ctx := context.Background()
+
+		valid := models.CarRequest{
+			Brand:              "Mercedes Benz",
+			Model:              "GLA 250",
+			Price:              3500,
+			RegistrationNumber: "ЛО777Х799",
+			Type:               models.Sedan,
+		}
+		another := valid
+		another.RegistrationNumber = "ЛО777Х800"
+		invalid := valid
+		invalid.Type = "TRUCK"
+
+		rows := []models.CarImportRow{
+			{Row: 1, Car: valid},
+			{Row: 2, Car: invalid},
+			{Row: 3, Car: valid},
+			{Row: 4, Car: another},
+			{Row: 5, Errors: []models.FieldError{{Field: "Price", Error: "price must be an integer"}}},
+		}
+
+		repository := mocks.NewCarsRepo(t)
+		repository.EXPECT().Import(ctx, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, cars []models.Car, change models.CarChange) ([]models.CarAction, error) {
+				require.Len(t, cars, 2)
+				require.Equal(t, valid.RegistrationNumber, cars[0].RegistrationNumber)
+				require.Equal(t, another.RegistrationNumber, cars[1].RegistrationNumber)
+				require.Equal(t, "admin", change.Username)
+				return []models.CarAction{models.CarUpdated, models.CarCreated}, nil
+			})
+
+		p := New(repository, "RUB")
+		got, err := p.Import(ctx, rows, "admin")
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.Created)
+		assert.Equal(t, 1, got.Updated)
+		assert.Equal(t, 3, got.Rejected)
+		assert.Equal(t, models.ImportUpdated, got.Rows[0].Status)
+		assert.Equal(t, models.ImportRejected, got.Rows[1].Status)
+		assert.Equal(t, models.ImportRejected, got.Rows[2].Status)
+		assert.Equal(t, models.ImportCreated, got.Rows[3].Status)
+		assert.Equal(t, models.ImportRejected, got.Rows[4].Status)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctx := context.Background()
+
+		rows := []models.CarImportRow{{Row: 1, Car: models.CarRequest{
+			Brand:              "Mercedes Benz",
+			Model:              "GLA 250",
+			Price:              3500,
+			RegistrationNumber: "ЛО777Х799",
+			Type:               models.Sedan,
+		}}}
+
+		repository := mocks.NewCarsRepo(t)
+		repository.EXPECT().Import(ctx, mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+		p := New(repository, "RUB")
+		got, err := p.Import(ctx, rows, "admin")
+		require.Error(t, err)
+		require.Nil(t, got)
+	})
+}
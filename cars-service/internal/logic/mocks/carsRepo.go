@@ -9,6 +9,8 @@ import (
 
 	models "github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/models"
 
+	outbox "github.com/polnaya-katuxa/ds-lab-02/outbox"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -25,6 +27,66 @@ func (_m *CarsRepo) EXPECT() *CarsRepo_Expecter {
 	return &CarsRepo_Expecter{mock: &_m.Mock}
 }
 
+// Create provides a mock function with given fields: ctx, car, change
+func (_m *CarsRepo) Create(ctx context.Context, car models.Car, change models.CarChange) (*models.Car, error) {
+	ret := _m.Called(ctx, car, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *models.Car
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Car, models.CarChange) (*models.Car, error)); ok {
+		return rf(ctx, car, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Car, models.CarChange) *models.Car); ok {
+		r0 = rf(ctx, car, change)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Car)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Car, models.CarChange) error); ok {
+		r1 = rf(ctx, car, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CarsRepo_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CarsRepo_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - car models.Car
+//   - change models.CarChange
+func (_e *CarsRepo_Expecter) Create(ctx interface{}, car interface{}, change interface{}) *CarsRepo_Create_Call {
+	return &CarsRepo_Create_Call{Call: _e.mock.On("Create", ctx, car, change)}
+}
+
+func (_c *CarsRepo_Create_Call) Run(run func(ctx context.Context, car models.Car, change models.CarChange)) *CarsRepo_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Car), args[2].(models.CarChange))
+	})
+	return _c
+}
+
+func (_c *CarsRepo_Create_Call) Return(_a0 *models.Car, _a1 error) *CarsRepo_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CarsRepo_Create_Call) RunAndReturn(run func(context.Context, models.Car, models.CarChange) (*models.Car, error)) *CarsRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, uid
 func (_m *CarsRepo) Get(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
 	ret := _m.Called(ctx, uid)
@@ -84,6 +146,172 @@ func (_c *CarsRepo_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (
 	return _c
 }
 
+// GetByRegistrationNumber provides a mock function with given fields: ctx, number
+func (_m *CarsRepo) GetByRegistrationNumber(ctx context.Context, number string) (*models.Car, error) {
+	ret := _m.Called(ctx, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByRegistrationNumber")
+	}
+
+	var r0 *models.Car
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.Car, error)); ok {
+		return rf(ctx, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.Car); ok {
+		r0 = rf(ctx, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Car)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CarsRepo_GetByRegistrationNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByRegistrationNumber'
+type CarsRepo_GetByRegistrationNumber_Call struct {
+	*mock.Call
+}
+
+// GetByRegistrationNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - number string
+func (_e *CarsRepo_Expecter) GetByRegistrationNumber(ctx interface{}, number interface{}) *CarsRepo_GetByRegistrationNumber_Call {
+	return &CarsRepo_GetByRegistrationNumber_Call{Call: _e.mock.On("GetByRegistrationNumber", ctx, number)}
+}
+
+func (_c *CarsRepo_GetByRegistrationNumber_Call) Run(run func(ctx context.Context, number string)) *CarsRepo_GetByRegistrationNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CarsRepo_GetByRegistrationNumber_Call) Return(_a0 *models.Car, _a1 error) *CarsRepo_GetByRegistrationNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CarsRepo_GetByRegistrationNumber_Call) RunAndReturn(run func(context.Context, string) (*models.Car, error)) *CarsRepo_GetByRegistrationNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: ctx, cars, change
+func (_m *CarsRepo) Import(ctx context.Context, cars []models.Car, change models.CarChange) ([]models.CarAction, error) {
+	ret := _m.Called(ctx, cars, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 []models.CarAction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Car, models.CarChange) ([]models.CarAction, error)); ok {
+		return rf(ctx, cars, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []models.Car, models.CarChange) []models.CarAction); ok {
+		r0 = rf(ctx, cars, change)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CarAction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []models.Car, models.CarChange) error); ok {
+		r1 = rf(ctx, cars, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CarsRepo_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type CarsRepo_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cars []models.Car
+//   - change models.CarChange
+func (_e *CarsRepo_Expecter) Import(ctx interface{}, cars interface{}, change interface{}) *CarsRepo_Import_Call {
+	return &CarsRepo_Import_Call{Call: _e.mock.On("Import", ctx, cars, change)}
+}
+
+func (_c *CarsRepo_Import_Call) Run(run func(ctx context.Context, cars []models.Car, change models.CarChange)) *CarsRepo_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]models.Car), args[2].(models.CarChange))
+	})
+	return _c
+}
+
+func (_c *CarsRepo_Import_Call) Return(_a0 []models.CarAction, _a1 error) *CarsRepo_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CarsRepo_Import_Call) RunAndReturn(run func(context.Context, []models.Car, models.CarChange) ([]models.CarAction, error)) *CarsRepo_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Iterate provides a mock function with given fields: ctx, fn
+func (_m *CarsRepo) Iterate(ctx context.Context, fn func(models.Car) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Iterate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(models.Car) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CarsRepo_Iterate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Iterate'
+type CarsRepo_Iterate_Call struct {
+	*mock.Call
+}
+
+// Iterate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(models.Car) error
+func (_e *CarsRepo_Expecter) Iterate(ctx interface{}, fn interface{}) *CarsRepo_Iterate_Call {
+	return &CarsRepo_Iterate_Call{Call: _e.mock.On("Iterate", ctx, fn)}
+}
+
+func (_c *CarsRepo_Iterate_Call) Run(run func(ctx context.Context, fn func(models.Car) error)) *CarsRepo_Iterate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(models.Car) error))
+	})
+	return _c
+}
+
+func (_c *CarsRepo_Iterate_Call) Return(_a0 error) *CarsRepo_Iterate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CarsRepo_Iterate_Call) RunAndReturn(run func(context.Context, func(models.Car) error) error) *CarsRepo_Iterate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, paginator
 func (_m *CarsRepo) List(ctx context.Context, paginator models.CarPaginator) (*models.CarList, error) {
 	ret := _m.Called(ctx, paginator)
@@ -143,17 +371,24 @@ func (_c *CarsRepo_List_Call) RunAndReturn(run func(context.Context, models.CarP
 	return _c
 }
 
-// Update provides a mock function with given fields: ctx, car
-func (_m *CarsRepo) Update(ctx context.Context, car *models.Car) error {
-	ret := _m.Called(ctx, car)
+// Update provides a mock function with given fields: ctx, car, events
+func (_m *CarsRepo) Update(ctx context.Context, car *models.Car, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, car)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *models.Car) error); ok {
-		r0 = rf(ctx, car)
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Car, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, car, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -169,13 +404,21 @@ type CarsRepo_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
 //   - car *models.Car
-func (_e *CarsRepo_Expecter) Update(ctx interface{}, car interface{}) *CarsRepo_Update_Call {
-	return &CarsRepo_Update_Call{Call: _e.mock.On("Update", ctx, car)}
+//   - events ...outbox.DomainEvent
+func (_e *CarsRepo_Expecter) Update(ctx interface{}, car interface{}, events ...interface{}) *CarsRepo_Update_Call {
+	return &CarsRepo_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, car}, events...)...)}
 }
 
-func (_c *CarsRepo_Update_Call) Run(run func(ctx context.Context, car *models.Car)) *CarsRepo_Update_Call {
+func (_c *CarsRepo_Update_Call) Run(run func(ctx context.Context, car *models.Car, events ...outbox.DomainEvent)) *CarsRepo_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*models.Car))
+		variadicArgs := make([]outbox.DomainEvent, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*models.Car), variadicArgs...)
 	})
 	return _c
 }
@@ -185,7 +428,70 @@ func (_c *CarsRepo_Update_Call) Return(_a0 error) *CarsRepo_Update_Call {
 	return _c
 }
 
-func (_c *CarsRepo_Update_Call) RunAndReturn(run func(context.Context, *models.Car) error) *CarsRepo_Update_Call {
+func (_c *CarsRepo_Update_Call) RunAndReturn(run func(context.Context, *models.Car, ...outbox.DomainEvent) error) *CarsRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWithChange provides a mock function with given fields: ctx, car, change, events
+func (_m *CarsRepo) UpdateWithChange(ctx context.Context, car *models.Car, change models.CarChange, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, car, change)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.Car, models.CarChange, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, car, change, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CarsRepo_UpdateWithChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithChange'
+type CarsRepo_UpdateWithChange_Call struct {
+	*mock.Call
+}
+
+// UpdateWithChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - car *models.Car
+//   - change models.CarChange
+//   - events ...outbox.DomainEvent
+func (_e *CarsRepo_Expecter) UpdateWithChange(ctx interface{}, car interface{}, change interface{}, events ...interface{}) *CarsRepo_UpdateWithChange_Call {
+	return &CarsRepo_UpdateWithChange_Call{Call: _e.mock.On("UpdateWithChange",
+		append([]interface{}{ctx, car, change}, events...)...)}
+}
+
+func (_c *CarsRepo_UpdateWithChange_Call) Run(run func(ctx context.Context, car *models.Car, change models.CarChange, events ...outbox.DomainEvent)) *CarsRepo_UpdateWithChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*models.Car), args[2].(models.CarChange), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *CarsRepo_UpdateWithChange_Call) Return(_a0 error) *CarsRepo_UpdateWithChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CarsRepo_UpdateWithChange_Call) RunAndReturn(run func(context.Context, *models.Car, models.CarChange, ...outbox.DomainEvent) error) *CarsRepo_UpdateWithChange_Call {
 	_c.Call.Return(run)
 	return _c
 }
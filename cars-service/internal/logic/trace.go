@@ -0,0 +1,16 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/requestid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+)
+
+// traceContext links events to the request being handled.
+func traceContext(ctx context.Context) outbox.TraceContext {
+	return outbox.TraceContext{
+		RequestID:   requestid.Get(ctx),
+		Traceparent: requestid.Traceparent(ctx),
+	}
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+)
+
+// EventSource is the name of the service in the envelope of its domain events.
+const EventSource = "cars-service"
+
+const (
+	CarBookedEvent   outbox.EventType = "CarBooked"
+	CarUnbookedEvent outbox.EventType = "CarUnbooked"
+	CarUpdatedEvent  outbox.EventType = "CarUpdated"
+)
+
+// CarEventVersion is the version of CarEventData, it is increased on incompatible changes of the payload.
+const CarEventVersion = 1
+
+// CarEventData is the state of the car after the booking change.
+type CarEventData struct {
+	CarUID             uuid.UUID  `json:"car_uid"`
+	Brand              string     `json:"brand"`
+	Model              string     `json:"model"`
+	RegistrationNumber string     `json:"registration_number"`
+	Type               CarType    `json:"type"`
+	Power              *int       `json:"power,omitempty"`
+	Price              int        `json:"price"`
+	Currency           string     `json:"currency"`
+	Available          bool       `json:"available"`
+	BookedBy           string     `json:"booked_by,omitempty"`
+	BookedAt           *time.Time `json:"booked_at,omitempty"`
+}
+
+func NewCarEvent(eventType outbox.EventType, car Car, trace outbox.TraceContext) outbox.DomainEvent {
+	return outbox.DomainEvent{
+		ID:          uuid.New(),
+		Type:        eventType,
+		Version:     CarEventVersion,
+		Source:      EventSource,
+		AggregateID: car.UUID,
+		OccurredAt:  time.Now().UTC(),
+		Trace:       trace,
+		Data: CarEventData{
+			CarUID:             car.UUID,
+			Brand:              car.Brand,
+			Model:              car.Model,
+			RegistrationNumber: car.RegistrationNumber,
+			Type:               car.Type,
+			Power:              car.Power,
+			Price:              car.Price,
+			Currency:           car.Currency,
+			Available:          car.Available,
+			BookedBy:           car.BookedBy,
+			BookedAt:           car.BookedAt,
+		},
+	}
+}
@@ -3,16 +3,21 @@ package models
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 )
 
 var (
-	ErrCarCantBeBooked = errors.New("car is not available")
-	ErrCarIsNotBooked  = errors.New("car was not booked")
-	ErrInvalidData     = errors.New("invalid data")
-	ErrCarNotFound     = errors.New("car not found")
+	ErrCarCantBeBooked  = errors.New("car is not available")
+	ErrCarIsNotBooked   = errors.New("car was not booked")
+	ErrInvalidData      = errors.New("invalid data")
+	ErrCarNotFound      = errors.New("car not found")
+	ErrCarExists        = errors.New("car with such registration number already exists")
+	ErrCarIsArchived    = errors.New("car is archived")
+	ErrCarIsNotArchived = errors.New("car is not archived")
+	ErrForbidden        = errors.New("forbidden")
 )
 
 type CarType string
@@ -33,8 +38,61 @@ type Car struct {
 	Model              string  `gorm:"column:model"`
 	Power              *int    `gorm:"column:power"`
 	Price              int     `gorm:"column:price"`
+	Currency           string  `gorm:"column:currency"`
 	RegistrationNumber string  `gorm:"column:registration_number"`
 	Type               CarType `gorm:"column:type"`
+	Archived           bool    `gorm:"column:archived"`
+	// BookedBy is the user holding the booked car, it is empty for cars booked before holders were stored.
+	BookedBy string     `gorm:"column:booked_by"`
+	BookedAt *time.Time `gorm:"column:booked_at;type:timestamptz"`
+}
+
+// Caller is who requests the booking change. Privileged callers are other services and admins.
+type Caller struct {
+	Username   string
+	Privileged bool
+}
+
+// CanUnbook tells whether the caller may release the car. Cars without the holder may be released by anyone,
+// so rentals booked before holders were stored can still be canceled.
+func (c Caller) CanUnbook(car Car) bool {
+	return c.Privileged || car.BookedBy == "" || car.BookedBy == c.Username
+}
+
+type CarRequest struct {
+	Brand              string  `validate:"required,max=80"`
+	Model              string  `validate:"required,max=80"`
+	Power              *int    `validate:"omitempty,gt=0"`
+	Price              int     `validate:"gt=0"`
+	Currency           string  `validate:"omitempty,iso4217"`
+	RegistrationNumber string  `validate:"required,max=20"`
+	Type               CarType `validate:"oneof=SEDAN SUV MINIVAN ROADSTER"`
+}
+
+func (r *CarRequest) Validate() error {
+	err := validator.New().Struct(r)
+	if err != nil {
+		return fmt.Errorf("validate car: %w (%w)", err, ErrInvalidData)
+	}
+
+	return nil
+}
+
+type CarAction string
+
+const (
+	CarCreated  CarAction = "CREATE"
+	CarUpdated  CarAction = "UPDATE"
+	CarArchived CarAction = "ARCHIVE"
+	CarRestored CarAction = "RESTORE"
+)
+
+type CarChange struct {
+	ID        int       `gorm:"column:id;primaryKey"`
+	CarUUID   uuid.UUID `gorm:"column:car_uid;type:uuid"`
+	Action    CarAction `gorm:"column:action"`
+	Username  string    `gorm:"column:username"`
+	ChangedAt time.Time `gorm:"column:changed_at;type:timestamptz"`
 }
 
 type CarList struct {
@@ -58,3 +116,55 @@ func (p *CarPaginator) Validate() error {
 
 	return nil
 }
+
+type ImportStatus string
+
+const (
+	ImportCreated  ImportStatus = "CREATED"
+	ImportUpdated  ImportStatus = "UPDATED"
+	ImportRejected ImportStatus = "REJECTED"
+)
+
+type FieldError struct {
+	Field string
+	Error string
+}
+
+// FieldErrors splits validation error into per-field descriptions.
+func FieldErrors(err error) []FieldError {
+	var valErrors validator.ValidationErrors
+	if !errors.As(err, &valErrors) {
+		return []FieldError{{Error: err.Error()}}
+	}
+
+	fieldErrors := make([]FieldError, 0, len(valErrors))
+	for _, v := range valErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field: v.Field(),
+			Error: v.Error(),
+		})
+	}
+
+	return fieldErrors
+}
+
+// CarImportRow is a parsed row of uploaded file. Errors contains problems found while parsing the row.
+type CarImportRow struct {
+	Row    int
+	Car    CarRequest
+	Errors []FieldError
+}
+
+type CarImportResult struct {
+	Row                int
+	RegistrationNumber string
+	Status             ImportStatus
+	Errors             []FieldError
+}
+
+type CarImportReport struct {
+	Rows     []CarImportResult
+	Created  int
+	Updated  int
+	Rejected int
+}
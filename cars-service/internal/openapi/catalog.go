@@ -0,0 +1,238 @@
+package openapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/generated/openapi"
+	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/models"
+)
+
+const mimeTextCSV = "text/csv"
+
+var (
+	carsCSVHeader         = []string{"carUid", "brand", "model", "registrationNumber", "power", "type", "price", "currency", "available", "archived"}
+	carsCSVRequiredHeader = []string{"brand", "model", "registrationNumber", "type", "price"}
+)
+
+func parseCarsImport(req *http.Request) ([]models.CarImportRow, error) {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return nil, fmt.Errorf("parse content type: %w (%w)", err, models.ErrInvalidData)
+	}
+
+	switch mediaType {
+	case mimeTextCSV:
+		return parseCarsCSV(req.Body)
+	case echo.MIMEApplicationJSON:
+		return parseCarsJSON(req.Body)
+	default:
+		return nil, fmt.Errorf("unsupported content type %q (%w)", mediaType, models.ErrInvalidData)
+	}
+}
+
+// parseCarsCSV reads cars by header names, so the file produced by export can be uploaded back.
+func parseCarsCSV(r io.Reader) ([]models.CarImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w (%w)", err, models.ErrInvalidData)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[name] = i
+	}
+
+	for _, name := range carsCSVRequiredHeader {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("missing csv column %q (%w)", name, models.ErrInvalidData)
+		}
+	}
+
+	var rows []models.CarImportRow
+	for rowNumber := 1; ; rowNumber++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv row %d: %w (%w)", rowNumber, err, models.ErrInvalidData)
+		}
+
+		row := models.CarImportRow{Row: rowNumber}
+		if len(record) != len(header) {
+			row.Errors = append(row.Errors, models.FieldError{
+				Error: fmt.Sprintf("expected %d columns, got %d", len(header), len(record)),
+			})
+			rows = append(rows, row)
+			continue
+		}
+
+		column := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return record[i]
+			}
+			return ""
+		}
+
+		row.Car = models.CarRequest{
+			Brand:              column("brand"),
+			Model:              column("model"),
+			RegistrationNumber: column("registrationNumber"),
+			Type:               models.CarType(column("type")),
+			Currency:           column("currency"),
+		}
+
+		row.Car.Price, err = strconv.Atoi(column("price"))
+		if err != nil {
+			row.Errors = append(row.Errors, models.FieldError{Field: "Price", Error: "price must be an integer"})
+		}
+
+		if power := column("power"); power != "" {
+			parsedPower, err := strconv.Atoi(power)
+			if err != nil {
+				row.Errors = append(row.Errors, models.FieldError{Field: "Power", Error: "power must be an integer"})
+			} else {
+				row.Car.Power = &parsedPower
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseCarsJSON(r io.Reader) ([]models.CarImportRow, error) {
+	var rawRows []json.RawMessage
+	err := json.NewDecoder(r).Decode(&rawRows)
+	if err != nil {
+		return nil, fmt.Errorf("decode json array: %w (%w)", err, models.ErrInvalidData)
+	}
+
+	rows := make([]models.CarImportRow, 0, len(rawRows))
+	for i, rawRow := range rawRows {
+		row := models.CarImportRow{Row: i + 1}
+
+		var car openapi.CarRequest
+		err := json.Unmarshal(rawRow, &car)
+		if err != nil {
+			row.Errors = append(row.Errors, models.FieldError{Error: err.Error()})
+		} else {
+			row.Car = toCarRequest(car)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+type carsEncoder interface {
+	Encode(car models.Car) error
+	Close() error
+}
+
+type csvCarsEncoder struct {
+	w *csv.Writer
+}
+
+func newCSVCarsEncoder(w io.Writer) (*csvCarsEncoder, error) {
+	csvWriter := csv.NewWriter(w)
+	err := csvWriter.Write(carsCSVHeader)
+	if err != nil {
+		return nil, fmt.Errorf("write csv header: %w", err)
+	}
+
+	return &csvCarsEncoder{w: csvWriter}, nil
+}
+
+func (e *csvCarsEncoder) Encode(car models.Car) error {
+	power := ""
+	if car.Power != nil {
+		power = strconv.Itoa(*car.Power)
+	}
+
+	err := e.w.Write([]string{
+		car.UUID.String(),
+		car.Brand,
+		car.Model,
+		car.RegistrationNumber,
+		power,
+		string(car.Type),
+		strconv.Itoa(car.Price),
+		car.Currency,
+		strconv.FormatBool(car.Available),
+		strconv.FormatBool(car.Archived),
+	})
+	if err != nil {
+		return fmt.Errorf("write csv row: %w", err)
+	}
+
+	return nil
+}
+
+func (e *csvCarsEncoder) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// jsonCarsEncoder writes cars as a json array element by element.
+type jsonCarsEncoder struct {
+	w     io.Writer
+	count int
+}
+
+func newJSONCarsEncoder(w io.Writer) *jsonCarsEncoder {
+	return &jsonCarsEncoder{w: w}
+}
+
+func (e *jsonCarsEncoder) Encode(car models.Car) error {
+	data, err := json.Marshal(fromCar(car))
+	if err != nil {
+		return fmt.Errorf("marshal car: %w", err)
+	}
+
+	delimiter := ","
+	if e.count == 0 {
+		delimiter = "["
+	}
+	e.count++
+
+	_, err = io.WriteString(e.w, delimiter)
+	if err != nil {
+		return fmt.Errorf("write delimiter: %w", err)
+	}
+
+	_, err = e.w.Write(data)
+	if err != nil {
+		return fmt.Errorf("write car: %w", err)
+	}
+
+	return nil
+}
+
+func (e *jsonCarsEncoder) Close() error {
+	ending := "]\n"
+	if e.count == 0 {
+		ending = "[]\n"
+	}
+
+	_, err := io.WriteString(e.w, ending)
+	if err != nil {
+		return fmt.Errorf("write array end: %w", err)
+	}
+
+	return nil
+}
@@ -33,8 +33,45 @@ func fromCar(car models.Car) openapi.CarResponse {
 		Model:              car.Model,
 		Power:              car.Power,
 		Price:              car.Price,
+		Currency:           car.Currency,
 		RegistrationNumber: car.RegistrationNumber,
 		Type:               openapi.CarResponseType(car.Type),
+		Archived:           lo.ToPtr(car.Archived),
+	}
+}
+
+func toCarRequest(req openapi.CarRequest) models.CarRequest {
+	return models.CarRequest{
+		Brand:              req.Brand,
+		Model:              req.Model,
+		Power:              req.Power,
+		Price:              req.Price,
+		Currency:           lo.FromPtr(req.Currency),
+		RegistrationNumber: req.RegistrationNumber,
+		Type:               models.CarType(req.Type),
+	}
+}
+
+func fromCarImportReport(report models.CarImportReport) openapi.CarImportReport {
+	rows := lo.Map(report.Rows, func(row models.CarImportResult, _ int) openapi.CarImportRow {
+		return openapi.CarImportRow{
+			Row:                row.Row,
+			RegistrationNumber: row.RegistrationNumber,
+			Status:             openapi.CarImportRowStatus(row.Status),
+			Errors: lo.Map(row.Errors, func(fieldError models.FieldError, _ int) openapi.ErrorDescription {
+				return openapi.ErrorDescription{
+					Error: fieldError.Error,
+					Field: fieldError.Field,
+				}
+			}),
+		}
+	})
+
+	return openapi.CarImportReport{
+		Created:  report.Created,
+		Updated:  report.Updated,
+		Rejected: report.Rejected,
+		Rows:     rows,
 	}
 }
 
@@ -61,11 +98,17 @@ func processError(c echo.Context, err error, comment string) error {
 		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
 			Message: err.Error(),
 		})
+	case errors.Is(err, models.ErrForbidden):
+		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: err.Error(),
+		})
 	case errors.Is(err, models.ErrCarNotFound):
 		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
 			Message: err.Error(),
 		})
-	case errors.Is(err, models.ErrCarIsNotBooked), errors.Is(err, models.ErrCarCantBeBooked):
+	case errors.Is(err, models.ErrCarIsNotBooked), errors.Is(err, models.ErrCarCantBeBooked),
+		errors.Is(err, models.ErrCarExists), errors.Is(err, models.ErrCarIsArchived),
+		errors.Is(err, models.ErrCarIsNotArchived):
 		return c.JSON(http.StatusConflict, openapi.ErrorResponse{
 			Message: err.Error(),
 		})
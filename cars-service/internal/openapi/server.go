@@ -2,16 +2,21 @@ package openapi
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/auth"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/generated/openapi"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/models"
 	"github.com/samber/lo"
 )
 
+const exportFlushInterval = 100
+
 type Server struct {
 	carsLogic carsLogic
 }
@@ -45,7 +50,7 @@ func (s *Server) Get(c echo.Context, carUid openapi_types.UUID) error {
 }
 
 func (s *Server) Book(c echo.Context, carUid openapi_types.UUID) error {
-	car, err := s.carsLogic.Book(c.Request().Context(), carUid)
+	car, err := s.carsLogic.Book(c.Request().Context(), carUid, auth.GetUsername(c.Request().Context()))
 	if err != nil {
 		return processError(c, err, "book car")
 	}
@@ -54,7 +59,10 @@ func (s *Server) Book(c echo.Context, carUid openapi_types.UUID) error {
 }
 
 func (s *Server) Unbook(c echo.Context, carUid openapi_types.UUID) error {
-	_, err := s.carsLogic.Unbook(c.Request().Context(), carUid)
+	_, err := s.carsLogic.Unbook(c.Request().Context(), carUid, models.Caller{
+		Username:   auth.GetUsername(c.Request().Context()),
+		Privileged: auth.IsPrivileged(c.Request().Context()),
+	})
 	if err != nil {
 		return processError(c, err, "unbook car")
 	}
@@ -62,6 +70,112 @@ func (s *Server) Unbook(c echo.Context, carUid openapi_types.UUID) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+func (s *Server) Create(c echo.Context) error {
+	var req openapi.CarRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	car, err := s.carsLogic.Create(c.Request().Context(), toCarRequest(req), auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "create car")
+	}
+
+	return c.JSON(http.StatusCreated, fromCar(*car))
+}
+
+func (s *Server) Update(c echo.Context, carUid openapi_types.UUID) error {
+	var req openapi.CarRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	car, err := s.carsLogic.Update(c.Request().Context(), carUid, toCarRequest(req), auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "update car")
+	}
+
+	return c.JSON(http.StatusOK, fromCar(*car))
+}
+
+func (s *Server) Archive(c echo.Context, carUid openapi_types.UUID) error {
+	car, err := s.carsLogic.Archive(c.Request().Context(), carUid, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "archive car")
+	}
+
+	return c.JSON(http.StatusOK, fromCar(*car))
+}
+
+func (s *Server) Restore(c echo.Context, carUid openapi_types.UUID) error {
+	car, err := s.carsLogic.Restore(c.Request().Context(), carUid, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "restore car")
+	}
+
+	return c.JSON(http.StatusOK, fromCar(*car))
+}
+
+func (s *Server) Import(c echo.Context) error {
+	rows, err := parseCarsImport(c.Request())
+	if err != nil {
+		return processError(c, err, "parse uploaded cars")
+	}
+
+	report, err := s.carsLogic.Import(c.Request().Context(), rows, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "import cars")
+	}
+
+	return c.JSON(http.StatusOK, fromCarImportReport(*report))
+}
+
+func (s *Server) Export(c echo.Context, params openapi.ExportParams) error {
+	var encoder carsEncoder
+	switch lo.FromPtrOr(params.Format, openapi.Json) {
+	case openapi.Csv:
+		c.Response().Header().Set(echo.HeaderContentType, mimeTextCSV)
+		c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="cars.csv"`)
+		c.Response().WriteHeader(http.StatusOK)
+
+		csvEncoder, err := newCSVCarsEncoder(c.Response())
+		if err != nil {
+			return fmt.Errorf("create csv encoder: %w", err)
+		}
+		encoder = csvEncoder
+	case openapi.Json:
+		c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		c.Response().WriteHeader(http.StatusOK)
+
+		encoder = newJSONCarsEncoder(c.Response())
+	default:
+		return processError(c, models.ErrInvalidData, "check export format")
+	}
+
+	exported := 0
+	err := s.carsLogic.Export(c.Request().Context(), func(car models.Car) error {
+		err := encoder.Encode(car)
+		if err != nil {
+			return fmt.Errorf("encode car: %w", err)
+		}
+
+		exported++
+		if exported%exportFlushInterval == 0 {
+			c.Response().Flush()
+		}
+
+		return nil
+	})
+	if err != nil {
+		// Response is already committed, so the error can only be logged by echo.
+		return fmt.Errorf("export cars: %w", err)
+	}
+
+	return encoder.Close()
+}
+
 func (s *Server) Live(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
@@ -69,6 +183,12 @@ func (s *Server) Live(c echo.Context) error {
 type carsLogic interface {
 	List(ctx context.Context, paginator models.CarPaginator) (*models.CarList, error)
 	Get(ctx context.Context, uid uuid.UUID) (*models.Car, error)
-	Book(ctx context.Context, uid uuid.UUID) (*models.Car, error)
-	Unbook(ctx context.Context, uid uuid.UUID) (*models.Car, error)
+	Book(ctx context.Context, uid uuid.UUID, username string) (*models.Car, error)
+	Unbook(ctx context.Context, uid uuid.UUID, caller models.Caller) (*models.Car, error)
+	Create(ctx context.Context, req models.CarRequest, username string) (*models.Car, error)
+	Update(ctx context.Context, uid uuid.UUID, req models.CarRequest, username string) (*models.Car, error)
+	Archive(ctx context.Context, uid uuid.UUID, username string) (*models.Car, error)
+	Restore(ctx context.Context, uid uuid.UUID, username string) (*models.Car, error)
+	Import(ctx context.Context, rows []models.CarImportRow, username string) (*models.CarImportReport, error)
+	Export(ctx context.Context, fn func(car models.Car) error) error
 }
@@ -7,7 +7,9 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/polnaya-katuxa/ds-lab-02/cars-service/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Cars struct {
@@ -23,6 +25,7 @@ func (c *Cars) List(ctx context.Context, paginator models.CarPaginator) (*models
 	var total int64
 
 	query := c.db.Table("cars").WithContext(ctx).Offset(paginator.Page * paginator.PageSize).Limit(paginator.PageSize)
+	query = query.Where("archived = false")
 	if !paginator.ShowAll {
 		query = query.Where("availability = true")
 	}
@@ -55,14 +58,177 @@ func (c *Cars) Get(ctx context.Context, uid uuid.UUID) (*models.Car, error) {
 	return &car, nil
 }
 
-func (c *Cars) Update(ctx context.Context, car *models.Car) error {
-	res := c.db.Table("cars").WithContext(ctx).Save(car)
-	if res.Error != nil {
-		return fmt.Errorf("update car in db: %w", res.Error)
+// Update saves the car with its domain events in one transaction.
+func (c *Cars) Update(ctx context.Context, car *models.Car, events ...outbox.DomainEvent) error {
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Table("cars").Save(car)
+		if res.Error != nil {
+			return fmt.Errorf("update car in db: %w", res.Error)
+		}
+
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("update car in db: %w", models.ErrCarNotFound)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cars) GetByRegistrationNumber(ctx context.Context, number string) (*models.Car, error) {
+	var car models.Car
+
+	err := c.db.Table("cars").WithContext(ctx).First(&car, "registration_number = ?", number).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get car from db: %w", models.ErrCarNotFound)
+		}
+
+		return nil, fmt.Errorf("get car from db: %w", err)
+	}
+
+	return &car, nil
+}
+
+func (c *Cars) Create(ctx context.Context, car models.Car, change models.CarChange) (*models.Car, error) {
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table("cars").Create(&car).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return fmt.Errorf("create car in db: %w", models.ErrCarExists)
+			}
+
+			return fmt.Errorf("create car in db: %w", err)
+		}
+
+		err = tx.Table("car_changes").Create(&change).Error
+		if err != nil {
+			return fmt.Errorf("create car change in db: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction: %w", err)
+	}
+
+	return &car, nil
+}
+
+func (c *Cars) UpdateWithChange(ctx context.Context, car *models.Car, change models.CarChange, events ...outbox.DomainEvent) error {
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Table("cars").Save(car)
+		if res.Error != nil {
+			if errors.Is(res.Error, gorm.ErrDuplicatedKey) {
+				return fmt.Errorf("update car in db: %w", models.ErrCarExists)
+			}
+
+			return fmt.Errorf("update car in db: %w", res.Error)
+		}
+
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("update car in db: %w", models.ErrCarNotFound)
+		}
+
+		err := tx.Table("car_changes").Create(&change).Error
+		if err != nil {
+			return fmt.Errorf("create car change in db: %w", err)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Import upserts cars by registration number in one transaction. Change is used as a template
+// for the change record of every car. Returns performed action for every car.
+func (c *Cars) Import(ctx context.Context, cars []models.Car, change models.CarChange) ([]models.CarAction, error) {
+	actions := make([]models.CarAction, len(cars))
+
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range cars {
+			car := &cars[i]
+
+			var existing models.Car
+			err := tx.Table("cars").Clauses(clause.Locking{Strength: "UPDATE"}).
+				Take(&existing, "registration_number = ?", car.RegistrationNumber).Error
+			switch {
+			case err == nil:
+				car.ID = existing.ID
+				car.UUID = existing.UUID
+				car.Available = existing.Available
+				car.Archived = existing.Archived
+				car.BookedBy = existing.BookedBy
+				car.BookedAt = existing.BookedAt
+
+				err = tx.Table("cars").Save(car).Error
+				if err != nil {
+					return fmt.Errorf("update car in db: %w", err)
+				}
+
+				actions[i] = models.CarUpdated
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				err = tx.Table("cars").Create(car).Error
+				if err != nil {
+					return fmt.Errorf("create car in db: %w", err)
+				}
+
+				actions[i] = models.CarCreated
+			default:
+				return fmt.Errorf("get car from db: %w", err)
+			}
+
+			carChange := change
+			carChange.CarUUID = car.UUID
+			carChange.Action = actions[i]
+
+			err = tx.Table("car_changes").Create(&carChange).Error
+			if err != nil {
+				return fmt.Errorf("create car change in db: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction: %w", err)
 	}
 
-	if res.RowsAffected == 0 {
-		return fmt.Errorf("update car in db: %w", models.ErrCarNotFound)
+	return actions, nil
+}
+
+// Iterate calls fn for every car in the catalog, including archived ones, without loading them all in memory.
+func (c *Cars) Iterate(ctx context.Context, fn func(car models.Car) error) error {
+	rows, err := c.db.Table("cars").WithContext(ctx).Order("id").Rows()
+	if err != nil {
+		return fmt.Errorf("select cars from db: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var car models.Car
+		err := c.db.ScanRows(rows, &car)
+		if err != nil {
+			return fmt.Errorf("scan car: %w", err)
+		}
+
+		err = fn(car)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("iterate cars: %w", err)
 	}
 
 	return nil
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"embed"
 	"errors"
 	"flag"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/auth"
@@ -19,14 +21,25 @@ import (
 	payment_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/payment-service"
 	rental_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/rental-service"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/openapi"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/projection"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka/domainevents"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka/rentalevents"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka/retryqueue"
+	repositoryPostgres "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/postgres"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/requestid"
+	"github.com/pressly/goose/v3"
 	circuit "github.com/rubyist/circuitbreaker"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
+//go:embed migrations/*.sql
+var embedMigrations embed.FS
+
 func main() {
 	err := run()
 	if err != nil {
@@ -49,22 +62,48 @@ func run() error {
 		return fmt.Errorf("init logger: %w", err)
 	}
 
+	db, err := gorm.Open(postgres.Open(cfg.Postgres.toDSN()), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return fmt.Errorf("open postgres connection: %w", err)
+	}
+
+	goose.SetBaseFS(embedMigrations)
+
+	if err := goose.SetDialect("postgres"); err != nil {
+		return fmt.Errorf("set goose dialect: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("get sql db: %w", err)
+	}
+
+	if err := goose.Up(sqlDB, "migrations"); err != nil {
+		return fmt.Errorf("up migrations: %w", err)
+	}
+
+	inbox := repositoryPostgres.NewInbox(db)
+	retryCommands := repositoryPostgres.NewRetryCommands(db)
+
 	optCarsServiceClient := cars_service.WithHTTPClient(circuit.NewHTTPClient(0, 10, nil))
-	carsServiceGeneratedClient, err := cars_service.NewClient(cfg.Services.Cars, optCarsServiceClient)
+	carsServiceGeneratedClient, err := cars_service.NewClient(cfg.Services.Cars, optCarsServiceClient,
+		cars_service.WithRequestEditorFn(requestid.Propagate))
 	if err != nil {
 		return fmt.Errorf("init cars service client: %w", err)
 	}
 	carsServiceClient := clients.NewCarsServiceClient(carsServiceGeneratedClient, cfg.ServicePassword)
 
 	optRentalServiceClient := rental_service.WithHTTPClient(circuit.NewHTTPClient(0, 10, nil))
-	rentalServiceGeneratedClient, err := rental_service.NewClient(cfg.Services.Rental, optRentalServiceClient)
+	rentalServiceGeneratedClient, err := rental_service.NewClient(cfg.Services.Rental, optRentalServiceClient,
+		rental_service.WithRequestEditorFn(requestid.Propagate))
 	if err != nil {
 		return fmt.Errorf("init rental service client: %w", err)
 	}
 	rentalServiceClient := clients.NewRentalServiceClient(rentalServiceGeneratedClient)
 
 	optPaymentServiceClient := payment_service.WithHTTPClient(circuit.NewHTTPClient(0, 10, nil))
-	paymentServiceGeneratedClient, err := payment_service.NewClient(cfg.Services.Payment, optPaymentServiceClient)
+	paymentServiceGeneratedClient, err := payment_service.NewClient(cfg.Services.Payment, optPaymentServiceClient,
+		payment_service.WithRequestEditorFn(requestid.Propagate))
 	if err != nil {
 		return fmt.Errorf("init rental service client: %w", err)
 	}
@@ -75,19 +114,49 @@ func run() error {
 		return fmt.Errorf("init retry queue producer: %w", err)
 	}
 
-	carsRetryQueueConsumer, err := retryqueue.NewCarsRetryQueueConsumer(ctx, retryQueueProducer, cfg.Kafka.Brokers, cfg.Kafka.CarsServiceRetryTopic, carsServiceClient, logger)
+	carsRetryQueueConsumer, err := retryqueue.NewCarsRetryQueueConsumer(ctx, retryQueueProducer, cfg.Kafka.Brokers, cfg.Kafka.CarsServiceRetryTopic, carsServiceClient,
+		inbox, retryCommands, cfg.Retry.MaxAttempts, logger)
 	if err != nil {
 		return fmt.Errorf("init cars retry queue consumer: %w", err)
 	}
 
-	paymentRetryQueueConsumer, err := retryqueue.NewPaymentRetryQueueConsumer(ctx, retryQueueProducer, cfg.Kafka.Brokers, cfg.Kafka.PaymentServiceRetryTopic, paymentServiceClient, logger)
+	paymentRetryQueueConsumer, err := retryqueue.NewPaymentRetryQueueConsumer(ctx, retryQueueProducer, cfg.Kafka.Brokers, cfg.Kafka.PaymentServiceRetryTopic, paymentServiceClient,
+		inbox, retryCommands, cfg.Retry.MaxAttempts, logger)
 	if err != nil {
 		return fmt.Errorf("init payment retry queue consumer: %w", err)
 	}
 
+	if cfg.Retry.MaxAttempts <= 0 {
+		logger.Warn("retry commands are never dead-lettered")
+	}
+
+	retryBacklog, err := retryqueue.NewBacklog(cfg.Kafka.Brokers, cfg.Kafka.CarsServiceRetryTopic, cfg.Kafka.PaymentServiceRetryTopic, logger)
+	if err != nil {
+		return fmt.Errorf("init retry backlog: %w", err)
+	}
+
+	rentalEventsConsumer, err := rentalevents.NewConsumer(ctx, retryQueueProducer, cfg.Kafka.Brokers, cfg.Kafka.RentalEventsTopic, inbox, logger)
+	if err != nil {
+		return fmt.Errorf("init rental events consumer: %w", err)
+	}
+
+	var rentalsProjection *projection.Projection
+	var domainEventsConsumer *domainevents.Consumer
+	if len(cfg.Kafka.DomainEventsTopics) > 0 {
+		rentalsProjection = projection.New(repositoryPostgres.NewProjection(db), cfg.Projection.MaxLag, logger)
+
+		domainEventsConsumer, err = domainevents.NewConsumer(ctx, rentalsProjection, cfg.Kafka.Brokers, cfg.Kafka.DomainEventsTopics, logger)
+		if err != nil {
+			return fmt.Errorf("init domain events consumer: %w", err)
+		}
+	} else {
+		logger.Warn("rentals projection is disabled")
+	}
+
 	e := echo.New()
-	e.Use(auth.CreateMiddleware(cfg.JWKsURL))
-	server := openapi.New(carsServiceClient, paymentServiceClient, rentalServiceClient, retryQueueProducer)
+	e.Use(requestid.CreateMiddleware())
+	e.Use(auth.CreateMiddleware(cfg.JWKsURL, cfg.AdminRole))
+	server := openapi.New(carsServiceClient, paymentServiceClient, rentalServiceClient, retryQueueProducer, retryCommands, retryBacklog, rentalsProjection, logger)
 	openapiGenerated.RegisterHandlers(e, server)
 
 	go func() {
@@ -98,6 +167,16 @@ func run() error {
 		e.Close()
 		carsRetryQueueConsumer.Stop()
 		paymentRetryQueueConsumer.Stop()
+		retryBacklog.Stop()
+		rentalEventsConsumer.Stop()
+		if domainEventsConsumer != nil {
+			domainEventsConsumer.Stop()
+		}
+
+		rawDB, err := db.DB()
+		if err == nil {
+			rawDB.Close()
+		}
 	}()
 
 	logger.Infow("starting service", "port", cfg.Port)
@@ -110,6 +189,15 @@ func run() error {
 		return nil
 	})
 
+	if cfg.Inbox.Retention > 0 {
+		g.Go(func() error {
+			purgeInbox(ctx, inbox, cfg.Inbox, logger)
+			return nil
+		})
+	} else {
+		logger.Warn("inbox purge is disabled")
+	}
+
 	if err := g.Wait(); err != nil {
 		return fmt.Errorf("errgroup: %w", err)
 	}
@@ -117,6 +205,27 @@ func run() error {
 	return nil
 }
 
+// purgeInbox deletes handled messages which kafka doesn't redeliver anymore.
+func purgeInbox(ctx context.Context, inbox *repositoryPostgres.Inbox, cfg inboxPurge, logger *zap.SugaredLogger) {
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := inbox.Purge(ctx, time.Now().Add(-cfg.Retention))
+			if err != nil {
+				logger.Errorw("cannot purge inbox", "error", err)
+				continue
+			}
+
+			logger.Infow("purged inbox", "messages", purged)
+		}
+	}
+}
+
 func readConfig() (*config, error) {
 	cfgFile := flag.String("config", "/config.yaml", "path to config")
 	flag.Parse()
@@ -168,13 +277,31 @@ func initLogger(cfg *config) (*zap.SugaredLogger, error) {
 	return logger.Sugar(), nil
 }
 
+type db struct {
+	Host     string
+	User     string
+	Password string
+	DBName   string
+	Port     int
+}
+
+func (d *db) toDSN() string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s", d.Host,
+		d.Port, d.User, d.Password, d.DBName)
+}
+
 type config struct {
+	Postgres        db
 	Services        services
 	Port            int
 	LogLevel        string
 	Kafka           kafka
 	JWKsURL         string
 	ServicePassword string
+	AdminRole       string
+	Projection      readModel
+	Inbox           inboxPurge
+	Retry           retryPolicy
 }
 
 type services struct {
@@ -187,4 +314,24 @@ type kafka struct {
 	Brokers                  []string
 	CarsServiceRetryTopic    string
 	PaymentServiceRetryTopic string
+	RentalEventsTopic        string
+	DomainEventsTopics       []string
+}
+
+// inboxPurge deletes messages handled longer than the retention of kafka topics ago,
+// purge is disabled when the retention is zero.
+type inboxPurge struct {
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+// retryPolicy dead-letters retry commands after MaxAttempts failed attempts, zero retries them forever.
+type retryPolicy struct {
+	MaxAttempts int
+}
+
+// readModel serves user rentals while the domain events consumers lag behind
+// each partition by no more than MaxLag messages.
+type readModel struct {
+	MaxLag int64
 }
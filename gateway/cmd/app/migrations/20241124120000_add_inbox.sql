@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Kafka messages handled by the gateway consumers, duplicates of them are skipped.
+CREATE TABLE inbox
+(
+    consumer     VARCHAR(80)              NOT NULL,
+    message_id   uuid                     NOT NULL,
+    topic        VARCHAR(80)              NOT NULL,
+    processed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (consumer, message_id)
+);
+
+CREATE INDEX inbox_processed_at_idx ON inbox (processed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS inbox;
+-- +goose StatementEnd
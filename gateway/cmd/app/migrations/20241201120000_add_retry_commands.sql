@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Compensations retried through kafka, commands which run out of attempts are dead-lettered.
+CREATE TABLE retry_commands
+(
+    id             uuid PRIMARY KEY,
+    type           VARCHAR(40)              NOT NULL,
+    topic          VARCHAR(80)              NOT NULL,
+    target         uuid                     NOT NULL,
+    payload        jsonb                    NOT NULL,
+    status         VARCHAR(20)              NOT NULL,
+    attempts       INT                      NOT NULL DEFAULT 0,
+    replays        INT                      NOT NULL DEFAULT 0,
+    last_error     TEXT,
+    discard_reason TEXT,
+    discarded_by   VARCHAR(80),
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at     TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX retry_commands_status_type_idx ON retry_commands (status, type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS retry_commands;
+-- +goose StatementEnd
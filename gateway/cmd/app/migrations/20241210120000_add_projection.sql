@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Documents of the user rentals view built from domain events, cars and payments are joined on read.
+CREATE TABLE projection_rentals
+(
+    rental_uid  uuid PRIMARY KEY,
+    username    VARCHAR(80)              NOT NULL,
+    car_uid     uuid                     NOT NULL,
+    payment_uid uuid                     NOT NULL,
+    date_from   DATE                     NOT NULL,
+    date_to     DATE                     NOT NULL,
+    status      VARCHAR(20)              NOT NULL,
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX projection_rentals_username_date_from_idx ON projection_rentals (username, date_from);
+
+CREATE TABLE projection_cars
+(
+    car_uid             uuid PRIMARY KEY,
+    brand               VARCHAR(80)              NOT NULL,
+    model               VARCHAR(80)              NOT NULL,
+    registration_number VARCHAR(20)              NOT NULL,
+    updated_at          TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Payment info is kept as the gateway returns it.
+CREATE TABLE projection_payments
+(
+    payment_uid uuid PRIMARY KEY,
+    info        jsonb                    NOT NULL,
+    updated_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Users whose documents were loaded from the services, they are loaded again after the user
+-- changes rentals through the gateway.
+CREATE TABLE projection_users
+(
+    username       VARCHAR(80) PRIMARY KEY,
+    seeded_at      TIMESTAMP WITH TIME ZONE,
+    invalidated_at TIMESTAMP WITH TIME ZONE
+);
+
+-- The newest applied domain event, the table has a single row.
+CREATE TABLE projection_marker
+(
+    id          INT PRIMARY KEY,
+    event_id    uuid                     NOT NULL,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS projection_marker;
+DROP TABLE IF EXISTS projection_users;
+DROP TABLE IF EXISTS projection_payments;
+DROP TABLE IF EXISTS projection_cars;
+DROP INDEX IF EXISTS projection_rentals_username_date_from_idx;
+DROP TABLE IF EXISTS projection_rentals;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Documents are updated by the events after the first load, users are no longer loaded again after their changes.
+ALTER TABLE projection_users
+    DROP COLUMN IF EXISTS invalidated_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE projection_users
+    ADD COLUMN invalidated_at TIMESTAMP WITH TIME ZONE;
+-- +goose StatementEnd
@@ -11,3 +11,8 @@ func GetUsername(ctx context.Context) string {
 	value, _ := ctx.Value(usernameKey).(string)
 	return value
 }
+
+func GetRoles(ctx context.Context) []string {
+	value, _ := ctx.Value(rolesKey).([]string)
+	return value
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/MicahParks/keyfunc"
@@ -14,9 +15,12 @@ import (
 const (
 	bearerKey   = "bearer"
 	usernameKey = "username"
+	rolesKey    = "roles"
+
+	adminPathPrefix = "/api/v1/admin/"
 )
 
-func CreateMiddleware(jwksURL string) echo.MiddlewareFunc {
+func CreateMiddleware(jwksURL, adminRole string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			if c.Path() == "/manage/health" {
@@ -35,15 +39,20 @@ func CreateMiddleware(jwksURL string) echo.MiddlewareFunc {
 
 			token := strings.TrimPrefix(header, prefix)
 
-			username, err := parseToken(token, jwksURL)
+			username, roles, err := parseToken(token, jwksURL)
 			fmt.Println(username, err)
 			if err != nil {
 				return c.NoContent(http.StatusUnauthorized)
 			}
 
+			if strings.HasPrefix(c.Path(), adminPathPrefix) && !slices.Contains(roles, adminRole) {
+				return c.NoContent(http.StatusForbidden)
+			}
+
 			ctx := c.Request().Context()
 			ctx = context.WithValue(ctx, bearerKey, token)
 			ctx = context.WithValue(ctx, usernameKey, username)
+			ctx = context.WithValue(ctx, rolesKey, roles)
 
 			c.SetRequest(c.Request().WithContext(ctx))
 
@@ -52,26 +61,48 @@ func CreateMiddleware(jwksURL string) echo.MiddlewareFunc {
 	}
 }
 
-func parseToken(token, jwksURL string) (string, error) {
+func parseToken(token, jwksURL string) (string, []string, error) {
 	jwks, err := keyfunc.Get(jwksURL, keyfunc.Options{})
 	if err != nil {
-		return "", fmt.Errorf("get keyfunc: %w", err)
+		return "", nil, fmt.Errorf("get keyfunc: %w", err)
 	}
 
 	parsedToken, err := jwt.Parse(token, jwks.Keyfunc)
 	if err != nil {
-		return "", fmt.Errorf("parse jwt: %w", err)
+		return "", nil, fmt.Errorf("parse jwt: %w", err)
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", fmt.Errorf("invalid token claims type")
+		return "", nil, fmt.Errorf("invalid token claims type")
 	}
 
 	username, ok := claims["preferred_username"].(string)
 	if !ok {
-		return "", fmt.Errorf("missing username in claims")
+		return "", nil, fmt.Errorf("missing username in claims")
+	}
+
+	return username, parseRoles(claims), nil
+}
+
+// parseRoles returns realm roles in the keycloak format: {"realm_access": {"roles": [...]}}.
+func parseRoles(claims jwt.MapClaims) []string {
+	realmAccess, ok := claims["realm_access"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	rawRoles, ok := realmAccess["roles"].([]any)
+	if !ok {
+		return nil
+	}
+
+	roles := make([]string, 0, len(rawRoles))
+	for _, rawRole := range rawRoles {
+		if role, ok := rawRole.(string); ok {
+			roles = append(roles, role)
+		}
 	}
 
-	return username, nil
+	return roles
 }
@@ -161,7 +161,7 @@ func (c *CarsServiceClient) Unbook(ctx context.Context, carUid uuid.UUID) error
 	resp.Body.Close()
 
 	switch resp.StatusCode {
-	case http.StatusNotFound, http.StatusInternalServerError, http.StatusConflict:
+	case http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError, http.StatusConflict:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
@@ -178,6 +178,7 @@ func (c *CarsServiceClient) Unbook(ctx context.Context, carUid uuid.UUID) error
 	}
 }
 
+// RetryUnbook releases the car on behalf of the retry queue, releasing an available car succeeds.
 func (c *CarsServiceClient) RetryUnbook(ctx context.Context, carUid uuid.UUID) error {
 	resp, err := c.c.Unbook(ctx, carUid, func(ctx context.Context, req *http.Request) error {
 		req.Header.Add("Service-Password", c.servicePassword)
@@ -194,7 +195,7 @@ func (c *CarsServiceClient) RetryUnbook(ctx context.Context, carUid uuid.UUID) e
 	resp.Body.Close()
 
 	switch resp.StatusCode {
-	case http.StatusNotFound, http.StatusInternalServerError, http.StatusConflict:
+	case http.StatusNotFound, http.StatusInternalServerError:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
@@ -204,9 +205,90 @@ func (c *CarsServiceClient) RetryUnbook(ctx context.Context, carUid uuid.UUID) e
 		internalError.StatusCode = resp.StatusCode
 
 		return internalError
-	case http.StatusNoContent:
+	case http.StatusNoContent, http.StatusConflict:
+		// Conflict means the car isn't booked, it is released already, e.g. by a redelivered command.
 		return nil
 	default:
 		return fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
 	}
 }
+
+func (c *CarsServiceClient) Create(ctx context.Context, req cars_service.CarRequest) (*cars_service.CarResponse, error) {
+	resp, err := c.c.Create(ctx, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("create car: %w", err)
+	}
+
+	return parseAdminCarResponse(resp, http.StatusCreated)
+}
+
+func (c *CarsServiceClient) Update(ctx context.Context, carUid uuid.UUID, req cars_service.CarRequest) (*cars_service.CarResponse, error) {
+	resp, err := c.c.Update(ctx, carUid, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("update car: %w", err)
+	}
+
+	return parseAdminCarResponse(resp, http.StatusOK)
+}
+
+func (c *CarsServiceClient) Archive(ctx context.Context, carUid uuid.UUID) (*cars_service.CarResponse, error) {
+	resp, err := c.c.Archive(ctx, carUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("archive car: %w", err)
+	}
+
+	return parseAdminCarResponse(resp, http.StatusOK)
+}
+
+func (c *CarsServiceClient) Restore(ctx context.Context, carUid uuid.UUID) (*cars_service.CarResponse, error) {
+	resp, err := c.c.Restore(ctx, carUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("restore car: %w", err)
+	}
+
+	return parseAdminCarResponse(resp, http.StatusOK)
+}
+
+func parseAdminCarResponse(resp *http.Response, successStatus int) (*cars_service.CarResponse, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusForbidden:
+		return nil, models.InternalError{
+			Message:    http.StatusText(resp.StatusCode),
+			StatusCode: resp.StatusCode,
+		}
+	case http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case successStatus:
+		var carResponse cars_service.CarResponse
+		err := json.Unmarshal(body, &carResponse)
+		if err != nil {
+			return nil, fmt.Errorf("parse car response: %w", err)
+		}
+
+		return &carResponse, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
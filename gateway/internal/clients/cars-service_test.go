@@ -94,3 +94,49 @@ func TestPaymentsLogic_Get(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestCarsServiceClient_RetryUnbook(t *testing.T) {
+	t.Run("car is not booked", func(t *testing.T) {
+		ctx := context.Background()
+
+		client := mocks.NewClientInterface(t)
+		data := `
+		{
+			"message": "car is not booked"
+		}
+		`
+		buf := bytes.NewBufferString(data)
+		resp := &http.Response{
+			StatusCode: http.StatusConflict,
+			Body:       io.NopCloser(buf),
+		}
+
+		client.EXPECT().Unbook(ctx, uuid.UUID{}, mock.Anything).Return(resp, nil)
+
+		c := NewCarsServiceClient(client, "")
+		err := c.RetryUnbook(ctx, uuid.UUID{})
+		require.NoError(t, err)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		ctx := context.Background()
+
+		client := mocks.NewClientInterface(t)
+		data := `
+		{
+			"message": "service error"
+		}
+		`
+		buf := bytes.NewBufferString(data)
+		resp := &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       io.NopCloser(buf),
+		}
+
+		client.EXPECT().Unbook(ctx, uuid.UUID{}, mock.Anything).Return(resp, nil)
+
+		c := NewCarsServiceClient(client, "")
+		err := c.RetryUnbook(ctx, uuid.UUID{})
+		require.Error(t, err)
+	})
+}
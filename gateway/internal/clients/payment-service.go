@@ -30,6 +30,24 @@ func (c *PaymentServiceClient) Create(ctx context.Context, payment payment_servi
 		return nil, fmt.Errorf("create payment: %w", err)
 	}
 
+	return parseCreateResponse(resp)
+}
+
+// RetryCreate creates the payment on behalf of the gateway for payment.Username, it is used by the retry queue
+// outside of user requests.
+func (c *PaymentServiceClient) RetryCreate(ctx context.Context, payment payment_service.CreatePaymentRequest) (*payment_service.PaymentInfo, error) {
+	resp, err := c.c.Create(ctx, payment, func(ctx context.Context, req *http.Request) error {
+		req.Header.Add("Service-Password", c.servicePassword)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create payment: %w", err)
+	}
+
+	return parseCreateResponse(resp)
+}
+
+func parseCreateResponse(resp *http.Response) (*payment_service.PaymentInfo, error) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("read response body: %w", err)
@@ -45,7 +63,7 @@ func (c *PaymentServiceClient) Create(ctx context.Context, payment payment_servi
 		}
 
 		return nil, validationError
-	case http.StatusInternalServerError:
+	case http.StatusPaymentRequired, http.StatusInternalServerError, http.StatusServiceUnavailable:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
@@ -68,73 +86,160 @@ func (c *PaymentServiceClient) Create(ctx context.Context, payment payment_servi
 	}
 }
 
-func (c *PaymentServiceClient) Cancel(ctx context.Context, paymentUid uuid.UUID) error {
-	resp, err := c.c.Cancel(ctx, paymentUid, withToken(ctx))
+// Cancel refunds the payment by the cancellation policy if params contain the rental start, otherwise voids it.
+func (c *PaymentServiceClient) Cancel(ctx context.Context, paymentUid uuid.UUID, params *payment_service.CancelParams) (*payment_service.PaymentInfo, error) {
+	resp, err := c.c.Cancel(ctx, paymentUid, params, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("cancel payment: %w", err)
+	}
+
+	return parsePaymentResponse(resp)
+}
+
+func (c *PaymentServiceClient) RetryCancel(ctx context.Context, paymentUid uuid.UUID, params *payment_service.CancelParams) error {
+	resp, err := c.c.Cancel(ctx, paymentUid, params, func(ctx context.Context, req *http.Request) error {
+		req.Header.Add("Service-Password", c.servicePassword)
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("cancel payment: %w", err)
 	}
 
+	_, err = parsePaymentResponse(resp)
+	return err
+}
+
+// Capture charges the amount authorized at booking and links the payment to the rental in the ledger.
+func (c *PaymentServiceClient) Capture(ctx context.Context, paymentUid, rentalUid uuid.UUID) (*payment_service.PaymentInfo, error) {
+	resp, err := c.c.Capture(ctx, paymentUid, &payment_service.CaptureParams{RentalUid: &rentalUid}, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("capture payment: %w", err)
+	}
+
+	return parsePaymentResponse(resp)
+}
+
+// RetryCapture captures the payment on behalf of the gateway, it is used by the retry queue outside of user requests.
+func (c *PaymentServiceClient) RetryCapture(ctx context.Context, paymentUid uuid.UUID, rentalUid *uuid.UUID) error {
+	resp, err := c.c.Capture(ctx, paymentUid, &payment_service.CaptureParams{RentalUid: rentalUid}, func(ctx context.Context, req *http.Request) error {
+		req.Header.Add("Service-Password", c.servicePassword)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("capture payment: %w", err)
+	}
+
+	_, err = parsePaymentResponse(resp)
+	return err
+}
+
+// Confirm completes the authorization after the customer passed 3-D Secure.
+func (c *PaymentServiceClient) Confirm(ctx context.Context, paymentUid uuid.UUID) (*payment_service.PaymentInfo, error) {
+	resp, err := c.c.Confirm(ctx, paymentUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("confirm payment: %w", err)
+	}
+
+	return parsePaymentResponse(resp)
+}
+
+// Refund returns a part of the payment, the authorized amount is partially captured if it wasn't captured yet.
+func (c *PaymentServiceClient) Refund(ctx context.Context, paymentUid uuid.UUID, req payment_service.RefundRequest) (*payment_service.PaymentInfo, error) {
+	resp, err := c.c.Refund(ctx, paymentUid, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("refund payment: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusBadRequest {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("read response body: %w", err)
+		}
+		resp.Body.Close()
+
+		var validationError models.ValidationError
+		err = json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	}
+
+	return parsePaymentResponse(resp)
+}
+
+func parsePaymentResponse(resp *http.Response) (*payment_service.PaymentInfo, error) {
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("read response body: %w", err)
+		return nil, fmt.Errorf("read response body: %w", err)
 	}
 	resp.Body.Close()
 
 	switch resp.StatusCode {
-	case http.StatusNotFound, http.StatusInternalServerError:
+	case http.StatusPaymentRequired, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusInternalServerError, http.StatusServiceUnavailable:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
-			return fmt.Errorf("parse service error: %w", err)
+			return nil, fmt.Errorf("parse service error: %w", err)
 		}
 
 		internalError.StatusCode = resp.StatusCode
 
-		return internalError
-	case http.StatusNoContent:
-		return nil
+		return nil, internalError
+	case http.StatusOK:
+		var paymentInfo payment_service.PaymentInfo
+		err := json.Unmarshal(body, &paymentInfo)
+		if err != nil {
+			return nil, fmt.Errorf("parse payment info: %w", err)
+		}
+
+		return &paymentInfo, nil
 	default:
-		return fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
 	}
 }
 
-func (c *PaymentServiceClient) RetryCancel(ctx context.Context, paymentUid uuid.UUID) error {
-	resp, err := c.c.Cancel(ctx, paymentUid, func(ctx context.Context, req *http.Request) error {
-		req.Header.Add("Service-Password", c.servicePassword)
-		return nil
-	})
+func (c *PaymentServiceClient) Get(ctx context.Context, paymentUid uuid.UUID) (*payment_service.PaymentInfo, error) {
+	resp, err := c.c.Get(ctx, paymentUid, withToken(ctx))
 	if err != nil {
-		return fmt.Errorf("cancel payment: %w", err)
+		return nil, fmt.Errorf("get payment: %w", err)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("read response body: %w", err)
+		return nil, fmt.Errorf("read response body: %w", err)
 	}
 	resp.Body.Close()
 
 	switch resp.StatusCode {
-	case http.StatusNotFound, http.StatusInternalServerError:
+	case http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
-			return fmt.Errorf("parse service error: %w", err)
+			return nil, fmt.Errorf("parse service error: %w", err)
 		}
 
 		internalError.StatusCode = resp.StatusCode
 
-		return internalError
-	case http.StatusNoContent:
-		return nil
+		return nil, internalError
+	case http.StatusOK:
+		var paymentInfo payment_service.PaymentInfo
+		err := json.Unmarshal(body, &paymentInfo)
+		if err != nil {
+			return nil, fmt.Errorf("parse payment info: %w", err)
+		}
+
+		return &paymentInfo, nil
 	default:
-		return fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
 	}
 }
 
-func (c *PaymentServiceClient) Get(ctx context.Context, paymentUid uuid.UUID) (*payment_service.PaymentInfo, error) {
-	resp, err := c.c.Get(ctx, paymentUid, withToken(ctx))
+func (c *PaymentServiceClient) CreatePromoCode(ctx context.Context, req payment_service.PromoCodeRequest) (*payment_service.PromoCodeResponse, error) {
+	resp, err := c.c.CreatePromoCode(ctx, req, withToken(ctx))
 	if err != nil {
-		return nil, fmt.Errorf("get payment: %w", err)
+		return nil, fmt.Errorf("create promo code: %w", err)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -144,7 +249,61 @@ func (c *PaymentServiceClient) Get(ctx context.Context, paymentUid uuid.UUID) (*
 	resp.Body.Close()
 
 	switch resp.StatusCode {
-	case http.StatusNotFound, http.StatusInternalServerError:
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusForbidden:
+		return nil, models.InternalError{
+			Message:    http.StatusText(resp.StatusCode),
+			StatusCode: resp.StatusCode,
+		}
+	case http.StatusConflict, http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusCreated:
+		var promo payment_service.PromoCodeResponse
+		err := json.Unmarshal(body, &promo)
+		if err != nil {
+			return nil, fmt.Errorf("parse promo code: %w", err)
+		}
+
+		return &promo, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *PaymentServiceClient) ListPromoCodes(ctx context.Context) ([]payment_service.PromoCodeResponse, error) {
+	resp, err := c.c.ListPromoCodes(ctx, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list promo codes: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusForbidden:
+		return nil, models.InternalError{
+			Message:    http.StatusText(resp.StatusCode),
+			StatusCode: resp.StatusCode,
+		}
+	case http.StatusInternalServerError:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
@@ -155,13 +314,265 @@ func (c *PaymentServiceClient) Get(ctx context.Context, paymentUid uuid.UUID) (*
 
 		return nil, internalError
 	case http.StatusOK:
-		var paymentInfo payment_service.PaymentInfo
-		err := json.Unmarshal(body, &paymentInfo)
+		var promos []payment_service.PromoCodeResponse
+		err := json.Unmarshal(body, &promos)
 		if err != nil {
-			return nil, fmt.Errorf("parse payment info: %w", err)
+			return nil, fmt.Errorf("parse promo codes: %w", err)
 		}
 
-		return &paymentInfo, nil
+		return promos, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *PaymentServiceClient) GetRentalLedger(ctx context.Context, rentalUid uuid.UUID) (*payment_service.RentalLedger, error) {
+	resp, err := c.c.GetRentalLedger(ctx, rentalUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get rental ledger: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var ledger payment_service.RentalLedger
+		err := json.Unmarshal(body, &ledger)
+		if err != nil {
+			return nil, fmt.Errorf("parse rental ledger: %w", err)
+		}
+
+		return &ledger, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+// GetInvoice returns the PDF invoice of the rental or nil if it isn't generated yet.
+func (c *PaymentServiceClient) GetInvoice(ctx context.Context, rentalUid uuid.UUID) ([]byte, error) {
+	resp, err := c.c.GetInvoice(ctx, rentalUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get invoice: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusNotFound:
+		return nil, nil
+	case http.StatusOK:
+		return body, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+// GenerateInvoice returns the invoice generated before if there is one.
+func (c *PaymentServiceClient) GenerateInvoice(ctx context.Context, rentalUid uuid.UUID, req payment_service.InvoiceRequest) ([]byte, error) {
+	resp, err := c.c.GenerateInvoice(ctx, rentalUid, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("generate invoice: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse validation error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusForbidden, http.StatusInternalServerError, http.StatusConflict:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		return body, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+// Convert recalculates amounts in the currency for display only.
+func (c *PaymentServiceClient) Convert(ctx context.Context, amounts []payment_service.Money, currency string) ([]payment_service.Money, error) {
+	resp, err := c.c.Convert(ctx, payment_service.ConvertRequest{
+		Amounts:  amounts,
+		Currency: currency,
+	}, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("convert amounts: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusNotFound:
+		// The rate of the requested currency is missing, so it's the client's mistake.
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		validationError.Errors = []models.ErrorDescription{{Field: "X-Currency", Error: "unknown currency"}}
+
+		return nil, validationError
+	case http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var converted []payment_service.Money
+		err := json.Unmarshal(body, &converted)
+		if err != nil {
+			return nil, fmt.Errorf("parse converted amounts: %w", err)
+		}
+
+		return converted, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *PaymentServiceClient) ListExchangeRates(ctx context.Context) ([]payment_service.ExchangeRate, error) {
+	resp, err := c.c.ListExchangeRates(ctx, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list exchange rates: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var rates []payment_service.ExchangeRate
+		err := json.Unmarshal(body, &rates)
+		if err != nil {
+			return nil, fmt.Errorf("parse exchange rates: %w", err)
+		}
+
+		return rates, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *PaymentServiceClient) SetExchangeRate(ctx context.Context, currency string, req payment_service.ExchangeRateRequest) (*payment_service.ExchangeRate, error) {
+	resp, err := c.c.SetExchangeRate(ctx, currency, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("set exchange rate: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusForbidden:
+		return nil, models.InternalError{
+			Message:    http.StatusText(resp.StatusCode),
+			StatusCode: resp.StatusCode,
+		}
+	case http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var rate payment_service.ExchangeRate
+		err := json.Unmarshal(body, &rate)
+		if err != nil {
+			return nil, fmt.Errorf("parse exchange rate: %w", err)
+		}
+
+		return &rate, nil
 	default:
 		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
 	}
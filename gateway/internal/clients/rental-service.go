@@ -22,8 +22,8 @@ func NewRentalServiceClient(c *rental_service.Client) *RentalServiceClient {
 	}
 }
 
-func (c *RentalServiceClient) List(ctx context.Context, userName string) ([]rental_service.RentalResponse, error) {
-	resp, err := c.c.GetUserRentals(ctx, withToken(ctx))
+func (c *RentalServiceClient) List(ctx context.Context, userName string, params *rental_service.GetUserRentalsParams) (*rental_service.RentalPaginationResponse, error) {
+	resp, err := c.c.GetUserRentals(ctx, params, withToken(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("list user rentals: %w", err)
 	}
@@ -35,6 +35,14 @@ func (c *RentalServiceClient) List(ctx context.Context, userName string) ([]rent
 	resp.Body.Close()
 
 	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
 	case http.StatusInternalServerError:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
@@ -44,13 +52,13 @@ func (c *RentalServiceClient) List(ctx context.Context, userName string) ([]rent
 
 		return nil, internalError
 	case http.StatusOK:
-		var rentals []rental_service.RentalResponse
+		var rentals rental_service.RentalPaginationResponse
 		err := json.Unmarshal(body, &rentals)
 		if err != nil {
 			return nil, fmt.Errorf("parse rentals info: %w", err)
 		}
 
-		return rentals, nil
+		return &rentals, nil
 	default:
 		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
 	}
@@ -136,8 +144,8 @@ func (c *RentalServiceClient) Get(ctx context.Context, userName string, rentalUi
 	}
 }
 
-func (c *RentalServiceClient) Cancel(ctx context.Context, userName string, rentalUid uuid.UUID) error {
-	resp, err := c.c.Cancel(ctx, rentalUid, withToken(ctx))
+func (c *RentalServiceClient) Cancel(ctx context.Context, userName string, rentalUid uuid.UUID, reason *string) error {
+	resp, err := c.c.Cancel(ctx, rentalUid, &rental_service.CancelParams{Reason: reason}, withToken(ctx))
 	if err != nil {
 		return fmt.Errorf("cancel user rental: %w", err)
 	}
@@ -149,7 +157,7 @@ func (c *RentalServiceClient) Cancel(ctx context.Context, userName string, renta
 	resp.Body.Close()
 
 	switch resp.StatusCode {
-	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound:
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
@@ -166,10 +174,10 @@ func (c *RentalServiceClient) Cancel(ctx context.Context, userName string, renta
 	}
 }
 
-func (c *RentalServiceClient) Finish(ctx context.Context, userName string, rentalUid uuid.UUID) error {
-	resp, err := c.c.Finish(ctx, rentalUid, withToken(ctx))
+func (c *RentalServiceClient) Start(ctx context.Context, userName string, rentalUid uuid.UUID, readings rental_service.CarReadings) error {
+	resp, err := c.c.Start(ctx, rentalUid, readings, withToken(ctx))
 	if err != nil {
-		return fmt.Errorf("finish user rental: %w", err)
+		return fmt.Errorf("start user rental: %w", err)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -178,8 +186,408 @@ func (c *RentalServiceClient) Finish(ctx context.Context, userName string, renta
 	}
 	resp.Body.Close()
 
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return fmt.Errorf("parse service error: %w", err)
+		}
+
+		return validationError
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return internalError
+	case http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *RentalServiceClient) Finish(ctx context.Context, userName string, rentalUid uuid.UUID, req rental_service.FinishRentalRequest) (*rental_service.RentalResponse, error) {
+	resp, err := c.c.Finish(ctx, rentalUid, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("finish user rental: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var rental rental_service.RentalResponse
+		err := json.Unmarshal(body, &rental)
+		if err != nil {
+			return nil, fmt.Errorf("parse rental: %w", err)
+		}
+
+		return &rental, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+// ChangeDates moves the end date of the rental and returns the difference of prices to charge or refund.
+func (c *RentalServiceClient) ChangeDates(ctx context.Context, userName string, rentalUid uuid.UUID, req rental_service.ChangeRentalRequest) (*rental_service.RentalChange, error) {
+	resp, err := c.c.ChangeDates(ctx, rentalUid, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("change user rental dates: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var change rental_service.RentalChange
+		err := json.Unmarshal(body, &change)
+		if err != nil {
+			return nil, fmt.Errorf("parse rental change: %w", err)
+		}
+
+		return &change, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *RentalServiceClient) RevertChange(ctx context.Context, userName string, rentalUid, changeUid uuid.UUID) error {
+	resp, err := c.c.RevertChange(ctx, rentalUid, changeUid, withToken(ctx))
+	if err != nil {
+		return fmt.Errorf("revert user rental change: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return internalError
+	case http.StatusNoContent:
+		return nil
+	default:
+		return fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *RentalServiceClient) GetHistory(ctx context.Context, userName string, rentalUid uuid.UUID) ([]rental_service.RentalEvent, error) {
+	resp, err := c.c.GetHistory(ctx, rentalUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get rental history: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var events []rental_service.RentalEvent
+		err := json.Unmarshal(body, &events)
+		if err != nil {
+			return nil, fmt.Errorf("parse rental history: %w", err)
+		}
+
+		return events, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *RentalServiceClient) Quote(ctx context.Context, req rental_service.QuoteRequest) (*rental_service.QuoteResponse, error) {
+	resp, err := c.c.CreateQuote(ctx, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("quote rental: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusBadRequest:
+		var serviceError models.ValidationError
+		err := json.Unmarshal(body, &serviceError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, serviceError
+	case http.StatusOK:
+		var quote rental_service.QuoteResponse
+		err := json.Unmarshal(body, &quote)
+		if err != nil {
+			return nil, fmt.Errorf("parse quote: %w", err)
+		}
+
+		return &quote, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *RentalServiceClient) GetQuote(ctx context.Context, quoteUid uuid.UUID) (*rental_service.QuoteResponse, error) {
+	resp, err := c.c.GetQuote(ctx, quoteUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get quote: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusNotFound:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var quote rental_service.QuoteResponse
+		err := json.Unmarshal(body, &quote)
+		if err != nil {
+			return nil, fmt.Errorf("parse quote: %w", err)
+		}
+
+		return &quote, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+// Search returns a page of rentals of all users, the token must belong to an admin.
+func (c *RentalServiceClient) Search(ctx context.Context, params *rental_service.SearchRentalsParams) (*rental_service.RentalPaginationResponse, error) {
+	resp, err := c.c.SearchRentals(ctx, params, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("search rentals: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusInternalServerError, http.StatusForbidden:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var rentals rental_service.RentalPaginationResponse
+		err := json.Unmarshal(body, &rentals)
+		if err != nil {
+			return nil, fmt.Errorf("parse rentals info: %w", err)
+		}
+
+		return &rentals, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+// GetAny returns the rental of any user, the token must belong to an admin.
+func (c *RentalServiceClient) GetAny(ctx context.Context, rentalUid uuid.UUID) (*rental_service.RentalResponse, error) {
+	resp, err := c.c.GetAnyRental(ctx, rentalUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get rental: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
 	switch resp.StatusCode {
 	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var rental rental_service.RentalResponse
+		err := json.Unmarshal(body, &rental)
+		if err != nil {
+			return nil, fmt.Errorf("parse rental info: %w", err)
+		}
+
+		return &rental, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+func (c *RentalServiceClient) GetAnyHistory(ctx context.Context, rentalUid uuid.UUID) ([]rental_service.RentalEvent, error) {
+	resp, err := c.c.GetAnyRentalHistory(ctx, rentalUid, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get rental history: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var events []rental_service.RentalEvent
+		err := json.Unmarshal(body, &events)
+		if err != nil {
+			return nil, fmt.Errorf("parse rental history: %w", err)
+		}
+
+		return events, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
+
+// ForceCancel cancels the rental on behalf of its owner, the reason is recorded in the rental history.
+func (c *RentalServiceClient) ForceCancel(ctx context.Context, rentalUid uuid.UUID, reason string) error {
+	resp, err := c.c.ForceCancel(ctx, rentalUid, rental_service.ForceCancelRequest{Reason: reason}, withToken(ctx))
+	if err != nil {
+		return fmt.Errorf("force cancel rental: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return fmt.Errorf("parse service error: %w", err)
+		}
+
+		return validationError
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
 		var internalError models.InternalError
 		err := json.Unmarshal(body, &internalError)
 		if err != nil {
@@ -195,3 +603,48 @@ func (c *RentalServiceClient) Finish(ctx context.Context, userName string, renta
 		return fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
 	}
 }
+
+// ForceFinish records the car return on behalf of the rental owner, the reason is recorded in the rental history.
+func (c *RentalServiceClient) ForceFinish(ctx context.Context, rentalUid uuid.UUID, req rental_service.ForceFinishRequest) (*rental_service.RentalResponse, error) {
+	resp, err := c.c.ForceFinish(ctx, rentalUid, req, withToken(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("force finish rental: %w", err)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		var validationError models.ValidationError
+		err := json.Unmarshal(body, &validationError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		return nil, validationError
+	case http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound, http.StatusConflict:
+		var internalError models.InternalError
+		err := json.Unmarshal(body, &internalError)
+		if err != nil {
+			return nil, fmt.Errorf("parse service error: %w", err)
+		}
+
+		internalError.StatusCode = resp.StatusCode
+
+		return nil, internalError
+	case http.StatusOK:
+		var rental rental_service.RentalResponse
+		err := json.Unmarshal(body, &rental)
+		if err != nil {
+			return nil, fmt.Errorf("parse rental: %w", err)
+		}
+
+		return &rental, nil
+	default:
+		return nil, fmt.Errorf("unknown response %d: %w", resp.StatusCode, models.ErrUnknownResponseStatus)
+	}
+}
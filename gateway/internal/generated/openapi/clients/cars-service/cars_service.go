@@ -4,6 +4,7 @@
 package cars_service
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -16,16 +17,98 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CarImportRowStatus.
+const (
+	CREATED  CarImportRowStatus = "CREATED"
+	REJECTED CarImportRowStatus = "REJECTED"
+	UPDATED  CarImportRowStatus = "UPDATED"
+)
+
+// Defines values for CarRequestType.
+const (
+	CarRequestTypeMINIVAN  CarRequestType = "MINIVAN"
+	CarRequestTypeROADSTER CarRequestType = "ROADSTER"
+	CarRequestTypeSEDAN    CarRequestType = "SEDAN"
+	CarRequestTypeSUV      CarRequestType = "SUV"
+)
+
 // Defines values for CarResponseType.
 const (
-	MINIVAN  CarResponseType = "MINIVAN"
-	ROADSTER CarResponseType = "ROADSTER"
-	SEDAN    CarResponseType = "SEDAN"
-	SUV      CarResponseType = "SUV"
+	CarResponseTypeMINIVAN  CarResponseType = "MINIVAN"
+	CarResponseTypeROADSTER CarResponseType = "ROADSTER"
+	CarResponseTypeSEDAN    CarResponseType = "SEDAN"
+	CarResponseTypeSUV      CarResponseType = "SUV"
+)
+
+// Defines values for ExportParamsFormat.
+const (
+	Csv  ExportParamsFormat = "csv"
+	Json ExportParamsFormat = "json"
 )
 
+// CarImportReport defines model for CarImportReport.
+type CarImportReport struct {
+	// Created Количество добавленных автомобилей
+	Created int `json:"created"`
+
+	// Rejected Количество отклоненных строк
+	Rejected int            `json:"rejected"`
+	Rows     []CarImportRow `json:"rows"`
+
+	// Updated Количество обновленных автомобилей
+	Updated int `json:"updated"`
+}
+
+// CarImportRow defines model for CarImportRow.
+type CarImportRow struct {
+	// Errors Причины отклонения строки
+	Errors []ErrorDescription `json:"errors"`
+
+	// RegistrationNumber Регистрационный номер автомобиля
+	RegistrationNumber string `json:"registrationNumber"`
+
+	// Row Номер строки в загруженном файле, начиная с 1
+	Row int `json:"row"`
+
+	// Status Результат обработки строки
+	Status CarImportRowStatus `json:"status"`
+}
+
+// CarImportRowStatus Результат обработки строки
+type CarImportRowStatus string
+
+// CarRequest defines model for CarRequest.
+type CarRequest struct {
+	// Brand Марка автомобиля
+	Brand string `json:"brand"`
+
+	// Currency Код валюты ISO 4217, по умолчанию - валюта сервиса
+	Currency *string `json:"currency,omitempty"`
+
+	// Model Модель автомобиля
+	Model string `json:"model"`
+
+	// Power Мощность автомобиля в лошадиных силах
+	Power *int `json:"power,omitempty"`
+
+	// Price Цена автомобиля за сутки в минимальных единицах валюты (копейках)
+	Price int `json:"price"`
+
+	// RegistrationNumber Регистрационный номер автомобиля
+	RegistrationNumber string `json:"registrationNumber"`
+
+	// Type Тип автомобиля
+	Type CarRequestType `json:"type"`
+}
+
+// CarRequestType Тип автомобиля
+type CarRequestType string
+
 // CarResponse defines model for CarResponse.
 type CarResponse struct {
+	// Archived Флаг, указывающий что автомобиль убран в архив
+	Archived *bool `json:"archived,omitempty"`
+
 	// Available Флаг, указывающий что автомобиль доступен для бронирования
 	Available bool `json:"available"`
 
@@ -35,13 +118,16 @@ type CarResponse struct {
 	// CarUid UUID автомобиля
 	CarUid openapi_types.UUID `json:"carUid"`
 
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
 	// Model Модель автомобиля
 	Model string `json:"model"`
 
 	// Power Мощность автомобиля в лошадиных силах
 	Power *int `json:"power,omitempty"`
 
-	// Price Цена автомобиля за сутки
+	// Price Цена автомобиля за сутки в минимальных единицах валюты (копейках)
 	Price int `json:"price"`
 
 	// RegistrationNumber Регистрационный номер автомобиля
@@ -89,6 +175,17 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ExportParams defines parameters for Export.
+type ExportParams struct {
+	Format *ExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportParamsFormat defines parameters for Export.
+type ExportParamsFormat string
+
+// ImportJSONBody defines parameters for Import.
+type ImportJSONBody = []CarRequest
+
 // ListParams defines parameters for List.
 type ListParams struct {
 	Page    *float32 `form:"page,omitempty" json:"page,omitempty"`
@@ -96,6 +193,15 @@ type ListParams struct {
 	ShowAll *bool    `form:"showAll,omitempty" json:"showAll,omitempty"`
 }
 
+// CreateJSONRequestBody defines body for Create for application/json ContentType.
+type CreateJSONRequestBody = CarRequest
+
+// ImportJSONRequestBody defines body for Import for application/json ContentType.
+type ImportJSONRequestBody = ImportJSONBody
+
+// UpdateJSONRequestBody defines body for Update for application/json ContentType.
+type UpdateJSONRequestBody = CarRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -169,6 +275,30 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// CreateWithBody request with any body
+	CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Export request
+	Export(ctx context.Context, params *ExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportWithBody request with any body
+	ImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Import(ctx context.Context, body ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWithBody request with any body
+	UpdateWithBody(ctx context.Context, carUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Update(ctx context.Context, carUid openapi_types.UUID, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Archive request
+	Archive(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Restore request
+	Restore(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// List request
 	List(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -185,6 +315,114 @@ type ClientInterface interface {
 	Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Export(ctx context.Context, params *ExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Import(ctx context.Context, body ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWithBody(ctx context.Context, carUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRequestWithBody(c.Server, carUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Update(ctx context.Context, carUid openapi_types.UUID, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRequest(c.Server, carUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Archive(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveRequest(c.Server, carUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Restore(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreRequest(c.Server, carUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) List(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListRequest(c.Server, params)
 	if err != nil {
@@ -245,8 +483,19 @@ func (c *Client) Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http
 	return c.Client.Do(req)
 }
 
-// NewListRequest generates requests for List
-func NewListRequest(server string, params *ListParams) (*http.Request, error) {
+// NewCreateRequest calls the generic Create builder with application/json body
+func NewCreateRequest(server string, body CreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRequestWithBody generates requests for Create with any type of body
+func NewCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -254,7 +503,7 @@ func NewListRequest(server string, params *ListParams) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/cars")
+	operationPath := fmt.Sprintf("/api/v1/admin/cars")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -264,44 +513,41 @@ func NewListRequest(server string, params *ListParams) (*http.Request, error) {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Page != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewExportRequest generates requests for Export
+func NewExportRequest(server string, params *ExportParams) (*http.Request, error) {
+	var err error
 
-		if params.Size != nil {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	operationPath := fmt.Sprintf("/api/v1/admin/cars/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.ShowAll != nil {
+	if params != nil {
+		queryValues := queryURL.Query()
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showAll", runtime.ParamLocationQuery, *params.ShowAll); err != nil {
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -326,23 +572,27 @@ func NewListRequest(server string, params *ListParams) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetRequest generates requests for Get
-func NewGetRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "car_uid", runtime.ParamLocationPath, carUid)
+// NewImportRequest calls the generic Import builder with application/json body
+func NewImportRequest(server string, body ImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewImportRequestWithBody generates requests for Import with any type of body
+func NewImportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/cars/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/cars/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -352,16 +602,29 @@ func NewGetRequest(server string, carUid openapi_types.UUID) (*http.Request, err
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewBookRequest generates requests for Book
-func NewBookRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
+// NewUpdateRequest calls the generic Update builder with application/json body
+func NewUpdateRequest(server string, carUid openapi_types.UUID, body UpdateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRequestWithBody(server, carUid, "application/json", bodyReader)
+}
+
+// NewUpdateRequestWithBody generates requests for Update with any type of body
+func NewUpdateRequestWithBody(server string, carUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -376,7 +639,7 @@ func NewBookRequest(server string, carUid openapi_types.UUID) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/cars/%s/book", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/cars/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -386,16 +649,18 @@ func NewBookRequest(server string, carUid openapi_types.UUID) (*http.Request, er
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUnbookRequest generates requests for Unbook
-func NewUnbookRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
+// NewArchiveRequest generates requests for Archive
+func NewArchiveRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -410,7 +675,7 @@ func NewUnbookRequest(server string, carUid openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/cars/%s/unbook", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/cars/%s/archive", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -428,16 +693,23 @@ func NewUnbookRequest(server string, carUid openapi_types.UUID) (*http.Request,
 	return req, nil
 }
 
-// NewLiveRequest generates requests for Live
-func NewLiveRequest(server string) (*http.Request, error) {
+// NewRestoreRequest generates requests for Restore
+func NewRestoreRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "car_uid", runtime.ParamLocationPath, carUid)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/manage/health")
+	operationPath := fmt.Sprintf("/api/v1/admin/cars/%s/restore", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -447,7 +719,7 @@ func NewLiveRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -455,17 +727,227 @@ func NewLiveRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
+// NewListRequest generates requests for List
+func NewListRequest(server string, params *ListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/cars")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ShowAll != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showAll", runtime.ParamLocationQuery, *params.ShowAll); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRequest generates requests for Get
+func NewGetRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "car_uid", runtime.ParamLocationPath, carUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/cars/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBookRequest generates requests for Book
+func NewBookRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "car_uid", runtime.ParamLocationPath, carUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/cars/%s/book", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnbookRequest generates requests for Unbook
+func NewUnbookRequest(server string, carUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "car_uid", runtime.ParamLocationPath, carUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/cars/%s/unbook", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLiveRequest generates requests for Live
+func NewLiveRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/manage/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -498,6 +980,30 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// CreateWithBodyWithResponse request with any body
+	CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	// ExportWithResponse request
+	ExportWithResponse(ctx context.Context, params *ExportParams, reqEditors ...RequestEditorFn) (*ExportResponse, error)
+
+	// ImportWithBodyWithResponse request with any body
+	ImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportResponse, error)
+
+	ImportWithResponse(ctx context.Context, body ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportResponse, error)
+
+	// UpdateWithBodyWithResponse request with any body
+	UpdateWithBodyWithResponse(ctx context.Context, carUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+
+	UpdateWithResponse(ctx context.Context, carUid openapi_types.UUID, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error)
+
+	// ArchiveWithResponse request
+	ArchiveWithResponse(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ArchiveResponse, error)
+
+	// RestoreWithResponse request
+	RestoreWithResponse(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreResponse, error)
+
 	// ListWithResponse request
 	ListWithResponse(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*ListResponse, error)
 
@@ -514,6 +1020,148 @@ type ClientWithResponsesInterface interface {
 	LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error)
 }
 
+type CreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CarResponse
+	JSON400      *ValidationErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CarResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CarImportReport
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CarResponse
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CarResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CarResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -587,6 +1235,7 @@ func (r BookResponse) StatusCode() int {
 type UnbookResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
 	JSON404      *ErrorResponse
 	JSON409      *ErrorResponse
 }
@@ -628,6 +1277,84 @@ func (r LiveResponse) StatusCode() int {
 	return 0
 }
 
+// CreateWithBodyWithResponse request with arbitrary body returning *CreateResponse
+func (c *ClientWithResponses) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.CreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.Create(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+// ExportWithResponse request returning *ExportResponse
+func (c *ClientWithResponses) ExportWithResponse(ctx context.Context, params *ExportParams, reqEditors ...RequestEditorFn) (*ExportResponse, error) {
+	rsp, err := c.Export(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportResponse(rsp)
+}
+
+// ImportWithBodyWithResponse request with arbitrary body returning *ImportResponse
+func (c *ClientWithResponses) ImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportResponse, error) {
+	rsp, err := c.ImportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportWithResponse(ctx context.Context, body ImportJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportResponse, error) {
+	rsp, err := c.Import(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportResponse(rsp)
+}
+
+// UpdateWithBodyWithResponse request with arbitrary body returning *UpdateResponse
+func (c *ClientWithResponses) UpdateWithBodyWithResponse(ctx context.Context, carUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	rsp, err := c.UpdateWithBody(ctx, carUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWithResponse(ctx context.Context, carUid openapi_types.UUID, body UpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateResponse, error) {
+	rsp, err := c.Update(ctx, carUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateResponse(rsp)
+}
+
+// ArchiveWithResponse request returning *ArchiveResponse
+func (c *ClientWithResponses) ArchiveWithResponse(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ArchiveResponse, error) {
+	rsp, err := c.Archive(ctx, carUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveResponse(rsp)
+}
+
+// RestoreWithResponse request returning *RestoreResponse
+func (c *ClientWithResponses) RestoreWithResponse(ctx context.Context, carUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RestoreResponse, error) {
+	rsp, err := c.Restore(ctx, carUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreResponse(rsp)
+}
+
 // ListWithResponse request returning *ListResponse
 func (c *ClientWithResponses) ListWithResponse(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*ListResponse, error) {
 	rsp, err := c.List(ctx, params, reqEditors...)
@@ -673,6 +1400,235 @@ func (c *ClientWithResponses) LiveWithResponse(ctx context.Context, reqEditors .
 	return ParseLiveResponse(rsp)
 }
 
+// ParseCreateResponse parses an HTTP response from a CreateWithResponse call
+func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportResponse parses an HTTP response from a ExportWithResponse call
+func ParseExportResponse(rsp *http.Response) (*ExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseImportResponse parses an HTTP response from a ImportWithResponse call
+func ParseImportResponse(rsp *http.Response) (*ImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CarImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateResponse parses an HTTP response from a UpdateWithResponse call
+func ParseUpdateResponse(rsp *http.Response) (*UpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseArchiveResponse parses an HTTP response from a ArchiveWithResponse call
+func ParseArchiveResponse(rsp *http.Response) (*ArchiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ArchiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreResponse parses an HTTP response from a RestoreWithResponse call
+func ParseRestoreResponse(rsp *http.Response) (*RestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListResponse parses an HTTP response from a ListWithResponse call
 func ParseListResponse(rsp *http.Response) (*ListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -793,6 +1749,13 @@ func ParseUnbookResponse(rsp *http.Response) (*UnbookResponse, error) {
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9,6 +9,8 @@ import (
 
 	http "net/http"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -27,6 +29,80 @@ func (_m *ClientInterface) EXPECT() *ClientInterface_Expecter {
 	return &ClientInterface_Expecter{mock: &_m.Mock}
 }
 
+// Archive provides a mock function with given fields: ctx, carUid, reqEditors
+func (_m *ClientInterface) Archive(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, carUid, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, carUid, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type ClientInterface_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) Archive(ctx interface{}, carUid interface{}, reqEditors ...interface{}) *ClientInterface_Archive_Call {
+	return &ClientInterface_Archive_Call{Call: _e.mock.On("Archive",
+		append([]interface{}{ctx, carUid}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_Archive_Call) Run(run func(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Archive_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_Archive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_Archive_Call) RunAndReturn(run func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Book provides a mock function with given fields: ctx, carUid, reqEditors
 func (_m *ClientInterface) Book(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -101,6 +177,229 @@ func (_c *ClientInterface_Book_Call) RunAndReturn(run func(context.Context, uuid
 	return _c
 }
 
+// Create provides a mock function with given fields: ctx, body, reqEditors
+func (_m *ClientInterface) Create(ctx context.Context, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ClientInterface_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - body cars_service.CarRequest
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) Create(ctx interface{}, body interface{}, reqEditors ...interface{}) *ClientInterface_Create_Call {
+	return &ClientInterface_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, body}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_Create_Call) Run(run func(ctx context.Context, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(cars_service.CarRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Create_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_Create_Call) RunAndReturn(run func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWithBody provides a mock function with given fields: ctx, contentType, body, reqEditors
+func (_m *ClientInterface) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contentType, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithBody")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, contentType, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_CreateWithBody_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithBody'
+type ClientInterface_CreateWithBody_Call struct {
+	*mock.Call
+}
+
+// CreateWithBody is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType string
+//   - body io.Reader
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) CreateWithBody(ctx interface{}, contentType interface{}, body interface{}, reqEditors ...interface{}) *ClientInterface_CreateWithBody_Call {
+	return &ClientInterface_CreateWithBody_Call{Call: _e.mock.On("CreateWithBody",
+		append([]interface{}{ctx, contentType, body}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_CreateWithBody_Call) Run(run func(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_CreateWithBody_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_CreateWithBody_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_CreateWithBody_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_CreateWithBody_Call) RunAndReturn(run func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_CreateWithBody_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: ctx, params, reqEditors
+func (_m *ClientInterface) Export(ctx context.Context, params *cars_service.ExportParams, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, params, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, params, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, params, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type ClientInterface_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *cars_service.ExportParams
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) Export(ctx interface{}, params interface{}, reqEditors ...interface{}) *ClientInterface_Export_Call {
+	return &ClientInterface_Export_Call{Call: _e.mock.On("Export",
+		append([]interface{}{ctx, params}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_Export_Call) Run(run func(ctx context.Context, params *cars_service.ExportParams, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*cars_service.ExportParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Export_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_Export_Call) RunAndReturn(run func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function with given fields: ctx, carUid, reqEditors
 func (_m *ClientInterface) Get(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -175,6 +474,155 @@ func (_c *ClientInterface_Get_Call) RunAndReturn(run func(context.Context, uuid.
 	return _c
 }
 
+// Import provides a mock function with given fields: ctx, body, reqEditors
+func (_m *ClientInterface) Import(ctx context.Context, body []cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type ClientInterface_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - body []cars_service.CarRequest
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) Import(ctx interface{}, body interface{}, reqEditors ...interface{}) *ClientInterface_Import_Call {
+	return &ClientInterface_Import_Call{Call: _e.mock.On("Import",
+		append([]interface{}{ctx, body}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_Import_Call) Run(run func(ctx context.Context, body []cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].([]cars_service.CarRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Import_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_Import_Call) RunAndReturn(run func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportWithBody provides a mock function with given fields: ctx, contentType, body, reqEditors
+func (_m *ClientInterface) ImportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contentType, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportWithBody")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, contentType, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_ImportWithBody_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportWithBody'
+type ClientInterface_ImportWithBody_Call struct {
+	*mock.Call
+}
+
+// ImportWithBody is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType string
+//   - body io.Reader
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) ImportWithBody(ctx interface{}, contentType interface{}, body interface{}, reqEditors ...interface{}) *ClientInterface_ImportWithBody_Call {
+	return &ClientInterface_ImportWithBody_Call{Call: _e.mock.On("ImportWithBody",
+		append([]interface{}{ctx, contentType, body}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_ImportWithBody_Call) Run(run func(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_ImportWithBody_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_ImportWithBody_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_ImportWithBody_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_ImportWithBody_Call) RunAndReturn(run func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_ImportWithBody_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function with given fields: ctx, params, reqEditors
 func (_m *ClientInterface) List(ctx context.Context, params *cars_service.ListParams, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -322,6 +770,80 @@ func (_c *ClientInterface_Live_Call) RunAndReturn(run func(context.Context, ...c
 	return _c
 }
 
+// Restore provides a mock function with given fields: ctx, carUid, reqEditors
+func (_m *ClientInterface) Restore(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, carUid, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, carUid, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type ClientInterface_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) Restore(ctx interface{}, carUid interface{}, reqEditors ...interface{}) *ClientInterface_Restore_Call {
+	return &ClientInterface_Restore_Call{Call: _e.mock.On("Restore",
+		append([]interface{}{ctx, carUid}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_Restore_Call) Run(run func(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Restore_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_Restore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_Restore_Call) RunAndReturn(run func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unbook provides a mock function with given fields: ctx, carUid, reqEditors
 func (_m *ClientInterface) Unbook(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -396,6 +918,157 @@ func (_c *ClientInterface_Unbook_Call) RunAndReturn(run func(context.Context, uu
 	return _c
 }
 
+// Update provides a mock function with given fields: ctx, carUid, body, reqEditors
+func (_m *ClientInterface) Update(ctx context.Context, carUid uuid.UUID, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, carUid, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, carUid, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ClientInterface_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - body cars_service.CarRequest
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) Update(ctx interface{}, carUid interface{}, body interface{}, reqEditors ...interface{}) *ClientInterface_Update_Call {
+	return &ClientInterface_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, carUid, body}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_Update_Call) Run(run func(ctx context.Context, carUid uuid.UUID, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(cars_service.CarRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Update_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWithBody provides a mock function with given fields: ctx, carUid, contentType, body, reqEditors
+func (_m *ClientInterface) UpdateWithBody(ctx context.Context, carUid uuid.UUID, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn) (*http.Response, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid, contentType, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithBody")
+	}
+
+	var r0 *http.Response
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) (*http.Response, error)); ok {
+		return rf(ctx, carUid, contentType, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) *http.Response); ok {
+		r0 = rf(ctx, carUid, contentType, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*http.Response)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, contentType, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_UpdateWithBody_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithBody'
+type ClientInterface_UpdateWithBody_Call struct {
+	*mock.Call
+}
+
+// UpdateWithBody is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - contentType string
+//   - body io.Reader
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientInterface_Expecter) UpdateWithBody(ctx interface{}, carUid interface{}, contentType interface{}, body interface{}, reqEditors ...interface{}) *ClientInterface_UpdateWithBody_Call {
+	return &ClientInterface_UpdateWithBody_Call{Call: _e.mock.On("UpdateWithBody",
+		append([]interface{}{ctx, carUid, contentType, body}, reqEditors...)...)}
+}
+
+func (_c *ClientInterface_UpdateWithBody_Call) Run(run func(ctx context.Context, carUid uuid.UUID, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn)) *ClientInterface_UpdateWithBody_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(io.Reader), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientInterface_UpdateWithBody_Call) Return(_a0 *http.Response, _a1 error) *ClientInterface_UpdateWithBody_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_UpdateWithBody_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) (*http.Response, error)) *ClientInterface_UpdateWithBody_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClientInterface creates a new instance of ClientInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientInterface(t interface {
@@ -7,6 +7,8 @@ import (
 
 	cars_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/cars-service"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
@@ -25,6 +27,80 @@ func (_m *ClientWithResponsesInterface) EXPECT() *ClientWithResponsesInterface_E
 	return &ClientWithResponsesInterface_Expecter{mock: &_m.Mock}
 }
 
+// ArchiveWithResponse provides a mock function with given fields: ctx, carUid, reqEditors
+func (_m *ClientWithResponsesInterface) ArchiveWithResponse(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*cars_service.ArchiveResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveWithResponse")
+	}
+
+	var r0 *cars_service.ArchiveResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*cars_service.ArchiveResponse, error)); ok {
+		return rf(ctx, carUid, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) *cars_service.ArchiveResponse); ok {
+		r0 = rf(ctx, carUid, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.ArchiveResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_ArchiveWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveWithResponse'
+type ClientWithResponsesInterface_ArchiveWithResponse_Call struct {
+	*mock.Call
+}
+
+// ArchiveWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) ArchiveWithResponse(ctx interface{}, carUid interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_ArchiveWithResponse_Call {
+	return &ClientWithResponsesInterface_ArchiveWithResponse_Call{Call: _e.mock.On("ArchiveWithResponse",
+		append([]interface{}{ctx, carUid}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_ArchiveWithResponse_Call) Run(run func(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_ArchiveWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ArchiveWithResponse_Call) Return(_a0 *cars_service.ArchiveResponse, _a1 error) *ClientWithResponsesInterface_ArchiveWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ArchiveWithResponse_Call) RunAndReturn(run func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*cars_service.ArchiveResponse, error)) *ClientWithResponsesInterface_ArchiveWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BookWithResponse provides a mock function with given fields: ctx, carUid, reqEditors
 func (_m *ClientWithResponsesInterface) BookWithResponse(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*cars_service.BookResponse, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -99,6 +175,229 @@ func (_c *ClientWithResponsesInterface_BookWithResponse_Call) RunAndReturn(run f
 	return _c
 }
 
+// CreateWithBodyWithResponse provides a mock function with given fields: ctx, contentType, body, reqEditors
+func (_m *ClientWithResponsesInterface) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn) (*cars_service.CreateResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contentType, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithBodyWithResponse")
+	}
+
+	var r0 *cars_service.CreateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*cars_service.CreateResponse, error)); ok {
+		return rf(ctx, contentType, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) *cars_service.CreateResponse); ok {
+		r0 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.CreateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_CreateWithBodyWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithBodyWithResponse'
+type ClientWithResponsesInterface_CreateWithBodyWithResponse_Call struct {
+	*mock.Call
+}
+
+// CreateWithBodyWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType string
+//   - body io.Reader
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) CreateWithBodyWithResponse(ctx interface{}, contentType interface{}, body interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_CreateWithBodyWithResponse_Call {
+	return &ClientWithResponsesInterface_CreateWithBodyWithResponse_Call{Call: _e.mock.On("CreateWithBodyWithResponse",
+		append([]interface{}{ctx, contentType, body}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_CreateWithBodyWithResponse_Call) Run(run func(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_CreateWithBodyWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_CreateWithBodyWithResponse_Call) Return(_a0 *cars_service.CreateResponse, _a1 error) *ClientWithResponsesInterface_CreateWithBodyWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_CreateWithBodyWithResponse_Call) RunAndReturn(run func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*cars_service.CreateResponse, error)) *ClientWithResponsesInterface_CreateWithBodyWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWithResponse provides a mock function with given fields: ctx, body, reqEditors
+func (_m *ClientWithResponsesInterface) CreateWithResponse(ctx context.Context, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn) (*cars_service.CreateResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithResponse")
+	}
+
+	var r0 *cars_service.CreateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*cars_service.CreateResponse, error)); ok {
+		return rf(ctx, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) *cars_service.CreateResponse); ok {
+		r0 = rf(ctx, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.CreateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_CreateWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithResponse'
+type ClientWithResponsesInterface_CreateWithResponse_Call struct {
+	*mock.Call
+}
+
+// CreateWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - body cars_service.CarRequest
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) CreateWithResponse(ctx interface{}, body interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_CreateWithResponse_Call {
+	return &ClientWithResponsesInterface_CreateWithResponse_Call{Call: _e.mock.On("CreateWithResponse",
+		append([]interface{}{ctx, body}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_CreateWithResponse_Call) Run(run func(ctx context.Context, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_CreateWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(cars_service.CarRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_CreateWithResponse_Call) Return(_a0 *cars_service.CreateResponse, _a1 error) *ClientWithResponsesInterface_CreateWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_CreateWithResponse_Call) RunAndReturn(run func(context.Context, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*cars_service.CreateResponse, error)) *ClientWithResponsesInterface_CreateWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportWithResponse provides a mock function with given fields: ctx, params, reqEditors
+func (_m *ClientWithResponsesInterface) ExportWithResponse(ctx context.Context, params *cars_service.ExportParams, reqEditors ...cars_service.RequestEditorFn) (*cars_service.ExportResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportWithResponse")
+	}
+
+	var r0 *cars_service.ExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) (*cars_service.ExportResponse, error)); ok {
+		return rf(ctx, params, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) *cars_service.ExportResponse); ok {
+		r0 = rf(ctx, params, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.ExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, params, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_ExportWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportWithResponse'
+type ClientWithResponsesInterface_ExportWithResponse_Call struct {
+	*mock.Call
+}
+
+// ExportWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params *cars_service.ExportParams
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) ExportWithResponse(ctx interface{}, params interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_ExportWithResponse_Call {
+	return &ClientWithResponsesInterface_ExportWithResponse_Call{Call: _e.mock.On("ExportWithResponse",
+		append([]interface{}{ctx, params}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_ExportWithResponse_Call) Run(run func(ctx context.Context, params *cars_service.ExportParams, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_ExportWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*cars_service.ExportParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ExportWithResponse_Call) Return(_a0 *cars_service.ExportResponse, _a1 error) *ClientWithResponsesInterface_ExportWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ExportWithResponse_Call) RunAndReturn(run func(context.Context, *cars_service.ExportParams, ...cars_service.RequestEditorFn) (*cars_service.ExportResponse, error)) *ClientWithResponsesInterface_ExportWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithResponse provides a mock function with given fields: ctx, carUid, reqEditors
 func (_m *ClientWithResponsesInterface) GetWithResponse(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*cars_service.GetResponse, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -173,6 +472,155 @@ func (_c *ClientWithResponsesInterface_GetWithResponse_Call) RunAndReturn(run fu
 	return _c
 }
 
+// ImportWithBodyWithResponse provides a mock function with given fields: ctx, contentType, body, reqEditors
+func (_m *ClientWithResponsesInterface) ImportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn) (*cars_service.ImportResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, contentType, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportWithBodyWithResponse")
+	}
+
+	var r0 *cars_service.ImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*cars_service.ImportResponse, error)); ok {
+		return rf(ctx, contentType, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) *cars_service.ImportResponse); ok {
+		r0 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.ImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, contentType, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_ImportWithBodyWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportWithBodyWithResponse'
+type ClientWithResponsesInterface_ImportWithBodyWithResponse_Call struct {
+	*mock.Call
+}
+
+// ImportWithBodyWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contentType string
+//   - body io.Reader
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) ImportWithBodyWithResponse(ctx interface{}, contentType interface{}, body interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_ImportWithBodyWithResponse_Call {
+	return &ClientWithResponsesInterface_ImportWithBodyWithResponse_Call{Call: _e.mock.On("ImportWithBodyWithResponse",
+		append([]interface{}{ctx, contentType, body}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_ImportWithBodyWithResponse_Call) Run(run func(ctx context.Context, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_ImportWithBodyWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ImportWithBodyWithResponse_Call) Return(_a0 *cars_service.ImportResponse, _a1 error) *ClientWithResponsesInterface_ImportWithBodyWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ImportWithBodyWithResponse_Call) RunAndReturn(run func(context.Context, string, io.Reader, ...cars_service.RequestEditorFn) (*cars_service.ImportResponse, error)) *ClientWithResponsesInterface_ImportWithBodyWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportWithResponse provides a mock function with given fields: ctx, body, reqEditors
+func (_m *ClientWithResponsesInterface) ImportWithResponse(ctx context.Context, body []cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn) (*cars_service.ImportResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportWithResponse")
+	}
+
+	var r0 *cars_service.ImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) (*cars_service.ImportResponse, error)); ok {
+		return rf(ctx, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) *cars_service.ImportResponse); ok {
+		r0 = rf(ctx, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.ImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_ImportWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportWithResponse'
+type ClientWithResponsesInterface_ImportWithResponse_Call struct {
+	*mock.Call
+}
+
+// ImportWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - body []cars_service.CarRequest
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) ImportWithResponse(ctx interface{}, body interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_ImportWithResponse_Call {
+	return &ClientWithResponsesInterface_ImportWithResponse_Call{Call: _e.mock.On("ImportWithResponse",
+		append([]interface{}{ctx, body}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_ImportWithResponse_Call) Run(run func(ctx context.Context, body []cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_ImportWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].([]cars_service.CarRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ImportWithResponse_Call) Return(_a0 *cars_service.ImportResponse, _a1 error) *ClientWithResponsesInterface_ImportWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_ImportWithResponse_Call) RunAndReturn(run func(context.Context, []cars_service.CarRequest, ...cars_service.RequestEditorFn) (*cars_service.ImportResponse, error)) *ClientWithResponsesInterface_ImportWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListWithResponse provides a mock function with given fields: ctx, params, reqEditors
 func (_m *ClientWithResponsesInterface) ListWithResponse(ctx context.Context, params *cars_service.ListParams, reqEditors ...cars_service.RequestEditorFn) (*cars_service.ListResponse, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -320,6 +768,80 @@ func (_c *ClientWithResponsesInterface_LiveWithResponse_Call) RunAndReturn(run f
 	return _c
 }
 
+// RestoreWithResponse provides a mock function with given fields: ctx, carUid, reqEditors
+func (_m *ClientWithResponsesInterface) RestoreWithResponse(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*cars_service.RestoreResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreWithResponse")
+	}
+
+	var r0 *cars_service.RestoreResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*cars_service.RestoreResponse, error)); ok {
+		return rf(ctx, carUid, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) *cars_service.RestoreResponse); ok {
+		r0 = rf(ctx, carUid, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.RestoreResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_RestoreWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreWithResponse'
+type ClientWithResponsesInterface_RestoreWithResponse_Call struct {
+	*mock.Call
+}
+
+// RestoreWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) RestoreWithResponse(ctx interface{}, carUid interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_RestoreWithResponse_Call {
+	return &ClientWithResponsesInterface_RestoreWithResponse_Call{Call: _e.mock.On("RestoreWithResponse",
+		append([]interface{}{ctx, carUid}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_RestoreWithResponse_Call) Run(run func(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_RestoreWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_RestoreWithResponse_Call) Return(_a0 *cars_service.RestoreResponse, _a1 error) *ClientWithResponsesInterface_RestoreWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_RestoreWithResponse_Call) RunAndReturn(run func(context.Context, uuid.UUID, ...cars_service.RequestEditorFn) (*cars_service.RestoreResponse, error)) *ClientWithResponsesInterface_RestoreWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnbookWithResponse provides a mock function with given fields: ctx, carUid, reqEditors
 func (_m *ClientWithResponsesInterface) UnbookWithResponse(ctx context.Context, carUid uuid.UUID, reqEditors ...cars_service.RequestEditorFn) (*cars_service.UnbookResponse, error) {
 	_va := make([]interface{}, len(reqEditors))
@@ -394,6 +916,157 @@ func (_c *ClientWithResponsesInterface_UnbookWithResponse_Call) RunAndReturn(run
 	return _c
 }
 
+// UpdateWithBodyWithResponse provides a mock function with given fields: ctx, carUid, contentType, body, reqEditors
+func (_m *ClientWithResponsesInterface) UpdateWithBodyWithResponse(ctx context.Context, carUid uuid.UUID, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn) (*cars_service.UpdateResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid, contentType, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithBodyWithResponse")
+	}
+
+	var r0 *cars_service.UpdateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) (*cars_service.UpdateResponse, error)); ok {
+		return rf(ctx, carUid, contentType, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) *cars_service.UpdateResponse); ok {
+		r0 = rf(ctx, carUid, contentType, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.UpdateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, contentType, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithBodyWithResponse'
+type ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call struct {
+	*mock.Call
+}
+
+// UpdateWithBodyWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - contentType string
+//   - body io.Reader
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) UpdateWithBodyWithResponse(ctx interface{}, carUid interface{}, contentType interface{}, body interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call {
+	return &ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call{Call: _e.mock.On("UpdateWithBodyWithResponse",
+		append([]interface{}{ctx, carUid, contentType, body}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call) Run(run func(ctx context.Context, carUid uuid.UUID, contentType string, body io.Reader, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(io.Reader), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call) Return(_a0 *cars_service.UpdateResponse, _a1 error) *ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, io.Reader, ...cars_service.RequestEditorFn) (*cars_service.UpdateResponse, error)) *ClientWithResponsesInterface_UpdateWithBodyWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWithResponse provides a mock function with given fields: ctx, carUid, body, reqEditors
+func (_m *ClientWithResponsesInterface) UpdateWithResponse(ctx context.Context, carUid uuid.UUID, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn) (*cars_service.UpdateResponse, error) {
+	_va := make([]interface{}, len(reqEditors))
+	for _i := range reqEditors {
+		_va[_i] = reqEditors[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, carUid, body)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWithResponse")
+	}
+
+	var r0 *cars_service.UpdateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*cars_service.UpdateResponse, error)); ok {
+		return rf(ctx, carUid, body, reqEditors...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) *cars_service.UpdateResponse); ok {
+		r0 = rf(ctx, carUid, body, reqEditors...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cars_service.UpdateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) error); ok {
+		r1 = rf(ctx, carUid, body, reqEditors...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientWithResponsesInterface_UpdateWithResponse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWithResponse'
+type ClientWithResponsesInterface_UpdateWithResponse_Call struct {
+	*mock.Call
+}
+
+// UpdateWithResponse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUid uuid.UUID
+//   - body cars_service.CarRequest
+//   - reqEditors ...cars_service.RequestEditorFn
+func (_e *ClientWithResponsesInterface_Expecter) UpdateWithResponse(ctx interface{}, carUid interface{}, body interface{}, reqEditors ...interface{}) *ClientWithResponsesInterface_UpdateWithResponse_Call {
+	return &ClientWithResponsesInterface_UpdateWithResponse_Call{Call: _e.mock.On("UpdateWithResponse",
+		append([]interface{}{ctx, carUid, body}, reqEditors...)...)}
+}
+
+func (_c *ClientWithResponsesInterface_UpdateWithResponse_Call) Run(run func(ctx context.Context, carUid uuid.UUID, body cars_service.CarRequest, reqEditors ...cars_service.RequestEditorFn)) *ClientWithResponsesInterface_UpdateWithResponse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]cars_service.RequestEditorFn, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(cars_service.RequestEditorFn)
+			}
+		}
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(cars_service.CarRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_UpdateWithResponse_Call) Return(_a0 *cars_service.UpdateResponse, _a1 error) *ClientWithResponsesInterface_UpdateWithResponse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientWithResponsesInterface_UpdateWithResponse_Call) RunAndReturn(run func(context.Context, uuid.UUID, cars_service.CarRequest, ...cars_service.RequestEditorFn) (*cars_service.UpdateResponse, error)) *ClientWithResponsesInterface_UpdateWithResponse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewClientWithResponsesInterface creates a new instance of ClientWithResponsesInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewClientWithResponsesInterface(t interface {
@@ -12,23 +12,122 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CreatePaymentRequestKind.
+const (
+	CreatePaymentRequestKindEXTENSION  CreatePaymentRequestKind = "EXTENSION"
+	CreatePaymentRequestKindLATERETURN CreatePaymentRequestKind = "LATE_RETURN"
+	CreatePaymentRequestKindMILEAGE    CreatePaymentRequestKind = "MILEAGE"
+	CreatePaymentRequestKindREFUEL     CreatePaymentRequestKind = "REFUEL"
+	CreatePaymentRequestKindRENTAL     CreatePaymentRequestKind = "RENTAL"
+)
+
+// Defines values for InvoiceRequestRentalStatus.
+const (
+	InvoiceRequestRentalStatusCANCELED   InvoiceRequestRentalStatus = "CANCELED"
+	InvoiceRequestRentalStatusFINISHED   InvoiceRequestRentalStatus = "FINISHED"
+	InvoiceRequestRentalStatusINPROGRESS InvoiceRequestRentalStatus = "IN_PROGRESS"
+	InvoiceRequestRentalStatusOVERDUE    InvoiceRequestRentalStatus = "OVERDUE"
+	InvoiceRequestRentalStatusRESERVED   InvoiceRequestRentalStatus = "RESERVED"
+)
+
+// Defines values for JournalEntryKind.
+const (
+	CHARGE   JournalEntryKind = "CHARGE"
+	DISCOUNT JournalEntryKind = "DISCOUNT"
+	FEE      JournalEntryKind = "FEE"
+	REFUND   JournalEntryKind = "REFUND"
+)
+
+// Defines values for PaymentInfoKind.
+const (
+	PaymentInfoKindEXTENSION  PaymentInfoKind = "EXTENSION"
+	PaymentInfoKindLATERETURN PaymentInfoKind = "LATE_RETURN"
+	PaymentInfoKindMILEAGE    PaymentInfoKind = "MILEAGE"
+	PaymentInfoKindREFUEL     PaymentInfoKind = "REFUEL"
+	PaymentInfoKindRENTAL     PaymentInfoKind = "RENTAL"
+)
+
 // Defines values for PaymentInfoStatus.
 const (
-	CANCELED PaymentInfoStatus = "CANCELED"
-	PAID     PaymentInfoStatus = "PAID"
+	PaymentInfoStatusAUTHORIZED        PaymentInfoStatus = "AUTHORIZED"
+	PaymentInfoStatusCANCELED          PaymentInfoStatus = "CANCELED"
+	PaymentInfoStatusCAPTURED          PaymentInfoStatus = "CAPTURED"
+	PaymentInfoStatusFAILED            PaymentInfoStatus = "FAILED"
+	PaymentInfoStatusPAID              PaymentInfoStatus = "PAID"
+	PaymentInfoStatusPARTIALLYREFUNDED PaymentInfoStatus = "PARTIALLY_REFUNDED"
+	PaymentInfoStatusPENDING           PaymentInfoStatus = "PENDING"
+	PaymentInfoStatusREFUNDED          PaymentInfoStatus = "REFUNDED"
+)
+
+// Defines values for PromoCodeRequestKind.
+const (
+	PromoCodeRequestKindFIXED   PromoCodeRequestKind = "FIXED"
+	PromoCodeRequestKindPERCENT PromoCodeRequestKind = "PERCENT"
+)
+
+// Defines values for PromoCodeResponseKind.
+const (
+	PromoCodeResponseKindFIXED   PromoCodeResponseKind = "FIXED"
+	PromoCodeResponseKindPERCENT PromoCodeResponseKind = "PERCENT"
+)
+
+// Defines values for TaxMode.
+const (
+	EXCLUSIVE TaxMode = "EXCLUSIVE"
+	INCLUSIVE TaxMode = "INCLUSIVE"
 )
 
+// ConvertRequest defines model for ConvertRequest.
+type ConvertRequest struct {
+	// Amounts Суммы для пересчета
+	Amounts []Money `json:"amounts"`
+
+	// Currency Код валюты ISO 4217, в которую пересчитываются суммы
+	Currency string `json:"currency"`
+}
+
 // CreatePaymentRequest defines model for CreatePaymentRequest.
 type CreatePaymentRequest struct {
-	// Price Сумма платежа
+	// CarType Тип автомобиля, нужен для проверки ограничений промокода
+	CarType *string `json:"carType,omitempty"`
+
+	// Currency Код валюты платежа ISO 4217
+	Currency string `json:"currency"`
+
+	// Jurisdiction Страна или регион налогообложения, по умолчанию - из настроек сервиса
+	Jurisdiction *string `json:"jurisdiction,omitempty"`
+
+	// Kind Назначение платежа, по умолчанию - оплата аренды
+	Kind *CreatePaymentRequestKind `json:"kind,omitempty"`
+
+	// PaymentMethod Токен способа оплаты для платежного провайдера
+	PaymentMethod *string `json:"paymentMethod,omitempty"`
+
+	// Price Сумма платежа до применения промокода в минимальных единицах валюты. Налоги, не включенные в цену, добавляются к сумме после применения промокода
 	Price int `json:"price"`
+
+	// PromoCode Промокод
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// RentalDays Количество дней аренды, нужно для проверки ограничений промокода
+	RentalDays *int `json:"rentalDays,omitempty"`
+
+	// RentalUid UUID аренды, к которой относится платеж
+	RentalUid *openapi_types.UUID `json:"rentalUid,omitempty"`
+
+	// Username Владелец платежа, если платеж создает администратор или сервис от имени пользователя. Для остальных владельцем становится автор запроса.
+	Username *string `json:"username,omitempty"`
 }
 
+// CreatePaymentRequestKind Назначение платежа, по умолчанию - оплата аренды
+type CreatePaymentRequestKind string
+
 // ErrorDescription defines model for ErrorDescription.
 type ErrorDescription struct {
 	Error string `json:"error"`
@@ -41,21 +140,295 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// Exponent Количество знаков минимальных единиц валюты
+	Exponent int `json:"exponent"`
+
+	// Rate Стоимость единицы базовой валюты в этой валюте
+	Rate float64 `json:"rate"`
+
+	// UpdatedAt Время обновления курса
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ExchangeRateRequest defines model for ExchangeRateRequest.
+type ExchangeRateRequest struct {
+	// Exponent Количество знаков минимальных единиц валюты
+	Exponent int `json:"exponent"`
+
+	// Rate Стоимость единицы базовой валюты в этой валюте
+	Rate float64 `json:"rate"`
+}
+
+// InvoiceCar defines model for InvoiceCar.
+type InvoiceCar struct {
+	Brand              string `json:"brand"`
+	Model              string `json:"model"`
+	RegistrationNumber string `json:"registrationNumber"`
+	Type               string `json:"type"`
+}
+
+// InvoiceLine defines model for InvoiceLine.
+type InvoiceLine struct {
+	// Amount Сумма в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Description Описание строки
+	Description string `json:"description"`
+
+	// Quantity Количество
+	Quantity int `json:"quantity"`
+}
+
+// InvoiceRequest defines model for InvoiceRequest.
+type InvoiceRequest struct {
+	Car InvoiceCar `json:"car"`
+
+	// Currency Код валюты аренды ISO 4217
+	Currency string `json:"currency"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+
+	// Lines Строки стоимости аренды и дополнительные начисления
+	Lines []InvoiceLine `json:"lines"`
+
+	// RentalStatus Статус аренды, счет формируется только по завершенной или отмененной аренде
+	RentalStatus InvoiceRequestRentalStatus `json:"rentalStatus"`
+}
+
+// InvoiceRequestRentalStatus Статус аренды, счет формируется только по завершенной или отмененной аренде
+type InvoiceRequestRentalStatus string
+
+// JournalEntry defines model for JournalEntry.
+type JournalEntry struct {
+	// Amount Сумма проводки в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// CreatedAt Время проводки
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// EntryUid UUID проводки
+	EntryUid openapi_types.UUID `json:"entryUid"`
+
+	// Kind Вид проводки
+	Kind JournalEntryKind `json:"kind"`
+
+	// PaymentUid UUID платежа
+	PaymentUid openapi_types.UUID `json:"paymentUid"`
+
+	// Postings Дебетуемые и кредитуемые счета
+	Postings []Posting `json:"postings"`
+}
+
+// JournalEntryKind Вид проводки
+type JournalEntryKind string
+
+// LedgerBalance defines model for LedgerBalance.
+type LedgerBalance struct {
+	// Account Счет
+	Account string `json:"account"`
+
+	// Amount Дебет за вычетом кредита в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+}
+
+// Money defines model for Money.
+type Money struct {
+	// Amount Сумма в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+}
+
 // PaymentInfo defines model for PaymentInfo.
 type PaymentInfo struct {
+	// Captured Списанная и не возвращенная сумма по журналу проводок
+	Captured *int `json:"captured,omitempty"`
+
+	// Currency Код валюты платежа ISO 4217
+	Currency string `json:"currency"`
+
+	// Discount Скидка по промокоду, уже вычтенная из суммы платежа
+	Discount *int `json:"discount,omitempty"`
+
+	// FailureReason Причина отказа провайдера
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// Kind Назначение платежа
+	Kind *PaymentInfoKind `json:"kind,omitempty"`
+
 	// PaymentUid UUID платежа
 	PaymentUid openapi_types.UUID `json:"paymentUid"`
 
-	// Price Сумма платежа
+	// Price Сумма платежа в минимальных единицах валюты
 	Price int `json:"price"`
 
+	// PromoCode Примененный промокод
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Refunded Возвращенная сумма по журналу проводок
+	Refunded *int `json:"refunded,omitempty"`
+
+	// RentalUid UUID аренды, к которой относится платеж
+	RentalUid *openapi_types.UUID `json:"rentalUid,omitempty"`
+
 	// Status Статус платежа
 	Status PaymentInfoStatus `json:"status"`
+
+	// Taxes Налоги, входящие в сумму платежа
+	Taxes *[]Tax `json:"taxes,omitempty"`
 }
 
+// PaymentInfoKind Назначение платежа
+type PaymentInfoKind string
+
 // PaymentInfoStatus Статус платежа
 type PaymentInfoStatus string
 
+// Posting defines model for Posting.
+type Posting struct {
+	// Account Счет
+	Account string `json:"account"`
+
+	// Credit Сумма по кредиту
+	Credit int `json:"credit"`
+
+	// Debit Сумма по дебету
+	Debit int `json:"debit"`
+}
+
+// PromoCodeRequest defines model for PromoCodeRequest.
+type PromoCodeRequest struct {
+	// CarTypes Типы автомобилей, для которых действует промокод; пустой - для всех
+	CarTypes *[]string `json:"carTypes,omitempty"`
+
+	// Code Промокод
+	Code string `json:"code"`
+
+	// Currency Код валюты ISO 4217, обязателен для фиксированной скидки
+	Currency *string `json:"currency,omitempty"`
+
+	// Kind Тип скидки
+	Kind PromoCodeRequestKind `json:"kind"`
+
+	// MaxUses Общее ограничение использований, 0 - без ограничений
+	MaxUses *int `json:"maxUses,omitempty"`
+
+	// MaxUsesPerUser Ограничение использований одним пользователем, 0 - без ограничений
+	MaxUsesPerUser *int `json:"maxUsesPerUser,omitempty"`
+
+	// MinDays Минимальная длительность аренды в днях
+	MinDays *int `json:"minDays,omitempty"`
+
+	// ValidFrom Начало действия промокода
+	ValidFrom time.Time `json:"validFrom"`
+
+	// ValidTo Окончание действия промокода
+	ValidTo *time.Time `json:"validTo,omitempty"`
+
+	// Value Процент или фиксированная сумма скидки в минимальных единицах валюты
+	Value int `json:"value"`
+}
+
+// PromoCodeRequestKind Тип скидки
+type PromoCodeRequestKind string
+
+// PromoCodeResponse defines model for PromoCodeResponse.
+type PromoCodeResponse struct {
+	// CarTypes Типы автомобилей, для которых действует промокод; пустой - для всех
+	CarTypes *[]string `json:"carTypes,omitempty"`
+
+	// Code Промокод
+	Code string `json:"code"`
+
+	// Currency Код валюты ISO 4217, обязателен для фиксированной скидки
+	Currency *string `json:"currency,omitempty"`
+
+	// Kind Тип скидки
+	Kind PromoCodeResponseKind `json:"kind"`
+
+	// MaxUses Общее ограничение использований, 0 - без ограничений
+	MaxUses *int `json:"maxUses,omitempty"`
+
+	// MaxUsesPerUser Ограничение использований одним пользователем, 0 - без ограничений
+	MaxUsesPerUser *int `json:"maxUsesPerUser,omitempty"`
+
+	// MinDays Минимальная длительность аренды в днях
+	MinDays *int `json:"minDays,omitempty"`
+
+	// Used Количество действующих использований
+	Used int `json:"used"`
+
+	// ValidFrom Начало действия промокода
+	ValidFrom time.Time `json:"validFrom"`
+
+	// ValidTo Окончание действия промокода
+	ValidTo *time.Time `json:"validTo,omitempty"`
+
+	// Value Процент или фиксированная сумма скидки в минимальных единицах валюты
+	Value int `json:"value"`
+}
+
+// PromoCodeResponseKind Тип скидки
+type PromoCodeResponseKind string
+
+// RefundRequest defines model for RefundRequest.
+type RefundRequest struct {
+	// Amount Возвращаемая сумма в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// RentalUid UUID аренды, к которой привязывается платеж в журнале проводок
+	RentalUid *openapi_types.UUID `json:"rentalUid,omitempty"`
+}
+
+// RentalLedger defines model for RentalLedger.
+type RentalLedger struct {
+	// Balances Остатки по счетам
+	Balances []LedgerBalance `json:"balances"`
+
+	// Entries Проводки в порядке создания
+	Entries []JournalEntry `json:"entries"`
+
+	// RentalUid UUID аренды
+	RentalUid openapi_types.UUID `json:"rentalUid"`
+}
+
+// Tax defines model for Tax.
+type Tax struct {
+	// Amount Сумма налога в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Jurisdiction Страна или регион, например RU или RU-MOW
+	Jurisdiction string `json:"jurisdiction"`
+
+	// Mode INCLUSIVE - налог включен в цену, EXCLUSIVE - налог добавлен к цене
+	Mode TaxMode `json:"mode"`
+
+	// Name Название налога
+	Name string `json:"name"`
+
+	// Percent Ставка налога в процентах
+	Percent float32 `json:"percent"`
+}
+
+// TaxMode INCLUSIVE - налог включен в цену, EXCLUSIVE - налог добавлен к цене
+type TaxMode string
+
 // ValidationErrorResponse defines model for ValidationErrorResponse.
 type ValidationErrorResponse struct {
 	// Errors Массив полей с описанием ошибки
@@ -65,9 +438,42 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// CancelParams defines parameters for Cancel.
+type CancelParams struct {
+	// RentalStart Начало аренды
+	RentalStart *time.Time `form:"rentalStart,omitempty" json:"rentalStart,omitempty"`
+
+	// CanceledAt Момент отмены аренды, по умолчанию - текущий
+	CanceledAt *time.Time `form:"canceledAt,omitempty" json:"canceledAt,omitempty"`
+
+	// RentalUid UUID аренды, к которой привязывается платеж в журнале проводок
+	RentalUid *openapi_types.UUID `form:"rentalUid,omitempty" json:"rentalUid,omitempty"`
+}
+
+// CaptureParams defines parameters for Capture.
+type CaptureParams struct {
+	// RentalUid UUID аренды, к которой привязывается платеж в журнале проводок
+	RentalUid *openapi_types.UUID `form:"rentalUid,omitempty" json:"rentalUid,omitempty"`
+}
+
+// SetExchangeRateJSONRequestBody defines body for SetExchangeRate for application/json ContentType.
+type SetExchangeRateJSONRequestBody = ExchangeRateRequest
+
+// CreatePromoCodeJSONRequestBody defines body for CreatePromoCode for application/json ContentType.
+type CreatePromoCodeJSONRequestBody = PromoCodeRequest
+
+// ConvertJSONRequestBody defines body for Convert for application/json ContentType.
+type ConvertJSONRequestBody = ConvertRequest
+
 // CreateJSONRequestBody defines body for Create for application/json ContentType.
 type CreateJSONRequestBody = CreatePaymentRequest
 
+// RefundJSONRequestBody defines body for Refund for application/json ContentType.
+type RefundJSONRequestBody = RefundRequest
+
+// GenerateInvoiceJSONRequestBody defines body for GenerateInvoice for application/json ContentType.
+type GenerateInvoiceJSONRequestBody = InvoiceRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -141,23 +547,66 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// SetExchangeRateWithBody request with any body
+	SetExchangeRateWithBody(ctx context.Context, currency string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetExchangeRate(ctx context.Context, currency string, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPromoCodes request
+	ListPromoCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePromoCodeWithBody request with any body
+	CreatePromoCodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePromoCode(ctx context.Context, body CreatePromoCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExchangeRates request
+	ListExchangeRates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConvertWithBody request with any body
+	ConvertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Convert(ctx context.Context, body ConvertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateWithBody request with any body
 	CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Cancel request
-	Cancel(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Cancel(ctx context.Context, paymentUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Get request
 	Get(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Capture request
+	Capture(ctx context.Context, paymentUid openapi_types.UUID, params *CaptureParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Confirm request
+	Confirm(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefundWithBody request with any body
+	RefundWithBody(ctx context.Context, paymentUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Refund(ctx context.Context, paymentUid openapi_types.UUID, body RefundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInvoice request
+	GetInvoice(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GenerateInvoiceWithBody request with any body
+	GenerateInvoiceWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GenerateInvoice(ctx context.Context, rentalUid openapi_types.UUID, body GenerateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRentalLedger request
+	GetRentalLedger(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Live request
 	Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) SetExchangeRateWithBody(ctx context.Context, currency string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetExchangeRateRequestWithBody(c.Server, currency, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -168,8 +617,8 @@ func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io
 	return c.Client.Do(req)
 }
 
-func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRequest(c.Server, body)
+func (c *Client) SetExchangeRate(ctx context.Context, currency string, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetExchangeRateRequest(c.Server, currency, body)
 	if err != nil {
 		return nil, err
 	}
@@ -180,8 +629,8 @@ func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) Cancel(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCancelRequest(c.Server, paymentUid)
+func (c *Client) ListPromoCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPromoCodesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -192,8 +641,8 @@ func (c *Client) Cancel(ctx context.Context, paymentUid openapi_types.UUID, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) Get(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRequest(c.Server, paymentUid)
+func (c *Client) CreatePromoCodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePromoCodeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -204,8 +653,8 @@ func (c *Client) Get(ctx context.Context, paymentUid openapi_types.UUID, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLiveRequest(c.Server)
+func (c *Client) CreatePromoCode(ctx context.Context, body CreatePromoCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePromoCodeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -216,124 +665,226 @@ func (c *Client) Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http
 	return c.Client.Do(req)
 }
 
-// NewCreateRequest calls the generic Create builder with application/json body
-func NewCreateRequest(server string, body CreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListExchangeRates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExchangeRatesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateRequestWithBody generates requests for Create with any type of body
-func NewCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ConvertWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/payment")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Convert(ctx context.Context, body ConvertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCancelRequest generates requests for Cancel
-func NewCancelRequest(server string, paymentUid openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymentUid", runtime.ParamLocationPath, paymentUid)
+func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api/v1/payment/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetRequest generates requests for Get
-func NewGetRequest(server string, paymentUid openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymentUid", runtime.ParamLocationPath, paymentUid)
+func (c *Client) Cancel(ctx context.Context, paymentUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelRequest(c.Server, paymentUid, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) Get(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRequest(c.Server, paymentUid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/payment/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Capture(ctx context.Context, paymentUid openapi_types.UUID, params *CaptureParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCaptureRequest(c.Server, paymentUid, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Confirm(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmRequest(c.Server, paymentUid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) RefundWithBody(ctx context.Context, paymentUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefundRequestWithBody(c.Server, paymentUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLiveRequest generates requests for Live
-func NewLiveRequest(server string) (*http.Request, error) {
+func (c *Client) Refund(ctx context.Context, paymentUid openapi_types.UUID, body RefundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefundRequest(c.Server, paymentUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInvoice(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceRequest(c.Server, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GenerateInvoiceWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateInvoiceRequestWithBody(c.Server, rentalUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GenerateInvoice(ctx context.Context, rentalUid openapi_types.UUID, body GenerateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGenerateInvoiceRequest(c.Server, rentalUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRentalLedger(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRentalLedgerRequest(c.Server, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLiveRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewSetExchangeRateRequest calls the generic SetExchangeRate builder with application/json body
+func NewSetExchangeRateRequest(server string, currency string, body SetExchangeRateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetExchangeRateRequestWithBody(server, currency, "application/json", bodyReader)
+}
+
+// NewSetExchangeRateRequestWithBody generates requests for SetExchangeRate with any type of body
+func NewSetExchangeRateRequestWithBody(server string, currency string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "currency", runtime.ParamLocationPath, currency)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/manage/health")
+	operationPath := fmt.Sprintf("/api/v1/admin/exchange-rates/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -343,214 +894,1683 @@ func NewLiveRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewListPromoCodesRequest generates requests for ListPromoCodes
+func NewListPromoCodesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/api/v1/admin/promo-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewCreatePromoCodeRequest calls the generic CreatePromoCode builder with application/json body
+func NewCreatePromoCodeRequest(server string, body CreatePromoCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePromoCodeRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateWithBodyWithResponse request with any body
-	CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+// NewCreatePromoCodeRequestWithBody generates requests for CreatePromoCode with any type of body
+func NewCreatePromoCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CancelWithResponse request
-	CancelWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/promo-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetWithResponse request
-	GetWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// LiveWithResponse request
-	LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-type CreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PaymentInfo
-	JSON400      *ValidationErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListExchangeRatesRequest generates requests for ListExchangeRates
+func NewListExchangeRatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/exchange-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type CancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *ErrorResponse
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewConvertRequest calls the generic Convert builder with application/json body
+func NewConvertRequest(server string, body ConvertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewConvertRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewConvertRequestWithBody generates requests for Convert with any type of body
+func NewConvertRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/exchange-rates/convert")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateRequest calls the generic Create builder with application/json body
+func NewCreateRequest(server string, body CreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRequestWithBody generates requests for Create with any type of body
+func NewCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payment")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelRequest generates requests for Cancel
+func NewCancelRequest(server string, paymentUid openapi_types.UUID, params *CancelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymentUid", runtime.ParamLocationPath, paymentUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RentalStart != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rentalStart", runtime.ParamLocationQuery, *params.RentalStart); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CanceledAt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "canceledAt", runtime.ParamLocationQuery, *params.CanceledAt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RentalUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rentalUid", runtime.ParamLocationQuery, *params.RentalUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRequest generates requests for Get
+func NewGetRequest(server string, paymentUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymentUid", runtime.ParamLocationPath, paymentUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCaptureRequest generates requests for Capture
+func NewCaptureRequest(server string, paymentUid openapi_types.UUID, params *CaptureParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymentUid", runtime.ParamLocationPath, paymentUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payment/%s/capture", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RentalUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rentalUid", runtime.ParamLocationQuery, *params.RentalUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmRequest generates requests for Confirm
+func NewConfirmRequest(server string, paymentUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymentUid", runtime.ParamLocationPath, paymentUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payment/%s/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRefundRequest calls the generic Refund builder with application/json body
+func NewRefundRequest(server string, paymentUid openapi_types.UUID, body RefundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefundRequestWithBody(server, paymentUid, "application/json", bodyReader)
+}
+
+// NewRefundRequestWithBody generates requests for Refund with any type of body
+func NewRefundRequestWithBody(server string, paymentUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "paymentUid", runtime.ParamLocationPath, paymentUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/payment/%s/refund", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInvoiceRequest generates requests for GetInvoice
+func NewGetInvoiceRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rentals/%s/invoice", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGenerateInvoiceRequest calls the generic GenerateInvoice builder with application/json body
+func NewGenerateInvoiceRequest(server string, rentalUid openapi_types.UUID, body GenerateInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewGenerateInvoiceRequestWithBody(server, rentalUid, "application/json", bodyReader)
+}
+
+// NewGenerateInvoiceRequestWithBody generates requests for GenerateInvoice with any type of body
+func NewGenerateInvoiceRequestWithBody(server string, rentalUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rentals/%s/invoice", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRentalLedgerRequest generates requests for GetRentalLedger
+func NewGetRentalLedgerRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rentals/%s/ledger", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLiveRequest generates requests for Live
+func NewLiveRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/manage/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// SetExchangeRateWithBodyWithResponse request with any body
+	SetExchangeRateWithBodyWithResponse(ctx context.Context, currency string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error)
+
+	SetExchangeRateWithResponse(ctx context.Context, currency string, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error)
+
+	// ListPromoCodesWithResponse request
+	ListPromoCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPromoCodesResponse, error)
+
+	// CreatePromoCodeWithBodyWithResponse request with any body
+	CreatePromoCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePromoCodeResponse, error)
+
+	CreatePromoCodeWithResponse(ctx context.Context, body CreatePromoCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePromoCodeResponse, error)
+
+	// ListExchangeRatesWithResponse request
+	ListExchangeRatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListExchangeRatesResponse, error)
+
+	// ConvertWithBodyWithResponse request with any body
+	ConvertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertResponse, error)
+
+	ConvertWithResponse(ctx context.Context, body ConvertJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertResponse, error)
+
+	// CreateWithBodyWithResponse request with any body
+	CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	// CancelWithResponse request
+	CancelWithResponse(ctx context.Context, paymentUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*CancelResponse, error)
+
+	// GetWithResponse request
+	GetWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error)
+
+	// CaptureWithResponse request
+	CaptureWithResponse(ctx context.Context, paymentUid openapi_types.UUID, params *CaptureParams, reqEditors ...RequestEditorFn) (*CaptureResponse, error)
+
+	// ConfirmWithResponse request
+	ConfirmWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ConfirmResponse, error)
+
+	// RefundWithBodyWithResponse request with any body
+	RefundWithBodyWithResponse(ctx context.Context, paymentUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefundResponse, error)
+
+	RefundWithResponse(ctx context.Context, paymentUid openapi_types.UUID, body RefundJSONRequestBody, reqEditors ...RequestEditorFn) (*RefundResponse, error)
+
+	// GetInvoiceWithResponse request
+	GetInvoiceWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error)
+
+	// GenerateInvoiceWithBodyWithResponse request with any body
+	GenerateInvoiceWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateInvoiceResponse, error)
+
+	GenerateInvoiceWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body GenerateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateInvoiceResponse, error)
+
+	// GetRentalLedgerWithResponse request
+	GetRentalLedgerWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetRentalLedgerResponse, error)
+
+	// LiveWithResponse request
+	LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error)
+}
+
+type SetExchangeRateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExchangeRate
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetExchangeRateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetExchangeRateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPromoCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PromoCodeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPromoCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPromoCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePromoCodeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PromoCodeResponse
+	JSON400      *ValidationErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePromoCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePromoCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListExchangeRatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExchangeRate
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExchangeRatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExchangeRatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConvertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Money
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConvertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConvertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentInfo
+	JSON400      *ValidationErrorResponse
+	JSON402      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentInfo
+	JSON402      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentInfo
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CaptureResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentInfo
+	JSON402      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CaptureResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CaptureResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentInfo
+	JSON402      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefundResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PaymentInfo
+	JSON400      *ValidationErrorResponse
+	JSON402      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RefundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GenerateInvoiceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GenerateInvoiceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GenerateInvoiceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRentalLedgerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalLedger
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRentalLedgerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRentalLedgerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// SetExchangeRateWithBodyWithResponse request with arbitrary body returning *SetExchangeRateResponse
+func (c *ClientWithResponses) SetExchangeRateWithBodyWithResponse(ctx context.Context, currency string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error) {
+	rsp, err := c.SetExchangeRateWithBody(ctx, currency, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetExchangeRateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetExchangeRateWithResponse(ctx context.Context, currency string, body SetExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetExchangeRateResponse, error) {
+	rsp, err := c.SetExchangeRate(ctx, currency, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetExchangeRateResponse(rsp)
+}
+
+// ListPromoCodesWithResponse request returning *ListPromoCodesResponse
+func (c *ClientWithResponses) ListPromoCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPromoCodesResponse, error) {
+	rsp, err := c.ListPromoCodes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPromoCodesResponse(rsp)
+}
+
+// CreatePromoCodeWithBodyWithResponse request with arbitrary body returning *CreatePromoCodeResponse
+func (c *ClientWithResponses) CreatePromoCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePromoCodeResponse, error) {
+	rsp, err := c.CreatePromoCodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePromoCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePromoCodeWithResponse(ctx context.Context, body CreatePromoCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePromoCodeResponse, error) {
+	rsp, err := c.CreatePromoCode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePromoCodeResponse(rsp)
+}
+
+// ListExchangeRatesWithResponse request returning *ListExchangeRatesResponse
+func (c *ClientWithResponses) ListExchangeRatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListExchangeRatesResponse, error) {
+	rsp, err := c.ListExchangeRates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExchangeRatesResponse(rsp)
+}
+
+// ConvertWithBodyWithResponse request with arbitrary body returning *ConvertResponse
+func (c *ClientWithResponses) ConvertWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertResponse, error) {
+	rsp, err := c.ConvertWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConvertWithResponse(ctx context.Context, body ConvertJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertResponse, error) {
+	rsp, err := c.Convert(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertResponse(rsp)
+}
+
+// CreateWithBodyWithResponse request with arbitrary body returning *CreateResponse
+func (c *ClientWithResponses) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.CreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.Create(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+// CancelWithResponse request returning *CancelResponse
+func (c *ClientWithResponses) CancelWithResponse(ctx context.Context, paymentUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*CancelResponse, error) {
+	rsp, err := c.Cancel(ctx, paymentUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelResponse(rsp)
+}
+
+// GetWithResponse request returning *GetResponse
+func (c *ClientWithResponses) GetWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	rsp, err := c.Get(ctx, paymentUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResponse(rsp)
+}
+
+// CaptureWithResponse request returning *CaptureResponse
+func (c *ClientWithResponses) CaptureWithResponse(ctx context.Context, paymentUid openapi_types.UUID, params *CaptureParams, reqEditors ...RequestEditorFn) (*CaptureResponse, error) {
+	rsp, err := c.Capture(ctx, paymentUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCaptureResponse(rsp)
+}
+
+// ConfirmWithResponse request returning *ConfirmResponse
+func (c *ClientWithResponses) ConfirmWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ConfirmResponse, error) {
+	rsp, err := c.Confirm(ctx, paymentUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmResponse(rsp)
+}
+
+// RefundWithBodyWithResponse request with arbitrary body returning *RefundResponse
+func (c *ClientWithResponses) RefundWithBodyWithResponse(ctx context.Context, paymentUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefundResponse, error) {
+	rsp, err := c.RefundWithBody(ctx, paymentUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefundResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefundWithResponse(ctx context.Context, paymentUid openapi_types.UUID, body RefundJSONRequestBody, reqEditors ...RequestEditorFn) (*RefundResponse, error) {
+	rsp, err := c.Refund(ctx, paymentUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefundResponse(rsp)
+}
+
+// GetInvoiceWithResponse request returning *GetInvoiceResponse
+func (c *ClientWithResponses) GetInvoiceWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetInvoiceResponse, error) {
+	rsp, err := c.GetInvoice(ctx, rentalUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceResponse(rsp)
+}
+
+// GenerateInvoiceWithBodyWithResponse request with arbitrary body returning *GenerateInvoiceResponse
+func (c *ClientWithResponses) GenerateInvoiceWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GenerateInvoiceResponse, error) {
+	rsp, err := c.GenerateInvoiceWithBody(ctx, rentalUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGenerateInvoiceResponse(rsp)
+}
+
+func (c *ClientWithResponses) GenerateInvoiceWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body GenerateInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*GenerateInvoiceResponse, error) {
+	rsp, err := c.GenerateInvoice(ctx, rentalUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGenerateInvoiceResponse(rsp)
+}
+
+// GetRentalLedgerWithResponse request returning *GetRentalLedgerResponse
+func (c *ClientWithResponses) GetRentalLedgerWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetRentalLedgerResponse, error) {
+	rsp, err := c.GetRentalLedger(ctx, rentalUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRentalLedgerResponse(rsp)
+}
+
+// LiveWithResponse request returning *LiveResponse
+func (c *ClientWithResponses) LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error) {
+	rsp, err := c.Live(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLiveResponse(rsp)
+}
+
+// ParseSetExchangeRateResponse parses an HTTP response from a SetExchangeRateWithResponse call
+func ParseSetExchangeRateResponse(rsp *http.Response) (*SetExchangeRateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetExchangeRateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExchangeRate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPromoCodesResponse parses an HTTP response from a ListPromoCodesWithResponse call
+func ParseListPromoCodesResponse(rsp *http.Response) (*ListPromoCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPromoCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PromoCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePromoCodeResponse parses an HTTP response from a CreatePromoCodeWithResponse call
+func ParseCreatePromoCodeResponse(rsp *http.Response) (*CreatePromoCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePromoCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PromoCodeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExchangeRatesResponse parses an HTTP response from a ListExchangeRatesWithResponse call
+func ParseListExchangeRatesResponse(rsp *http.Response) (*ListExchangeRatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExchangeRatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExchangeRate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConvertResponse parses an HTTP response from a ConvertWithResponse call
+func ParseConvertResponse(rsp *http.Response) (*ConvertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConvertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Money
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateResponse parses an HTTP response from a CreateWithResponse call
+func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON402 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelResponse parses an HTTP response from a CancelWithResponse call
+func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON402 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
-	return 0
-}
 
-type GetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PaymentInfo
-	JSON404      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetResponse parses an HTTP response from a GetWithResponse call
+func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type LiveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r LiveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LiveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// CreateWithBodyWithResponse request with arbitrary body returning *CreateResponse
-func (c *ClientWithResponses) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
-	rsp, err := c.CreateWithBody(ctx, contentType, body, reqEditors...)
+// ParseCaptureResponse parses an HTTP response from a CaptureWithResponse call
+func ParseCaptureResponse(rsp *http.Response) (*CaptureResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
-	rsp, err := c.Create(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CaptureResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateResponse(rsp)
-}
 
-// CancelWithResponse request returning *CancelResponse
-func (c *ClientWithResponses) CancelWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error) {
-	rsp, err := c.Cancel(ctx, paymentUid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON402 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
-	return ParseCancelResponse(rsp)
+
+	return response, nil
 }
 
-// GetWithResponse request returning *GetResponse
-func (c *ClientWithResponses) GetWithResponse(ctx context.Context, paymentUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error) {
-	rsp, err := c.Get(ctx, paymentUid, reqEditors...)
+// ParseConfirmResponse parses an HTTP response from a ConfirmWithResponse call
+func ParseConfirmResponse(rsp *http.Response) (*ConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetResponse(rsp)
-}
 
-// LiveWithResponse request returning *LiveResponse
-func (c *ClientWithResponses) LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error) {
-	rsp, err := c.Live(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseLiveResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PaymentInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON402 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseCreateResponse parses an HTTP response from a CreateWithResponse call
-func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
+// ParseRefundResponse parses an HTTP response from a RefundWithResponse call
+func ParseRefundResponse(rsp *http.Response) (*RefundResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateResponse{
+	response := &RefundResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -570,25 +2590,67 @@ func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 402:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON402 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCancelResponse parses an HTTP response from a CancelWithResponse call
-func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
+// ParseGetInvoiceResponse parses an HTTP response from a GetInvoiceWithResponse call
+func ParseGetInvoiceResponse(rsp *http.Response) (*GetInvoiceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CancelResponse{
+	response := &GetInvoiceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -601,33 +2663,73 @@ func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
 	return response, nil
 }
 
-// ParseGetResponse parses an HTTP response from a GetWithResponse call
-func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+// ParseGenerateInvoiceResponse parses an HTTP response from a GenerateInvoiceWithResponse call
+func ParseGenerateInvoiceResponse(rsp *http.Response) (*GenerateInvoiceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResponse{
+	response := &GenerateInvoiceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRentalLedgerResponse parses an HTTP response from a GetRentalLedgerWithResponse call
+func ParseGetRentalLedgerResponse(rsp *http.Response) (*GetRentalLedgerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRentalLedgerResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PaymentInfo
+		var dest RentalLedger
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON403 = &dest
 
 	}
 
@@ -12,18 +12,114 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ChargeKind.
+const (
+	LATERETURN ChargeKind = "LATE_RETURN"
+	MILEAGE    ChargeKind = "MILEAGE"
+	REFUEL     ChargeKind = "REFUEL"
+)
+
+// Defines values for ChargeUnit.
+const (
+	DAY     ChargeUnit = "DAY"
+	HOUR    ChargeUnit = "HOUR"
+	KM      ChargeUnit = "KM"
+	PERCENT ChargeUnit = "PERCENT"
+)
+
+// Defines values for PriceItemKind.
+const (
+	BASE             PriceItemKind = "BASE"
+	DATECHANGE       PriceItemKind = "DATE_CHANGE"
+	DURATIONDISCOUNT PriceItemKind = "DURATION_DISCOUNT"
+	SEASON           PriceItemKind = "SEASON"
+	WEEKEND          PriceItemKind = "WEEKEND"
+)
+
 // Defines values for RentalResponseStatus.
 const (
-	CANCELED   RentalResponseStatus = "CANCELED"
-	FINISHED   RentalResponseStatus = "FINISHED"
-	INPROGRESS RentalResponseStatus = "IN_PROGRESS"
+	RentalResponseStatusCANCELED   RentalResponseStatus = "CANCELED"
+	RentalResponseStatusFINISHED   RentalResponseStatus = "FINISHED"
+	RentalResponseStatusINPROGRESS RentalResponseStatus = "IN_PROGRESS"
+	RentalResponseStatusOVERDUE    RentalResponseStatus = "OVERDUE"
+	RentalResponseStatusRESERVED   RentalResponseStatus = "RESERVED"
+)
+
+// Defines values for SearchRentalsParamsStatus.
+const (
+	SearchRentalsParamsStatusCANCELED   SearchRentalsParamsStatus = "CANCELED"
+	SearchRentalsParamsStatusFINISHED   SearchRentalsParamsStatus = "FINISHED"
+	SearchRentalsParamsStatusINPROGRESS SearchRentalsParamsStatus = "IN_PROGRESS"
+	SearchRentalsParamsStatusOVERDUE    SearchRentalsParamsStatus = "OVERDUE"
+	SearchRentalsParamsStatusRESERVED   SearchRentalsParamsStatus = "RESERVED"
+)
+
+// Defines values for SearchRentalsParamsSort.
+const (
+	SearchRentalsParamsSortDATEFROMASC  SearchRentalsParamsSort = "DATE_FROM_ASC"
+	SearchRentalsParamsSortDATEFROMDESC SearchRentalsParamsSort = "DATE_FROM_DESC"
+)
+
+// Defines values for GetUserRentalsParamsStatus.
+const (
+	CANCELED   GetUserRentalsParamsStatus = "CANCELED"
+	FINISHED   GetUserRentalsParamsStatus = "FINISHED"
+	INPROGRESS GetUserRentalsParamsStatus = "IN_PROGRESS"
+	OVERDUE    GetUserRentalsParamsStatus = "OVERDUE"
+	RESERVED   GetUserRentalsParamsStatus = "RESERVED"
+)
+
+// Defines values for GetUserRentalsParamsSort.
+const (
+	GetUserRentalsParamsSortDATEFROMASC  GetUserRentalsParamsSort = "DATE_FROM_ASC"
+	GetUserRentalsParamsSortDATEFROMDESC GetUserRentalsParamsSort = "DATE_FROM_DESC"
 )
 
+// CarReadings defines model for CarReadings.
+type CarReadings struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+}
+
+// ChangeRentalRequest defines model for ChangeRentalRequest.
+type ChangeRentalRequest struct {
+	// DateTo Новая дата окончания аренды
+	DateTo string `json:"dateTo"`
+}
+
+// Charge defines model for Charge.
+type Charge struct {
+	// Amount Сумма начисления в минимальных единицах валюты аренды
+	Amount int `json:"amount"`
+
+	// Description Описание начисления
+	Description string `json:"description"`
+
+	// Kind Вид начисления
+	Kind ChargeKind `json:"kind"`
+
+	// Quantity Количество единиц
+	Quantity int `json:"quantity"`
+
+	// Unit Единица измерения
+	Unit ChargeUnit `json:"unit"`
+}
+
+// ChargeKind Вид начисления
+type ChargeKind string
+
+// ChargeUnit Единица измерения
+type ChargeUnit string
+
 // CreateRentalRequest defines model for CreateRentalRequest.
 type CreateRentalRequest struct {
 	// CarUid UUID автомобиля
@@ -37,6 +133,9 @@ type CreateRentalRequest struct {
 
 	// PaymentUid UUID платежа
 	PaymentUid openapi_types.UUID `json:"paymentUid"`
+
+	// QuoteUid UUID расчета стоимости, по которому оформляется аренда
+	QuoteUid openapi_types.UUID `json:"quoteUid"`
 }
 
 // ErrorDescription defines model for ErrorDescription.
@@ -51,11 +150,190 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// FinishRentalRequest defines model for FinishRentalRequest.
+type FinishRentalRequest struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+
+	// ReturnedAt Фактическое время возврата, по умолчанию - текущее
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+}
+
+// ForceCancelRequest defines model for ForceCancelRequest.
+type ForceCancelRequest struct {
+	// Reason Причина отмены, записывается в историю аренды
+	Reason string `json:"reason"`
+}
+
+// ForceFinishRequest defines model for ForceFinishRequest.
+type ForceFinishRequest struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+
+	// Reason Причина завершения, записывается в историю аренды
+	Reason string `json:"reason"`
+
+	// ReturnedAt Фактическое время возврата, по умолчанию - текущее
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+}
+
+// OwnersRequest defines model for OwnersRequest.
+type OwnersRequest struct {
+	// PaymentUids UUID платежей за аренду
+	PaymentUids *[]openapi_types.UUID `json:"paymentUids,omitempty"`
+
+	// RentalUids UUID аренд
+	RentalUids *[]openapi_types.UUID `json:"rentalUids,omitempty"`
+}
+
+// PriceItem defines model for PriceItem.
+type PriceItem struct {
+	// Amount Сумма строки в минимальных единицах валюты, отрицательная для скидок
+	Amount int `json:"amount"`
+
+	// Description Описание строки расчета
+	Description string `json:"description"`
+
+	// Kind Вид строки расчета
+	Kind PriceItemKind `json:"kind"`
+
+	// Quantity Количество дней, к которым применена строка
+	Quantity int `json:"quantity"`
+}
+
+// PriceItemKind Вид строки расчета
+type PriceItemKind string
+
+// QuoteRequest defines model for QuoteRequest.
+type QuoteRequest struct {
+	// CarType Тип автомобиля
+	CarType string `json:"carType"`
+
+	// CarUid UUID автомобиля
+	CarUid openapi_types.UUID `json:"carUid"`
+
+	// Currency Код валюты ISO 4217 цены автомобиля, по умолчанию - валюта сервиса
+	Currency *string `json:"currency,omitempty"`
+
+	// DailyPrice Цена аренды автомобиля за день в минимальных единицах валюты
+	DailyPrice int `json:"dailyPrice"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+}
+
+// QuoteResponse defines model for QuoteResponse.
+type QuoteResponse struct {
+	// CarUid UUID автомобиля
+	CarUid openapi_types.UUID `json:"carUid"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+
+	// Days Количество дней аренды
+	Days int `json:"days"`
+
+	// ExpiresAt Время, до которого действует цена
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Items Детализация стоимости
+	Items []PriceItem `json:"items"`
+
+	// QuoteUid UUID расчета стоимости
+	QuoteUid openapi_types.UUID `json:"quoteUid"`
+
+	// TotalPrice Итоговая стоимость аренды в минимальных единицах валюты
+	TotalPrice int `json:"totalPrice"`
+}
+
+// RentalChange defines model for RentalChange.
+type RentalChange struct {
+	ChangeUid openapi_types.UUID `json:"changeUid"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Currency  string             `json:"currency"`
+	DateTo    string             `json:"dateTo"`
+
+	// Difference Доплата, если положительная, или сумма возврата, если отрицательная
+	Difference     int    `json:"difference"`
+	PreviousDateTo string `json:"previousDateTo"`
+
+	// PreviousPrice Стоимость аренды до изменения в минимальных единицах валюты
+	PreviousPrice int `json:"previousPrice"`
+
+	// Price Стоимость аренды после изменения в минимальных единицах валюты
+	Price      int                `json:"price"`
+	RentalUid  openapi_types.UUID `json:"rentalUid"`
+	RevertedAt *time.Time         `json:"revertedAt,omitempty"`
+}
+
+// RentalEvent defines model for RentalEvent.
+type RentalEvent struct {
+	// Actor Пользователь или сервис, изменивший статус
+	Actor string `json:"actor"`
+
+	// CreatedAt Время изменения
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromStatus Статус до изменения, отсутствует для создания аренды
+	FromStatus *string `json:"fromStatus,omitempty"`
+
+	// Reason Причина изменения
+	Reason *string `json:"reason,omitempty"`
+
+	// RequestId Идентификатор запроса, в рамках которого изменен статус
+	RequestId *string `json:"requestId,omitempty"`
+
+	// ToStatus Статус после изменения
+	ToStatus string `json:"toStatus"`
+}
+
+// RentalOwner defines model for RentalOwner.
+type RentalOwner struct {
+	PaymentUid openapi_types.UUID `json:"paymentUid"`
+	RentalUid  openapi_types.UUID `json:"rentalUid"`
+	Username   string             `json:"username"`
+}
+
+// RentalPaginationResponse defines model for RentalPaginationResponse.
+type RentalPaginationResponse struct {
+	Items []RentalResponse `json:"items"`
+
+	// Page Номер страницы
+	Page int `json:"page"`
+
+	// PageSize Количество элементов на странице
+	PageSize int `json:"pageSize"`
+
+	// TotalElements Количество аренд, подходящих под фильтр
+	TotalElements int `json:"totalElements"`
+}
+
 // RentalResponse defines model for RentalResponse.
 type RentalResponse struct {
 	// CarUid UUID автомобиля
 	CarUid openapi_types.UUID `json:"carUid"`
 
+	// Charges Дополнительные начисления при возврате автомобиля
+	Charges *[]Charge `json:"charges,omitempty"`
+
+	// Currency Код валюты ISO 4217
+	Currency *string `json:"currency,omitempty"`
+
 	// DateFrom Дата начала аренды
 	DateFrom string `json:"dateFrom"`
 
@@ -65,11 +343,23 @@ type RentalResponse struct {
 	// PaymentUid UUID платежа
 	PaymentUid openapi_types.UUID `json:"paymentUid"`
 
+	// Price Стоимость аренды, зафиксированная при бронировании, в минимальных единицах валюты
+	Price *int `json:"price,omitempty"`
+
+	// PriceItems Детализация стоимости
+	PriceItems *[]PriceItem `json:"priceItems,omitempty"`
+
 	// RentalUid UUID аренды
 	RentalUid openapi_types.UUID `json:"rentalUid"`
 
+	// ReturnedAt Фактическое время возврата автомобиля
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+
 	// Status Статус аренды
 	Status RentalResponseStatus `json:"status"`
+
+	// Username Имя пользователя
+	Username *string `json:"username,omitempty"`
 }
 
 // RentalResponseStatus Статус аренды
@@ -84,9 +374,88 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// SearchRentalsParams defines parameters for SearchRentals.
+type SearchRentalsParams struct {
+	// Username Имя пользователя
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+
+	// CarUid UUID автомобиля
+	CarUid *openapi_types.UUID `form:"carUid,omitempty" json:"carUid,omitempty"`
+
+	// Status Статусы аренд, по умолчанию - все
+	Status *[]SearchRentalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// DateFrom Начало периода, аренды которого пересекаются с периодом
+	DateFrom *string `form:"dateFrom,omitempty" json:"dateFrom,omitempty"`
+
+	// DateTo Конец периода, аренды которого пересекаются с периодом
+	DateTo *string `form:"dateTo,omitempty" json:"dateTo,omitempty"`
+
+	// Sort Сортировка по дате начала аренды, по умолчанию - сначала новые
+	Sort *SearchRentalsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page *int                     `form:"page,omitempty" json:"page,omitempty"`
+	Size *int                     `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// SearchRentalsParamsStatus defines parameters for SearchRentals.
+type SearchRentalsParamsStatus string
+
+// SearchRentalsParamsSort defines parameters for SearchRentals.
+type SearchRentalsParamsSort string
+
+// GetUserRentalsParams defines parameters for GetUserRentals.
+type GetUserRentalsParams struct {
+	// Status Статусы аренд, по умолчанию - все
+	Status *[]GetUserRentalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// DateFrom Начало периода, аренды которого пересекаются с периодом
+	DateFrom *string `form:"dateFrom,omitempty" json:"dateFrom,omitempty"`
+
+	// DateTo Конец периода, аренды которого пересекаются с периодом
+	DateTo *string `form:"dateTo,omitempty" json:"dateTo,omitempty"`
+
+	// Sort Сортировка по дате начала аренды, по умолчанию - сначала новые
+	Sort *GetUserRentalsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page *int                      `form:"page,omitempty" json:"page,omitempty"`
+	Size *int                      `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetUserRentalsParamsStatus defines parameters for GetUserRentals.
+type GetUserRentalsParamsStatus string
+
+// GetUserRentalsParamsSort defines parameters for GetUserRentals.
+type GetUserRentalsParamsSort string
+
+// CancelParams defines parameters for Cancel.
+type CancelParams struct {
+	// Reason Причина отмены
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// ForceCancelJSONRequestBody defines body for ForceCancel for application/json ContentType.
+type ForceCancelJSONRequestBody = ForceCancelRequest
+
+// ForceFinishJSONRequestBody defines body for ForceFinish for application/json ContentType.
+type ForceFinishJSONRequestBody = ForceFinishRequest
+
+// CreateQuoteJSONRequestBody defines body for CreateQuote for application/json ContentType.
+type CreateQuoteJSONRequestBody = QuoteRequest
+
 // CreateJSONRequestBody defines body for Create for application/json ContentType.
 type CreateJSONRequestBody = CreateRentalRequest
 
+// FindOwnersJSONRequestBody defines body for FindOwners for application/json ContentType.
+type FindOwnersJSONRequestBody = OwnersRequest
+
+// ChangeDatesJSONRequestBody defines body for ChangeDates for application/json ContentType.
+type ChangeDatesJSONRequestBody = ChangeRentalRequest
+
+// FinishJSONRequestBody defines body for Finish for application/json ContentType.
+type FinishJSONRequestBody = FinishRentalRequest
+
+// StartJSONRequestBody defines body for Start for application/json ContentType.
+type StartJSONRequestBody = CarReadings
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -160,29 +529,79 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// SearchRentals request
+	SearchRentals(ctx context.Context, params *SearchRentalsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAnyRental request
+	GetAnyRental(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ForceCancelWithBody request with any body
+	ForceCancelWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ForceCancel(ctx context.Context, rentalUid openapi_types.UUID, body ForceCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ForceFinishWithBody request with any body
+	ForceFinishWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ForceFinish(ctx context.Context, rentalUid openapi_types.UUID, body ForceFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAnyRentalHistory request
+	GetAnyRentalHistory(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateQuoteWithBody request with any body
+	CreateQuoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateQuote(ctx context.Context, body CreateQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQuote request
+	GetQuote(ctx context.Context, quoteUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUserRentals request
-	GetUserRentals(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetUserRentals(ctx context.Context, params *GetUserRentalsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateWithBody request with any body
 	CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// FindOwnersWithBody request with any body
+	FindOwnersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	FindOwners(ctx context.Context, body FindOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Cancel request
-	Cancel(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Cancel(ctx context.Context, rentalUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Get request
 	Get(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// Finish request
-	Finish(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ChangeDatesWithBody request with any body
+	ChangeDatesWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangeDates(ctx context.Context, rentalUid openapi_types.UUID, body ChangeDatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevertChange request
+	RevertChange(ctx context.Context, rentalUid openapi_types.UUID, changeUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// FinishWithBody request with any body
+	FinishWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Finish(ctx context.Context, rentalUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHistory request
+	GetHistory(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartWithBody request with any body
+	StartWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Start(ctx context.Context, rentalUid openapi_types.UUID, body StartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Live request
 	Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetUserRentals(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserRentalsRequest(c.Server)
+func (c *Client) SearchRentals(ctx context.Context, params *SearchRentalsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchRentalsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -193,8 +612,8 @@ func (c *Client) GetUserRentals(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAnyRental(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnyRentalRequest(c.Server, rentalUid)
 	if err != nil {
 		return nil, err
 	}
@@ -205,8 +624,8 @@ func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io
 	return c.Client.Do(req)
 }
 
-func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRequest(c.Server, body)
+func (c *Client) ForceCancelWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForceCancelRequestWithBody(c.Server, rentalUid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -217,8 +636,8 @@ func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) Cancel(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCancelRequest(c.Server, rentalUid)
+func (c *Client) ForceCancel(ctx context.Context, rentalUid openapi_types.UUID, body ForceCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForceCancelRequest(c.Server, rentalUid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -229,8 +648,8 @@ func (c *Client) Cancel(ctx context.Context, rentalUid openapi_types.UUID, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) Get(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRequest(c.Server, rentalUid)
+func (c *Client) ForceFinishWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForceFinishRequestWithBody(c.Server, rentalUid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -241,8 +660,8 @@ func (c *Client) Get(ctx context.Context, rentalUid openapi_types.UUID, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) Finish(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFinishRequest(c.Server, rentalUid)
+func (c *Client) ForceFinish(ctx context.Context, rentalUid openapi_types.UUID, body ForceFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewForceFinishRequest(c.Server, rentalUid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -253,8 +672,8 @@ func (c *Client) Finish(ctx context.Context, rentalUid openapi_types.UUID, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLiveRequest(c.Server)
+func (c *Client) GetAnyRentalHistory(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnyRentalHistoryRequest(c.Server, rentalUid)
 	if err != nil {
 		return nil, err
 	}
@@ -265,124 +684,244 @@ func (c *Client) Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http
 	return c.Client.Do(req)
 }
 
-// NewGetUserRentalsRequest generates requests for GetUserRentals
-func NewGetUserRentalsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateQuoteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateQuoteRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/rental")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateQuote(ctx context.Context, body CreateQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateQuoteRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateRequest calls the generic Create builder with application/json body
-func NewCreateRequest(server string, body CreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetQuote(ctx context.Context, quoteUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQuoteRequest(c.Server, quoteUid)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateRequestWithBody generates requests for Create with any type of body
-func NewCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetUserRentals(ctx context.Context, params *GetUserRentalsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRentalsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/rental")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCancelRequest generates requests for Cancel
-func NewCancelRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) FindOwnersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindOwnersRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/rental/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FindOwners(ctx context.Context, body FindOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFindOwnersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) Cancel(ctx context.Context, rentalUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelRequest(c.Server, rentalUid, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) Get(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRequest(c.Server, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetRequest generates requests for Get
-func NewGetRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
-	var err error
+func (c *Client) ChangeDatesWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangeDatesRequestWithBody(c.Server, rentalUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) ChangeDates(ctx context.Context, rentalUid openapi_types.UUID, body ChangeDatesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangeDatesRequest(c.Server, rentalUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+func (c *Client) RevertChange(ctx context.Context, rentalUid openapi_types.UUID, changeUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevertChangeRequest(c.Server, rentalUid, changeUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) FinishWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinishRequestWithBody(c.Server, rentalUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Finish(ctx context.Context, rentalUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinishRequest(c.Server, rentalUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHistory(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHistoryRequest(c.Server, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartWithBody(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartRequestWithBody(c.Server, rentalUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Start(ctx context.Context, rentalUid openapi_types.UUID, body StartJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartRequest(c.Server, rentalUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Live(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLiveRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewSearchRentalsRequest generates requests for SearchRentals
+func NewSearchRentalsRequest(server string, params *SearchRentalsParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/rental/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/rentals")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -392,6 +931,140 @@ func NewGetRequest(server string, rentalUid openapi_types.UUID) (*http.Request,
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CarUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "carUid", runtime.ParamLocationQuery, *params.CarUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateFrom", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateTo", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -400,8 +1073,8 @@ func NewGetRequest(server string, rentalUid openapi_types.UUID) (*http.Request,
 	return req, nil
 }
 
-// NewFinishRequest generates requests for Finish
-func NewFinishRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
+// NewGetAnyRentalRequest generates requests for GetAnyRental
+func NewGetAnyRentalRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -416,7 +1089,7 @@ func NewFinishRequest(server string, rentalUid openapi_types.UUID) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/rental/%s/finish", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/admin/rentals/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -426,7 +1099,7 @@ func NewFinishRequest(server string, rentalUid openapi_types.UUID) (*http.Reques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -434,16 +1107,34 @@ func NewFinishRequest(server string, rentalUid openapi_types.UUID) (*http.Reques
 	return req, nil
 }
 
-// NewLiveRequest generates requests for Live
-func NewLiveRequest(server string) (*http.Request, error) {
+// NewForceCancelRequest calls the generic ForceCancel builder with application/json body
+func NewForceCancelRequest(server string, rentalUid openapi_types.UUID, body ForceCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewForceCancelRequestWithBody(server, rentalUid, "application/json", bodyReader)
+}
+
+// NewForceCancelRequestWithBody generates requests for ForceCancel with any type of body
+func NewForceCancelRequestWithBody(server string, rentalUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/manage/health")
+	operationPath := fmt.Sprintf("/api/v1/admin/rentals/%s/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -453,317 +1144,1975 @@ func NewLiveRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewForceFinishRequest calls the generic ForceFinish builder with application/json body
+func NewForceFinishRequest(server string, rentalUid openapi_types.UUID, body ForceFinishJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewForceFinishRequestWithBody(server, rentalUid, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewForceFinishRequestWithBody generates requests for ForceFinish with any type of body
+func NewForceFinishRequestWithBody(server string, rentalUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetUserRentalsWithResponse request
-	GetUserRentalsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserRentalsResponse, error)
-
-	// CreateWithBodyWithResponse request with any body
-	CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
 
-	CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/admin/rentals/%s/finish", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CancelWithResponse request
-	CancelWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetWithResponse request
-	GetWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// FinishWithResponse request
-	FinishWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*FinishResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// LiveWithResponse request
-	LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error)
+	return req, nil
 }
 
-type GetUserRentalsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RentalResponse
-}
+// NewGetAnyRentalHistoryRequest generates requests for GetAnyRentalHistory
+func NewGetAnyRentalHistoryRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetUserRentalsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserRentalsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RentalResponse
-	JSON400      *ValidationErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/admin/rentals/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON403      *ErrorResponse
-	JSON404      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateQuoteRequest calls the generic CreateQuote builder with application/json body
+func NewCreateQuoteRequest(server string, body CreateQuoteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateQuoteRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateQuoteRequestWithBody generates requests for CreateQuote with any type of body
+func NewCreateQuoteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RentalResponse
-	JSON403      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/quotes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type FinishResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON404      *ErrorResponse
+// NewGetQuoteRequest generates requests for GetQuote
+func NewGetQuoteRequest(server string, quoteUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "quoteUid", runtime.ParamLocationPath, quoteUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/quotes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FinishResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetUserRentalsRequest generates requests for GetUserRentals
+func NewGetUserRentalsRequest(server string, params *GetUserRentalsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	operationPath := fmt.Sprintf("/api/v1/rental")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateFrom", runtime.ParamLocationQuery, *params.DateFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DateTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateTo", runtime.ParamLocationQuery, *params.DateTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FinishResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateRequest calls the generic Create builder with application/json body
+func NewCreateRequest(server string, body CreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRequestWithBody(server, "application/json", bodyReader)
 }
 
-type LiveResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// NewCreateRequestWithBody generates requests for Create with any type of body
+func NewCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r LiveResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewFindOwnersRequest calls the generic FindOwners builder with application/json body
+func NewFindOwnersRequest(server string, body FindOwnersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewFindOwnersRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LiveResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewFindOwnersRequestWithBody generates requests for FindOwners with any type of body
+func NewFindOwnersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/owners")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelRequest generates requests for Cancel
+func NewCancelRequest(server string, rentalUid openapi_types.UUID, params *CancelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Reason != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reason", runtime.ParamLocationQuery, *params.Reason); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRequest generates requests for Get
+func NewGetRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewChangeDatesRequest calls the generic ChangeDates builder with application/json body
+func NewChangeDatesRequest(server string, rentalUid openapi_types.UUID, body ChangeDatesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangeDatesRequestWithBody(server, rentalUid, "application/json", bodyReader)
+}
+
+// NewChangeDatesRequestWithBody generates requests for ChangeDates with any type of body
+func NewChangeDatesRequestWithBody(server string, rentalUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevertChangeRequest generates requests for RevertChange
+func NewRevertChangeRequest(server string, rentalUid openapi_types.UUID, changeUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "changeUid", runtime.ParamLocationPath, changeUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/%s/changes/%s/revert", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFinishRequest calls the generic Finish builder with application/json body
+func NewFinishRequest(server string, rentalUid openapi_types.UUID, body FinishJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFinishRequestWithBody(server, rentalUid, "application/json", bodyReader)
+}
+
+// NewFinishRequestWithBody generates requests for Finish with any type of body
+func NewFinishRequestWithBody(server string, rentalUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/%s/finish", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetHistoryRequest generates requests for GetHistory
+func NewGetHistoryRequest(server string, rentalUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStartRequest calls the generic Start builder with application/json body
+func NewStartRequest(server string, rentalUid openapi_types.UUID, body StartJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartRequestWithBody(server, rentalUid, "application/json", bodyReader)
+}
+
+// NewStartRequestWithBody generates requests for Start with any type of body
+func NewStartRequestWithBody(server string, rentalUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rentalUid", runtime.ParamLocationPath, rentalUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rental/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLiveRequest generates requests for Live
+func NewLiveRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/manage/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// SearchRentalsWithResponse request
+	SearchRentalsWithResponse(ctx context.Context, params *SearchRentalsParams, reqEditors ...RequestEditorFn) (*SearchRentalsResponse, error)
+
+	// GetAnyRentalWithResponse request
+	GetAnyRentalWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAnyRentalResponse, error)
+
+	// ForceCancelWithBodyWithResponse request with any body
+	ForceCancelWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForceCancelResponse, error)
+
+	ForceCancelWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body ForceCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ForceCancelResponse, error)
+
+	// ForceFinishWithBodyWithResponse request with any body
+	ForceFinishWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForceFinishResponse, error)
+
+	ForceFinishWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body ForceFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*ForceFinishResponse, error)
+
+	// GetAnyRentalHistoryWithResponse request
+	GetAnyRentalHistoryWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAnyRentalHistoryResponse, error)
+
+	// CreateQuoteWithBodyWithResponse request with any body
+	CreateQuoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateQuoteResponse, error)
+
+	CreateQuoteWithResponse(ctx context.Context, body CreateQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateQuoteResponse, error)
+
+	// GetQuoteWithResponse request
+	GetQuoteWithResponse(ctx context.Context, quoteUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetQuoteResponse, error)
+
+	// GetUserRentalsWithResponse request
+	GetUserRentalsWithResponse(ctx context.Context, params *GetUserRentalsParams, reqEditors ...RequestEditorFn) (*GetUserRentalsResponse, error)
+
+	// CreateWithBodyWithResponse request with any body
+	CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	// FindOwnersWithBodyWithResponse request with any body
+	FindOwnersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FindOwnersResponse, error)
+
+	FindOwnersWithResponse(ctx context.Context, body FindOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*FindOwnersResponse, error)
+
+	// CancelWithResponse request
+	CancelWithResponse(ctx context.Context, rentalUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*CancelResponse, error)
+
+	// GetWithResponse request
+	GetWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error)
+
+	// ChangeDatesWithBodyWithResponse request with any body
+	ChangeDatesWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangeDatesResponse, error)
+
+	ChangeDatesWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body ChangeDatesJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangeDatesResponse, error)
+
+	// RevertChangeWithResponse request
+	RevertChangeWithResponse(ctx context.Context, rentalUid openapi_types.UUID, changeUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevertChangeResponse, error)
+
+	// FinishWithBodyWithResponse request with any body
+	FinishWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinishResponse, error)
+
+	FinishWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*FinishResponse, error)
+
+	// GetHistoryWithResponse request
+	GetHistoryWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHistoryResponse, error)
+
+	// StartWithBodyWithResponse request with any body
+	StartWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartResponse, error)
+
+	StartWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body StartJSONRequestBody, reqEditors ...RequestEditorFn) (*StartResponse, error)
+
+	// LiveWithResponse request
+	LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error)
+}
+
+type SearchRentalsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalPaginationResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchRentalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchRentalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnyRentalResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnyRentalResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnyRentalResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ForceCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ForceCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ForceCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ForceFinishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ForceFinishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ForceFinishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnyRentalHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RentalEvent
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnyRentalHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnyRentalHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateQuoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuoteResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateQuoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateQuoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQuoteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuoteResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQuoteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQuoteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserRentalsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalPaginationResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserRentalsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserRentalsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FindOwnersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RentalOwner
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r FindOwnersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FindOwnersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangeDatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalChange
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangeDatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangeDatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevertChangeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevertChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevertChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RentalResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r FinishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RentalEvent
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// SearchRentalsWithResponse request returning *SearchRentalsResponse
+func (c *ClientWithResponses) SearchRentalsWithResponse(ctx context.Context, params *SearchRentalsParams, reqEditors ...RequestEditorFn) (*SearchRentalsResponse, error) {
+	rsp, err := c.SearchRentals(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchRentalsResponse(rsp)
+}
+
+// GetAnyRentalWithResponse request returning *GetAnyRentalResponse
+func (c *ClientWithResponses) GetAnyRentalWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAnyRentalResponse, error) {
+	rsp, err := c.GetAnyRental(ctx, rentalUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnyRentalResponse(rsp)
+}
+
+// ForceCancelWithBodyWithResponse request with arbitrary body returning *ForceCancelResponse
+func (c *ClientWithResponses) ForceCancelWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForceCancelResponse, error) {
+	rsp, err := c.ForceCancelWithBody(ctx, rentalUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForceCancelResponse(rsp)
+}
+
+func (c *ClientWithResponses) ForceCancelWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body ForceCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ForceCancelResponse, error) {
+	rsp, err := c.ForceCancel(ctx, rentalUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForceCancelResponse(rsp)
+}
+
+// ForceFinishWithBodyWithResponse request with arbitrary body returning *ForceFinishResponse
+func (c *ClientWithResponses) ForceFinishWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ForceFinishResponse, error) {
+	rsp, err := c.ForceFinishWithBody(ctx, rentalUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForceFinishResponse(rsp)
+}
+
+func (c *ClientWithResponses) ForceFinishWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body ForceFinishJSONRequestBody, reqEditors ...RequestEditorFn) (*ForceFinishResponse, error) {
+	rsp, err := c.ForceFinish(ctx, rentalUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseForceFinishResponse(rsp)
+}
+
+// GetAnyRentalHistoryWithResponse request returning *GetAnyRentalHistoryResponse
+func (c *ClientWithResponses) GetAnyRentalHistoryWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetAnyRentalHistoryResponse, error) {
+	rsp, err := c.GetAnyRentalHistory(ctx, rentalUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnyRentalHistoryResponse(rsp)
+}
+
+// CreateQuoteWithBodyWithResponse request with arbitrary body returning *CreateQuoteResponse
+func (c *ClientWithResponses) CreateQuoteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateQuoteResponse, error) {
+	rsp, err := c.CreateQuoteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateQuoteResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateQuoteWithResponse(ctx context.Context, body CreateQuoteJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateQuoteResponse, error) {
+	rsp, err := c.CreateQuote(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateQuoteResponse(rsp)
+}
+
+// GetQuoteWithResponse request returning *GetQuoteResponse
+func (c *ClientWithResponses) GetQuoteWithResponse(ctx context.Context, quoteUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetQuoteResponse, error) {
+	rsp, err := c.GetQuote(ctx, quoteUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQuoteResponse(rsp)
+}
+
+// GetUserRentalsWithResponse request returning *GetUserRentalsResponse
+func (c *ClientWithResponses) GetUserRentalsWithResponse(ctx context.Context, params *GetUserRentalsParams, reqEditors ...RequestEditorFn) (*GetUserRentalsResponse, error) {
+	rsp, err := c.GetUserRentals(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserRentalsResponse(rsp)
+}
+
+// CreateWithBodyWithResponse request with arbitrary body returning *CreateResponse
+func (c *ClientWithResponses) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.CreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.Create(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+// FindOwnersWithBodyWithResponse request with arbitrary body returning *FindOwnersResponse
+func (c *ClientWithResponses) FindOwnersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FindOwnersResponse, error) {
+	rsp, err := c.FindOwnersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindOwnersResponse(rsp)
+}
+
+func (c *ClientWithResponses) FindOwnersWithResponse(ctx context.Context, body FindOwnersJSONRequestBody, reqEditors ...RequestEditorFn) (*FindOwnersResponse, error) {
+	rsp, err := c.FindOwners(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFindOwnersResponse(rsp)
+}
+
+// CancelWithResponse request returning *CancelResponse
+func (c *ClientWithResponses) CancelWithResponse(ctx context.Context, rentalUid openapi_types.UUID, params *CancelParams, reqEditors ...RequestEditorFn) (*CancelResponse, error) {
+	rsp, err := c.Cancel(ctx, rentalUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelResponse(rsp)
+}
+
+// GetWithResponse request returning *GetResponse
+func (c *ClientWithResponses) GetWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	rsp, err := c.Get(ctx, rentalUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResponse(rsp)
+}
+
+// ChangeDatesWithBodyWithResponse request with arbitrary body returning *ChangeDatesResponse
+func (c *ClientWithResponses) ChangeDatesWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangeDatesResponse, error) {
+	rsp, err := c.ChangeDatesWithBody(ctx, rentalUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangeDatesResponse(rsp)
+}
+
+func (c *ClientWithResponses) ChangeDatesWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body ChangeDatesJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangeDatesResponse, error) {
+	rsp, err := c.ChangeDates(ctx, rentalUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangeDatesResponse(rsp)
+}
+
+// RevertChangeWithResponse request returning *RevertChangeResponse
+func (c *ClientWithResponses) RevertChangeWithResponse(ctx context.Context, rentalUid openapi_types.UUID, changeUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RevertChangeResponse, error) {
+	rsp, err := c.RevertChange(ctx, rentalUid, changeUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevertChangeResponse(rsp)
+}
+
+// FinishWithBodyWithResponse request with arbitrary body returning *FinishResponse
+func (c *ClientWithResponses) FinishWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinishResponse, error) {
+	rsp, err := c.FinishWithBody(ctx, rentalUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinishResponse(rsp)
+}
+
+func (c *ClientWithResponses) FinishWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*FinishResponse, error) {
+	rsp, err := c.Finish(ctx, rentalUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinishResponse(rsp)
+}
+
+// GetHistoryWithResponse request returning *GetHistoryResponse
+func (c *ClientWithResponses) GetHistoryWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHistoryResponse, error) {
+	rsp, err := c.GetHistory(ctx, rentalUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHistoryResponse(rsp)
+}
+
+// StartWithBodyWithResponse request with arbitrary body returning *StartResponse
+func (c *ClientWithResponses) StartWithBodyWithResponse(ctx context.Context, rentalUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartResponse, error) {
+	rsp, err := c.StartWithBody(ctx, rentalUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartWithResponse(ctx context.Context, rentalUid openapi_types.UUID, body StartJSONRequestBody, reqEditors ...RequestEditorFn) (*StartResponse, error) {
+	rsp, err := c.Start(ctx, rentalUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartResponse(rsp)
+}
+
+// LiveWithResponse request returning *LiveResponse
+func (c *ClientWithResponses) LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error) {
+	rsp, err := c.Live(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLiveResponse(rsp)
+}
+
+// ParseSearchRentalsResponse parses an HTTP response from a SearchRentalsWithResponse call
+func ParseSearchRentalsResponse(rsp *http.Response) (*SearchRentalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchRentalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAnyRentalResponse parses an HTTP response from a GetAnyRentalWithResponse call
+func ParseGetAnyRentalResponse(rsp *http.Response) (*GetAnyRentalResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAnyRentalResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseForceCancelResponse parses an HTTP response from a ForceCancelWithResponse call
+func ParseForceCancelResponse(rsp *http.Response) (*ForceCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ForceCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseForceFinishResponse parses an HTTP response from a ForceFinishWithResponse call
+func ParseForceFinishResponse(rsp *http.Response) (*ForceFinishResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ForceFinishResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAnyRentalHistoryResponse parses an HTTP response from a GetAnyRentalHistoryWithResponse call
+func ParseGetAnyRentalHistoryResponse(rsp *http.Response) (*GetAnyRentalHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAnyRentalHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RentalEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateQuoteResponse parses an HTTP response from a CreateQuoteWithResponse call
+func ParseCreateQuoteResponse(rsp *http.Response) (*CreateQuoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateQuoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QuoteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetQuoteResponse parses an HTTP response from a GetQuoteWithResponse call
+func ParseGetQuoteResponse(rsp *http.Response) (*GetQuoteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetQuoteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QuoteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserRentalsResponse parses an HTTP response from a GetUserRentalsWithResponse call
+func ParseGetUserRentalsResponse(rsp *http.Response) (*GetUserRentalsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserRentalsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetUserRentalsWithResponse request returning *GetUserRentalsResponse
-func (c *ClientWithResponses) GetUserRentalsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserRentalsResponse, error) {
-	rsp, err := c.GetUserRentals(ctx, reqEditors...)
+// ParseCreateResponse parses an HTTP response from a CreateWithResponse call
+func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserRentalsResponse(rsp)
-}
 
-// CreateWithBodyWithResponse request with arbitrary body returning *CreateResponse
-func (c *ClientWithResponses) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
-	rsp, err := c.CreateWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
-	rsp, err := c.Create(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RentalResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseCreateResponse(rsp)
+
+	return response, nil
 }
 
-// CancelWithResponse request returning *CancelResponse
-func (c *ClientWithResponses) CancelWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error) {
-	rsp, err := c.Cancel(ctx, rentalUid, reqEditors...)
+// ParseFindOwnersResponse parses an HTTP response from a FindOwnersWithResponse call
+func ParseFindOwnersResponse(rsp *http.Response) (*FindOwnersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCancelResponse(rsp)
-}
 
-// GetWithResponse request returning *GetResponse
-func (c *ClientWithResponses) GetWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error) {
-	rsp, err := c.Get(ctx, rentalUid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &FindOwnersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetResponse(rsp)
-}
 
-// FinishWithResponse request returning *FinishResponse
-func (c *ClientWithResponses) FinishWithResponse(ctx context.Context, rentalUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*FinishResponse, error) {
-	rsp, err := c.Finish(ctx, rentalUid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RentalOwner
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
-	return ParseFinishResponse(rsp)
+
+	return response, nil
 }
 
-// LiveWithResponse request returning *LiveResponse
-func (c *ClientWithResponses) LiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LiveResponse, error) {
-	rsp, err := c.Live(ctx, reqEditors...)
+// ParseCancelResponse parses an HTTP response from a CancelWithResponse call
+func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseLiveResponse(rsp)
+
+	response := &CancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetUserRentalsResponse parses an HTTP response from a GetUserRentalsWithResponse call
-func ParseGetUserRentalsResponse(rsp *http.Response) (*GetUserRentalsResponse, error) {
+// ParseGetResponse parses an HTTP response from a GetWithResponse call
+func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUserRentalsResponse{
+	response := &GetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RentalResponse
+		var dest RentalResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateResponse parses an HTTP response from a CreateWithResponse call
-func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
+// ParseChangeDatesResponse parses an HTTP response from a ChangeDatesWithResponse call
+func ParseChangeDatesResponse(rsp *http.Response) (*ChangeDatesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateResponse{
+	response := &ChangeDatesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RentalResponse
+		var dest RentalChange
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -776,20 +3125,41 @@ func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCancelResponse parses an HTTP response from a CancelWithResponse call
-func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
+// ParseRevertChangeResponse parses an HTTP response from a RevertChangeWithResponse call
+func ParseRevertChangeResponse(rsp *http.Response) (*RevertChangeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CancelResponse{
+	response := &RevertChangeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -809,20 +3179,27 @@ func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetResponse parses an HTTP response from a GetWithResponse call
-func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+// ParseFinishResponse parses an HTTP response from a FinishWithResponse call
+func ParseFinishResponse(rsp *http.Response) (*FinishResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResponse{
+	response := &FinishResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -835,6 +3212,60 @@ func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHistoryResponse parses an HTTP response from a GetHistoryWithResponse call
+func ParseGetHistoryResponse(rsp *http.Response) (*GetHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RentalEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -854,20 +3285,34 @@ func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
 	return response, nil
 }
 
-// ParseFinishResponse parses an HTTP response from a FinishWithResponse call
-func ParseFinishResponse(rsp *http.Response) (*FinishResponse, error) {
+// ParseStartResponse parses an HTTP response from a StartWithResponse call
+func ParseStartResponse(rsp *http.Response) (*StartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FinishResponse{
+	response := &StartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -875,6 +3320,13 @@ func ParseFinishResponse(rsp *http.Response) (*FinishResponse, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
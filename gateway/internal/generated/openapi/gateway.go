@@ -6,18 +6,42 @@ package openapi
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CarRequestType.
+const (
+	CarRequestTypeMINIVAN  CarRequestType = "MINIVAN"
+	CarRequestTypeROADSTER CarRequestType = "ROADSTER"
+	CarRequestTypeSEDAN    CarRequestType = "SEDAN"
+	CarRequestTypeSUV      CarRequestType = "SUV"
+)
+
 // Defines values for CarResponseType.
 const (
-	MINIVAN  CarResponseType = "MINIVAN"
-	ROADSTER CarResponseType = "ROADSTER"
-	SEDAN    CarResponseType = "SEDAN"
-	SUV      CarResponseType = "SUV"
+	CarResponseTypeMINIVAN  CarResponseType = "MINIVAN"
+	CarResponseTypeROADSTER CarResponseType = "ROADSTER"
+	CarResponseTypeSEDAN    CarResponseType = "SEDAN"
+	CarResponseTypeSUV      CarResponseType = "SUV"
+)
+
+// Defines values for ChargeKind.
+const (
+	ChargeKindLATERETURN ChargeKind = "LATE_RETURN"
+	ChargeKindMILEAGE    ChargeKind = "MILEAGE"
+	ChargeKindREFUEL     ChargeKind = "REFUEL"
+)
+
+// Defines values for ChargeUnit.
+const (
+	ChargeUnitDAY     ChargeUnit = "DAY"
+	ChargeUnitHOUR    ChargeUnit = "HOUR"
+	ChargeUnitKM      ChargeUnit = "KM"
+	ChargeUnitPERCENT ChargeUnit = "PERCENT"
 )
 
 // Defines values for CreateRentalResponseStatus.
@@ -25,12 +49,59 @@ const (
 	CreateRentalResponseStatusCANCELED   CreateRentalResponseStatus = "CANCELED"
 	CreateRentalResponseStatusFINISHED   CreateRentalResponseStatus = "FINISHED"
 	CreateRentalResponseStatusINPROGRESS CreateRentalResponseStatus = "IN_PROGRESS"
+	CreateRentalResponseStatusOVERDUE    CreateRentalResponseStatus = "OVERDUE"
+	CreateRentalResponseStatusRESERVED   CreateRentalResponseStatus = "RESERVED"
+)
+
+// Defines values for JournalEntryKind.
+const (
+	CHARGE   JournalEntryKind = "CHARGE"
+	DISCOUNT JournalEntryKind = "DISCOUNT"
+	FEE      JournalEntryKind = "FEE"
+	REFUND   JournalEntryKind = "REFUND"
+)
+
+// Defines values for PaymentInfoKind.
+const (
+	PaymentInfoKindEXTENSION  PaymentInfoKind = "EXTENSION"
+	PaymentInfoKindLATERETURN PaymentInfoKind = "LATE_RETURN"
+	PaymentInfoKindMILEAGE    PaymentInfoKind = "MILEAGE"
+	PaymentInfoKindREFUEL     PaymentInfoKind = "REFUEL"
+	PaymentInfoKindRENTAL     PaymentInfoKind = "RENTAL"
 )
 
 // Defines values for PaymentInfoStatus.
 const (
-	PAID     PaymentInfoStatus = "PAID"
-	REVERSED PaymentInfoStatus = "REVERSED"
+	PaymentInfoStatusAUTHORIZED        PaymentInfoStatus = "AUTHORIZED"
+	PaymentInfoStatusCANCELED          PaymentInfoStatus = "CANCELED"
+	PaymentInfoStatusCAPTURED          PaymentInfoStatus = "CAPTURED"
+	PaymentInfoStatusFAILED            PaymentInfoStatus = "FAILED"
+	PaymentInfoStatusPAID              PaymentInfoStatus = "PAID"
+	PaymentInfoStatusPARTIALLYREFUNDED PaymentInfoStatus = "PARTIALLY_REFUNDED"
+	PaymentInfoStatusPENDING           PaymentInfoStatus = "PENDING"
+	PaymentInfoStatusREFUNDED          PaymentInfoStatus = "REFUNDED"
+	PaymentInfoStatusREVERSED          PaymentInfoStatus = "REVERSED"
+)
+
+// Defines values for PriceItemKind.
+const (
+	BASE             PriceItemKind = "BASE"
+	DATECHANGE       PriceItemKind = "DATE_CHANGE"
+	DURATIONDISCOUNT PriceItemKind = "DURATION_DISCOUNT"
+	SEASON           PriceItemKind = "SEASON"
+	WEEKEND          PriceItemKind = "WEEKEND"
+)
+
+// Defines values for PromoCodeRequestKind.
+const (
+	PromoCodeRequestKindFIXED   PromoCodeRequestKind = "FIXED"
+	PromoCodeRequestKindPERCENT PromoCodeRequestKind = "PERCENT"
+)
+
+// Defines values for PromoCodeResponseKind.
+const (
+	FIXED   PromoCodeResponseKind = "FIXED"
+	PERCENT PromoCodeResponseKind = "PERCENT"
 )
 
 // Defines values for RentalResponseStatus.
@@ -38,9 +109,164 @@ const (
 	RentalResponseStatusCANCELED   RentalResponseStatus = "CANCELED"
 	RentalResponseStatusFINISHED   RentalResponseStatus = "FINISHED"
 	RentalResponseStatusINPROGRESS RentalResponseStatus = "IN_PROGRESS"
-	RentalResponseStatusNEW        RentalResponseStatus = "NEW"
+	RentalResponseStatusOVERDUE    RentalResponseStatus = "OVERDUE"
+	RentalResponseStatusRESERVED   RentalResponseStatus = "RESERVED"
+)
+
+// Defines values for ReplayRetryCommandsRequestType.
+const (
+	ReplayRetryCommandsRequestTypeCARUNBOOK      ReplayRetryCommandsRequestType = "CAR_UNBOOK"
+	ReplayRetryCommandsRequestTypePAYMENTCANCEL  ReplayRetryCommandsRequestType = "PAYMENT_CANCEL"
+	ReplayRetryCommandsRequestTypePAYMENTCAPTURE ReplayRetryCommandsRequestType = "PAYMENT_CAPTURE"
+	ReplayRetryCommandsRequestTypePAYMENTCHARGE  ReplayRetryCommandsRequestType = "PAYMENT_CHARGE"
+)
+
+// Defines values for RetryCommandStatus.
+const (
+	RetryCommandStatusDEAD      RetryCommandStatus = "DEAD"
+	RetryCommandStatusDISCARDED RetryCommandStatus = "DISCARDED"
+	RetryCommandStatusDONE      RetryCommandStatus = "DONE"
+	RetryCommandStatusPENDING   RetryCommandStatus = "PENDING"
+)
+
+// Defines values for RetryCommandType.
+const (
+	RetryCommandTypeCARUNBOOK      RetryCommandType = "CAR_UNBOOK"
+	RetryCommandTypePAYMENTCANCEL  RetryCommandType = "PAYMENT_CANCEL"
+	RetryCommandTypePAYMENTCAPTURE RetryCommandType = "PAYMENT_CAPTURE"
+	RetryCommandTypePAYMENTCHARGE  RetryCommandType = "PAYMENT_CHARGE"
+)
+
+// Defines values for RetryTopicBacklogTypes.
+const (
+	RetryTopicBacklogTypesCARUNBOOK      RetryTopicBacklogTypes = "CAR_UNBOOK"
+	RetryTopicBacklogTypesPAYMENTCANCEL  RetryTopicBacklogTypes = "PAYMENT_CANCEL"
+	RetryTopicBacklogTypesPAYMENTCAPTURE RetryTopicBacklogTypes = "PAYMENT_CAPTURE"
+	RetryTopicBacklogTypesPAYMENTCHARGE  RetryTopicBacklogTypes = "PAYMENT_CHARGE"
+)
+
+// Defines values for TaxMode.
+const (
+	EXCLUSIVE TaxMode = "EXCLUSIVE"
+	INCLUSIVE TaxMode = "INCLUSIVE"
+)
+
+// Defines values for SearchRentalsParamsStatus.
+const (
+	SearchRentalsParamsStatusCANCELED   SearchRentalsParamsStatus = "CANCELED"
+	SearchRentalsParamsStatusFINISHED   SearchRentalsParamsStatus = "FINISHED"
+	SearchRentalsParamsStatusINPROGRESS SearchRentalsParamsStatus = "IN_PROGRESS"
+	SearchRentalsParamsStatusOVERDUE    SearchRentalsParamsStatus = "OVERDUE"
+	SearchRentalsParamsStatusRESERVED   SearchRentalsParamsStatus = "RESERVED"
+)
+
+// Defines values for SearchRentalsParamsSort.
+const (
+	SearchRentalsParamsSortDATEFROMASC  SearchRentalsParamsSort = "DATE_FROM_ASC"
+	SearchRentalsParamsSortDATEFROMDESC SearchRentalsParamsSort = "DATE_FROM_DESC"
+)
+
+// Defines values for ListRetryCommandsParamsStatus.
+const (
+	ListRetryCommandsParamsStatusDEAD      ListRetryCommandsParamsStatus = "DEAD"
+	ListRetryCommandsParamsStatusDISCARDED ListRetryCommandsParamsStatus = "DISCARDED"
+	ListRetryCommandsParamsStatusDONE      ListRetryCommandsParamsStatus = "DONE"
+	ListRetryCommandsParamsStatusPENDING   ListRetryCommandsParamsStatus = "PENDING"
 )
 
+// Defines values for ListRetryCommandsParamsType.
+const (
+	ListRetryCommandsParamsTypeCARUNBOOK      ListRetryCommandsParamsType = "CAR_UNBOOK"
+	ListRetryCommandsParamsTypePAYMENTCANCEL  ListRetryCommandsParamsType = "PAYMENT_CANCEL"
+	ListRetryCommandsParamsTypePAYMENTCAPTURE ListRetryCommandsParamsType = "PAYMENT_CAPTURE"
+	ListRetryCommandsParamsTypePAYMENTCHARGE  ListRetryCommandsParamsType = "PAYMENT_CHARGE"
+)
+
+// Defines values for GetUserRentalsParamsStatus.
+const (
+	CANCELED   GetUserRentalsParamsStatus = "CANCELED"
+	FINISHED   GetUserRentalsParamsStatus = "FINISHED"
+	INPROGRESS GetUserRentalsParamsStatus = "IN_PROGRESS"
+	OVERDUE    GetUserRentalsParamsStatus = "OVERDUE"
+	RESERVED   GetUserRentalsParamsStatus = "RESERVED"
+)
+
+// Defines values for GetUserRentalsParamsSort.
+const (
+	GetUserRentalsParamsSortDATEFROMASC  GetUserRentalsParamsSort = "DATE_FROM_ASC"
+	GetUserRentalsParamsSortDATEFROMDESC GetUserRentalsParamsSort = "DATE_FROM_DESC"
+)
+
+// AdminRental defines model for AdminRental.
+type AdminRental struct {
+	CarUid     openapi_types.UUID `json:"carUid"`
+	Currency   *string            `json:"currency,omitempty"`
+	DateFrom   string             `json:"dateFrom"`
+	DateTo     string             `json:"dateTo"`
+	PaymentUid openapi_types.UUID `json:"paymentUid"`
+
+	// Price Стоимость аренды в минимальных единицах валюты
+	Price      *int               `json:"price,omitempty"`
+	RentalUid  openapi_types.UUID `json:"rentalUid"`
+	ReturnedAt *time.Time         `json:"returnedAt,omitempty"`
+
+	// Status Статус аренды
+	Status string `json:"status"`
+
+	// Username Имя пользователя
+	Username string `json:"username"`
+}
+
+// AdminRentalDetails defines model for AdminRentalDetails.
+type AdminRentalDetails struct {
+	Car        CarInfo            `json:"car"`
+	CarUid     openapi_types.UUID `json:"carUid"`
+	Charges    []Charge           `json:"charges"`
+	Currency   *string            `json:"currency,omitempty"`
+	DateFrom   string             `json:"dateFrom"`
+	DateTo     string             `json:"dateTo"`
+	History    []RentalEvent      `json:"history"`
+	Payment    PaymentInfo        `json:"payment"`
+	PaymentUid openapi_types.UUID `json:"paymentUid"`
+
+	// Price Стоимость аренды в минимальных единицах валюты
+	Price      *int               `json:"price,omitempty"`
+	PriceItems []PriceItem        `json:"priceItems"`
+	RentalUid  openapi_types.UUID `json:"rentalUid"`
+	ReturnedAt *time.Time         `json:"returnedAt,omitempty"`
+
+	// Status Статус аренды
+	Status string `json:"status"`
+
+	// Username Имя пользователя
+	Username string `json:"username"`
+}
+
+// AdminRentalPage defines model for AdminRentalPage.
+type AdminRentalPage struct {
+	Items []AdminRental `json:"items"`
+
+	// Page Номер страницы
+	Page int `json:"page"`
+
+	// PageSize Количество элементов на странице
+	PageSize int `json:"pageSize"`
+
+	// TotalElements Количество аренд, подходящих под фильтр
+	TotalElements int `json:"totalElements"`
+}
+
+// CancelRentalResponse defines model for CancelRentalResponse.
+type CancelRentalResponse struct {
+	Payment *PaymentInfo `json:"payment,omitempty"`
+
+	// RentalUid UUID аренды
+	RentalUid openapi_types.UUID `json:"rentalUid"`
+
+	// Status Статус аренды
+	Status string `json:"status"`
+}
+
 // CarInfo defines model for CarInfo.
 type CarInfo struct {
 	// Brand Марка автомобиля
@@ -56,8 +282,47 @@ type CarInfo struct {
 	RegistrationNumber string `json:"registrationNumber"`
 }
 
+// CarReadings defines model for CarReadings.
+type CarReadings struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+}
+
+// CarRequest defines model for CarRequest.
+type CarRequest struct {
+	// Brand Марка автомобиля
+	Brand string `json:"brand"`
+
+	// Currency Код валюты цены ISO 4217, по умолчанию - валюта сервиса
+	Currency *string `json:"currency,omitempty"`
+
+	// Model Модель автомобиля
+	Model string `json:"model"`
+
+	// Power Мощность автомобиля в лошадиных силах
+	Power *int `json:"power,omitempty"`
+
+	// Price Цена автомобиля за сутки в минимальных единицах валюты
+	Price int `json:"price"`
+
+	// RegistrationNumber Регистрационный номер автомобиля
+	RegistrationNumber string `json:"registrationNumber"`
+
+	// Type Тип автомобиля
+	Type CarRequestType `json:"type"`
+}
+
+// CarRequestType Тип автомобиля
+type CarRequestType string
+
 // CarResponse defines model for CarResponse.
 type CarResponse struct {
+	// Archived Флаг, указывающий что автомобиль убран в архив
+	Archived *bool `json:"archived,omitempty"`
+
 	// Available Флаг, указывающий что автомобиль доступен для бронирования
 	Available bool `json:"available"`
 
@@ -67,13 +332,19 @@ type CarResponse struct {
 	// CarUid UUID автомобиля
 	CarUid openapi_types.UUID `json:"carUid"`
 
+	// Currency Код валюты цены ISO 4217
+	Currency string `json:"currency"`
+
+	// DisplayPrice Сумма, пересчитанная в валюту из заголовка X-Currency по курсу сервиса; только для отображения
+	DisplayPrice *Money `json:"displayPrice,omitempty"`
+
 	// Model Модель автомобиля
 	Model string `json:"model"`
 
 	// Power Мощность автомобиля в лошадиных силах
 	Power *int `json:"power,omitempty"`
 
-	// Price Цена автомобиля за сутки
+	// Price Цена автомобиля за сутки в минимальных единицах валюты
 	Price int `json:"price"`
 
 	// RegistrationNumber Регистрационный номер автомобиля
@@ -86,6 +357,39 @@ type CarResponse struct {
 // CarResponseType Тип автомобиля
 type CarResponseType string
 
+// ChangeRentalRequest defines model for ChangeRentalRequest.
+type ChangeRentalRequest struct {
+	// DateTo Новая дата окончания аренды
+	DateTo string `json:"dateTo"`
+
+	// PaymentMethod Токен способа оплаты для доплаты при продлении
+	PaymentMethod *string `json:"paymentMethod,omitempty"`
+}
+
+// Charge defines model for Charge.
+type Charge struct {
+	// Amount Сумма начисления в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Description Описание начисления
+	Description string `json:"description"`
+
+	// Kind Вид начисления
+	Kind ChargeKind `json:"kind"`
+
+	// Quantity Количество единиц
+	Quantity int `json:"quantity"`
+
+	// Unit Единица измерения
+	Unit ChargeUnit `json:"unit"`
+}
+
+// ChargeKind Вид начисления
+type ChargeKind string
+
+// ChargeUnit Единица измерения
+type ChargeUnit string
+
 // CreateRentalRequest defines model for CreateRentalRequest.
 type CreateRentalRequest struct {
 	// CarUid UUID автомобиля
@@ -96,6 +400,15 @@ type CreateRentalRequest struct {
 
 	// DateTo Дата окончания аренды
 	DateTo string `json:"dateTo"`
+
+	// PaymentMethod Токен способа оплаты для платежного провайдера
+	PaymentMethod *string `json:"paymentMethod,omitempty"`
+
+	// PromoCode Промокод на скидку
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// QuoteId UUID расчета стоимости; без него цена рассчитывается в момент бронирования
+	QuoteId *openapi_types.UUID `json:"quoteId,omitempty"`
 }
 
 // CreateRentalResponse defines model for CreateRentalResponse.
@@ -120,6 +433,15 @@ type CreateRentalResponse struct {
 // CreateRentalResponseStatus Статус аренды
 type CreateRentalResponseStatus string
 
+// DiscardRetryCommandsRequest defines model for DiscardRetryCommandsRequest.
+type DiscardRetryCommandsRequest struct {
+	// CommandUids Команды, которые нужно отбросить
+	CommandUids []openapi_types.UUID `json:"commandUids"`
+
+	// Reason Причина, по которой команды отброшены
+	Reason string `json:"reason"`
+}
+
 // ErrorDescription defines model for ErrorDescription.
 type ErrorDescription struct {
 	Error string `json:"error"`
@@ -132,6 +454,111 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// Exponent Количество знаков минимальных единиц валюты
+	Exponent int `json:"exponent"`
+
+	// Rate Стоимость единицы базовой валюты в этой валюте
+	Rate float64 `json:"rate"`
+
+	// UpdatedAt Время обновления курса
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ExchangeRateRequest defines model for ExchangeRateRequest.
+type ExchangeRateRequest struct {
+	// Exponent Количество знаков минимальных единиц валюты
+	Exponent int `json:"exponent"`
+
+	// Rate Стоимость единицы базовой валюты в этой валюте
+	Rate float64 `json:"rate"`
+}
+
+// FinishRentalRequest defines model for FinishRentalRequest.
+type FinishRentalRequest struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+
+	// ReturnedAt Фактическое время возврата, по умолчанию - текущее
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+}
+
+// ForceCancelRequest defines model for ForceCancelRequest.
+type ForceCancelRequest struct {
+	// Reason Причина отмены, записывается в историю аренды
+	Reason string `json:"reason"`
+}
+
+// ForceFinishRequest defines model for ForceFinishRequest.
+type ForceFinishRequest struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+
+	// Reason Причина завершения, записывается в историю аренды
+	Reason string `json:"reason"`
+
+	// ReturnedAt Фактическое время возврата, по умолчанию - текущее
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+}
+
+// JournalEntry defines model for JournalEntry.
+type JournalEntry struct {
+	// Amount Сумма проводки в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// CreatedAt Время проводки
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// EntryUid UUID проводки
+	EntryUid openapi_types.UUID `json:"entryUid"`
+
+	// Kind Вид проводки
+	Kind JournalEntryKind `json:"kind"`
+
+	// PaymentUid UUID платежа
+	PaymentUid openapi_types.UUID `json:"paymentUid"`
+
+	// Postings Дебетуемые и кредитуемые счета
+	Postings []Posting `json:"postings"`
+}
+
+// JournalEntryKind Вид проводки
+type JournalEntryKind string
+
+// LedgerBalance defines model for LedgerBalance.
+type LedgerBalance struct {
+	// Account Счет
+	Account string `json:"account"`
+
+	// Amount Дебет за вычетом кредита в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+}
+
+// Money Сумма, пересчитанная в валюту из заголовка X-Currency по курсу сервиса; только для отображения
+type Money struct {
+	// Amount Сумма в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+}
+
 // PaginationResponse defines model for PaginationResponse.
 type PaginationResponse struct {
 	Items []CarResponse `json:"items"`
@@ -148,127 +575,1059 @@ type PaginationResponse struct {
 
 // PaymentInfo defines model for PaymentInfo.
 type PaymentInfo struct {
+	// Captured Списанная сумма
+	Captured *int `json:"captured,omitempty"`
+
+	// Currency Код валюты платежа ISO 4217
+	Currency *string `json:"currency,omitempty"`
+
+	// Discount Скидка по промокоду, уже вычтенная из суммы платежа
+	Discount *int `json:"discount,omitempty"`
+
+	// DisplayPrice Сумма, пересчитанная в валюту из заголовка X-Currency по курсу сервиса; только для отображения
+	DisplayPrice *Money `json:"displayPrice,omitempty"`
+
+	// FailureReason Причина отказа платежного провайдера
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// Kind Назначение платежа
+	Kind *PaymentInfoKind `json:"kind,omitempty"`
+
 	// PaymentUid UUID платежа
 	PaymentUid openapi_types.UUID `json:"paymentUid"`
 
-	// Price Сумма платежа
+	// Price Сумма платежа в минимальных единицах валюты
 	Price int `json:"price"`
 
+	// PromoCode Примененный промокод
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Refunded Возвращенная сумма
+	Refunded *int `json:"refunded,omitempty"`
+
 	// Status Статус платежа
 	Status PaymentInfoStatus `json:"status"`
+
+	// Taxes Налоги, входящие в сумму платежа
+	Taxes *[]Tax `json:"taxes,omitempty"`
 }
 
+// PaymentInfoKind Назначение платежа
+type PaymentInfoKind string
+
 // PaymentInfoStatus Статус платежа
 type PaymentInfoStatus string
 
-// RentalResponse defines model for RentalResponse.
-type RentalResponse struct {
-	Car CarInfo `json:"car"`
-
-	// DateFrom Дата начала аренды
-	DateFrom string `json:"dateFrom"`
-
-	// DateTo Дата окончания аренды
-	DateTo  string      `json:"dateTo"`
-	Payment PaymentInfo `json:"payment"`
+// Posting defines model for Posting.
+type Posting struct {
+	// Account Счет
+	Account string `json:"account"`
 
-	// RentalUid UUID аренды
-	RentalUid openapi_types.UUID `json:"rentalUid"`
+	// Credit Сумма по кредиту
+	Credit int `json:"credit"`
 
-	// Status Статус аренды
-	Status RentalResponseStatus `json:"status"`
+	// Debit Сумма по дебету
+	Debit int `json:"debit"`
 }
 
-// RentalResponseStatus Статус аренды
-type RentalResponseStatus string
+// PriceItem defines model for PriceItem.
+type PriceItem struct {
+	// Amount Сумма строки в минимальных единицах валюты, отрицательная для скидок
+	Amount int `json:"amount"`
 
-// ValidationErrorResponse defines model for ValidationErrorResponse.
-type ValidationErrorResponse struct {
-	// Errors Массив полей с описанием ошибки
-	Errors []ErrorDescription `json:"errors"`
+	// Description Описание строки расчета
+	Description string `json:"description"`
 
-	// Message Информация об ошибке
-	Message string `json:"message"`
-}
+	// Kind Вид строки расчета
+	Kind PriceItemKind `json:"kind"`
 
-// GetCarsParams defines parameters for GetCars.
-type GetCarsParams struct {
-	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
-	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
-	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
+	// Quantity Количество дней, к которым применена строка
+	Quantity int `json:"quantity"`
 }
 
-// BookCarJSONRequestBody defines body for BookCar for application/json ContentType.
-type BookCarJSONRequestBody = CreateRentalRequest
+// PriceItemKind Вид строки расчета
+type PriceItemKind string
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получить список всех доступных для бронирования автомобилей
-	// (GET /api/v1/cars)
-	GetCars(ctx echo.Context, params GetCarsParams) error
-	// Получить информацию о всех арендах пользователя
-	// (GET /api/v1/rental)
-	GetUserRentals(ctx echo.Context) error
-	// Забронировать автомобиль
-	// (POST /api/v1/rental)
-	BookCar(ctx echo.Context) error
-	// Отмена аренды автомобиля
-	// (DELETE /api/v1/rental/{rentalUid})
-	CancelRental(ctx echo.Context, rentalUid openapi_types.UUID) error
-	// Информация по конкретной аренде пользователя
-	// (GET /api/v1/rental/{rentalUid})
-	GetUserRental(ctx echo.Context, rentalUid openapi_types.UUID) error
-	// Завершение аренды автомобиля
-	// (POST /api/v1/rental/{rentalUid}/finish)
-	FinishRental(ctx echo.Context, rentalUid openapi_types.UUID) error
-	// Liveness probe
-	// (GET /manage/health)
-	Live(ctx echo.Context) error
-}
+// PromoCodeRequest defines model for PromoCodeRequest.
+type PromoCodeRequest struct {
+	// CarTypes Типы автомобилей, для которых действует промокод; пустой - для всех
+	CarTypes *[]string `json:"carTypes,omitempty"`
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+	// Code Промокод
+	Code string `json:"code"`
 
-// GetCars converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCars(ctx echo.Context) error {
-	var err error
+	// Currency Код валюты ISO 4217, обязателен для фиксированной скидки
+	Currency *string `json:"currency,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCarsParams
-	// ------------- Optional query parameter "page" -------------
+	// Kind Тип скидки
+	Kind PromoCodeRequestKind `json:"kind"`
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
+	// MaxUses Общее ограничение использований, 0 - без ограничений
+	MaxUses *int `json:"maxUses,omitempty"`
 
-	// ------------- Optional query parameter "size" -------------
+	// MaxUsesPerUser Ограничение использований одним пользователем, 0 - без ограничений
+	MaxUsesPerUser *int `json:"maxUsesPerUser,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
-	}
+	// MinDays Минимальная длительность аренды в днях
+	MinDays *int `json:"minDays,omitempty"`
 
-	// ------------- Optional query parameter "showAll" -------------
+	// ValidFrom Начало действия промокода
+	ValidFrom time.Time `json:"validFrom"`
 
-	err = runtime.BindQueryParameter("form", true, false, "showAll", ctx.QueryParams(), &params.ShowAll)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showAll: %s", err))
-	}
+	// ValidTo Окончание действия промокода
+	ValidTo *time.Time `json:"validTo,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCars(ctx, params)
-	return err
+	// Value Процент или фиксированная сумма скидки в минимальных единицах валюты
+	Value int `json:"value"`
 }
 
-// GetUserRentals converts echo context to params.
+// PromoCodeRequestKind Тип скидки
+type PromoCodeRequestKind string
+
+// PromoCodeResponse defines model for PromoCodeResponse.
+type PromoCodeResponse struct {
+	// CarTypes Типы автомобилей, для которых действует промокод; пустой - для всех
+	CarTypes *[]string `json:"carTypes,omitempty"`
+
+	// Code Промокод
+	Code string `json:"code"`
+
+	// Currency Код валюты ISO 4217, обязателен для фиксированной скидки
+	Currency *string `json:"currency,omitempty"`
+
+	// Kind Тип скидки
+	Kind PromoCodeResponseKind `json:"kind"`
+
+	// MaxUses Общее ограничение использований, 0 - без ограничений
+	MaxUses *int `json:"maxUses,omitempty"`
+
+	// MaxUsesPerUser Ограничение использований одним пользователем, 0 - без ограничений
+	MaxUsesPerUser *int `json:"maxUsesPerUser,omitempty"`
+
+	// MinDays Минимальная длительность аренды в днях
+	MinDays *int `json:"minDays,omitempty"`
+
+	// Used Количество действующих использований
+	Used int `json:"used"`
+
+	// ValidFrom Начало действия промокода
+	ValidFrom time.Time `json:"validFrom"`
+
+	// ValidTo Окончание действия промокода
+	ValidTo *time.Time `json:"validTo,omitempty"`
+
+	// Value Процент или фиксированная сумма скидки в минимальных единицах валюты
+	Value int `json:"value"`
+}
+
+// PromoCodeResponseKind Тип скидки
+type PromoCodeResponseKind string
+
+// QuoteRequest defines model for QuoteRequest.
+type QuoteRequest struct {
+	// CarUid UUID автомобиля
+	CarUid openapi_types.UUID `json:"carUid"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+}
+
+// QuoteResponse defines model for QuoteResponse.
+type QuoteResponse struct {
+	// CarUid UUID автомобиля
+	CarUid openapi_types.UUID `json:"carUid"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+
+	// Days Количество дней аренды
+	Days int `json:"days"`
+
+	// ExpiresAt Время, до которого действует цена
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Items Детализация стоимости
+	Items []PriceItem `json:"items"`
+
+	// QuoteId UUID расчета, по которому можно забронировать автомобиль по этой цене
+	QuoteId openapi_types.UUID `json:"quoteId"`
+
+	// TotalPrice Итоговая стоимость аренды в минимальных единицах валюты
+	TotalPrice int `json:"totalPrice"`
+}
+
+// RentalChangeResponse defines model for RentalChangeResponse.
+type RentalChangeResponse struct {
+	// ChangeUid UUID изменения аренды
+	ChangeUid openapi_types.UUID `json:"changeUid"`
+	Currency  string             `json:"currency"`
+	DateTo    string             `json:"dateTo"`
+
+	// Difference Доплата, если положительная, или уменьшение стоимости аренды, если отрицательная. При уменьшении возвращается такая же доля оплаченной суммы с учетом скидки и налогов
+	Difference     int          `json:"difference"`
+	Payment        *PaymentInfo `json:"payment,omitempty"`
+	PreviousDateTo string       `json:"previousDateTo"`
+
+	// PreviousPrice Стоимость аренды до изменения в минимальных единицах валюты
+	PreviousPrice int `json:"previousPrice"`
+
+	// Price Стоимость аренды после изменения в минимальных единицах валюты
+	Price     int                `json:"price"`
+	RentalUid openapi_types.UUID `json:"rentalUid"`
+}
+
+// RentalEvent defines model for RentalEvent.
+type RentalEvent struct {
+	// Actor Пользователь или сервис, изменивший статус
+	Actor string `json:"actor"`
+
+	// CreatedAt Время изменения
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromStatus Статус до изменения, отсутствует для создания аренды
+	FromStatus *string `json:"fromStatus,omitempty"`
+
+	// Reason Причина изменения
+	Reason *string `json:"reason,omitempty"`
+
+	// RequestId Идентификатор запроса, в рамках которого изменен статус
+	RequestId *string `json:"requestId,omitempty"`
+
+	// ToStatus Статус после изменения
+	ToStatus string `json:"toStatus"`
+}
+
+// RentalLedger defines model for RentalLedger.
+type RentalLedger struct {
+	// Balances Остатки по счетам
+	Balances []LedgerBalance `json:"balances"`
+
+	// Entries Проводки в порядке создания
+	Entries []JournalEntry `json:"entries"`
+
+	// RentalUid UUID аренды
+	RentalUid openapi_types.UUID `json:"rentalUid"`
+}
+
+// RentalResponse defines model for RentalResponse.
+type RentalResponse struct {
+	Car CarInfo `json:"car"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo  string      `json:"dateTo"`
+	Payment PaymentInfo `json:"payment"`
+
+	// RentalUid UUID аренды
+	RentalUid openapi_types.UUID `json:"rentalUid"`
+
+	// Status Статус аренды
+	Status RentalResponseStatus `json:"status"`
+}
+
+// RentalResponseStatus Статус аренды
+type RentalResponseStatus string
+
+// RentalSettlement defines model for RentalSettlement.
+type RentalSettlement struct {
+	// Charges Дополнительные начисления
+	Charges []Charge `json:"charges"`
+
+	// ExtraPayments Платежи по дополнительным начислениям
+	ExtraPayments []PaymentInfo `json:"extraPayments"`
+
+	// Outstanding Сумма, которую не удалось списать, включая стоимость аренды
+	Outstanding int          `json:"outstanding"`
+	Payment     *PaymentInfo `json:"payment,omitempty"`
+
+	// RentalPrice Стоимость аренды по расчету при бронировании в минимальных единицах валюты
+	RentalPrice *int `json:"rentalPrice,omitempty"`
+
+	// RentalUid UUID аренды
+	RentalUid openapi_types.UUID `json:"rentalUid"`
+
+	// ReturnedAt Фактическое время возврата автомобиля
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+
+	// Status Статус аренды
+	Status string `json:"status"`
+
+	// TotalCharges Сумма дополнительных начислений
+	TotalCharges int `json:"totalCharges"`
+}
+
+// ReplayRetryCommandsRequest defines model for ReplayRetryCommandsRequest.
+type ReplayRetryCommandsRequest struct {
+	// CommandUids Команды для запуска
+	CommandUids *[]openapi_types.UUID `json:"commandUids,omitempty"`
+
+	// Type Тип команд, без выбранных команд запускаются все недоставленные команды типа
+	Type *ReplayRetryCommandsRequestType `json:"type,omitempty"`
+}
+
+// ReplayRetryCommandsRequestType Тип команд, без выбранных команд запускаются все недоставленные команды типа
+type ReplayRetryCommandsRequestType string
+
+// RetryCommand defines model for RetryCommand.
+type RetryCommand struct {
+	// Attempts Количество попыток с последнего запуска
+	Attempts   int                `json:"attempts"`
+	CommandUid openapi_types.UUID `json:"commandUid"`
+	CreatedAt  time.Time          `json:"createdAt"`
+
+	// DiscardReason Причина, по которой команда отброшена
+	DiscardReason *string `json:"discardReason,omitempty"`
+
+	// DiscardedBy Администратор, отбросивший команду
+	DiscardedBy *string `json:"discardedBy,omitempty"`
+
+	// LastError Ошибка последней попытки
+	LastError *string `json:"lastError,omitempty"`
+
+	// Replays Количество повторных запусков администратором
+	Replays int `json:"replays"`
+
+	// Status PENDING - ожидает попытки в топике, DEAD - попытки исчерпаны, DONE - выполнена, DISCARDED - отброшена администратором
+	Status RetryCommandStatus `json:"status"`
+
+	// TargetUid UUID автомобиля, платежа или аренды дополнительного начисления
+	TargetUid openapi_types.UUID `json:"targetUid"`
+
+	// Topic Топик повторных попыток
+	Topic string `json:"topic"`
+
+	// Type Освобождение автомобиля, отмена или списание платежа, оплата дополнительного начисления
+	Type      RetryCommandType `json:"type"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// RetryCommandStatus PENDING - ожидает попытки в топике, DEAD - попытки исчерпаны, DONE - выполнена, DISCARDED - отброшена администратором
+type RetryCommandStatus string
+
+// RetryCommandType Освобождение автомобиля, отмена или списание платежа, оплата дополнительного начисления
+type RetryCommandType string
+
+// RetryCommandPage defines model for RetryCommandPage.
+type RetryCommandPage struct {
+	Items []RetryCommand `json:"items"`
+
+	// Page Номер страницы
+	Page int `json:"page"`
+
+	// PageSize Количество элементов на странице
+	PageSize int `json:"pageSize"`
+
+	// TotalElements Количество команд, подходящих под фильтр
+	TotalElements int `json:"totalElements"`
+}
+
+// RetryTopicBacklog defines model for RetryTopicBacklog.
+type RetryTopicBacklog struct {
+	// Dead Количество команд в очереди недоставленных
+	Dead int `json:"dead"`
+
+	// Lag Количество сообщений, еще не обработанных потребителями
+	Lag int64 `json:"lag"`
+
+	// Partitions Количество партиций топика
+	Partitions int `json:"partitions"`
+
+	// Pending Количество ожидающих команд, записанных при первой попытке
+	Pending int    `json:"pending"`
+	Topic   string `json:"topic"`
+
+	// Types Типы команд, которые передаются через топик
+	Types []RetryTopicBacklogTypes `json:"types"`
+}
+
+// RetryTopicBacklogTypes defines model for RetryTopicBacklog.Types.
+type RetryTopicBacklogTypes string
+
+// Tax defines model for Tax.
+type Tax struct {
+	// Amount Сумма налога в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Jurisdiction Страна или регион, например RU или RU-MOW
+	Jurisdiction string `json:"jurisdiction"`
+
+	// Mode INCLUSIVE - налог включен в цену, EXCLUSIVE - налог добавлен к цене
+	Mode TaxMode `json:"mode"`
+
+	// Name Название налога
+	Name string `json:"name"`
+
+	// Percent Ставка налога в процентах
+	Percent float32 `json:"percent"`
+}
+
+// TaxMode INCLUSIVE - налог включен в цену, EXCLUSIVE - налог добавлен к цене
+type TaxMode string
+
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	// Errors Массив полей с описанием ошибки
+	Errors []ErrorDescription `json:"errors"`
+
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
+// SearchRentalsParams defines parameters for SearchRentals.
+type SearchRentalsParams struct {
+	// Username Имя пользователя
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+
+	// CarUid UUID автомобиля
+	CarUid *openapi_types.UUID `form:"carUid,omitempty" json:"carUid,omitempty"`
+
+	// Status Статусы аренд, по умолчанию - все
+	Status *[]SearchRentalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// DateFrom Начало периода, аренды которого пересекаются с периодом
+	DateFrom *string `form:"dateFrom,omitempty" json:"dateFrom,omitempty"`
+
+	// DateTo Конец периода, аренды которого пересекаются с периодом
+	DateTo *string `form:"dateTo,omitempty" json:"dateTo,omitempty"`
+
+	// Sort Сортировка по дате начала аренды, по умолчанию - сначала новые
+	Sort *SearchRentalsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page *int                     `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Количество аренд на странице, по умолчанию - 20
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// SearchRentalsParamsStatus defines parameters for SearchRentals.
+type SearchRentalsParamsStatus string
+
+// SearchRentalsParamsSort defines parameters for SearchRentals.
+type SearchRentalsParamsSort string
+
+// ListRetryCommandsParams defines parameters for ListRetryCommands.
+type ListRetryCommandsParams struct {
+	// Status Статусы команд, по умолчанию - ожидающие и в очереди недоставленных
+	Status *[]ListRetryCommandsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Type Тип команды
+	Type *ListRetryCommandsParamsType `form:"type,omitempty" json:"type,omitempty"`
+	Page *int                         `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Количество команд на странице, по умолчанию - 20
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListRetryCommandsParamsStatus defines parameters for ListRetryCommands.
+type ListRetryCommandsParamsStatus string
+
+// ListRetryCommandsParamsType defines parameters for ListRetryCommands.
+type ListRetryCommandsParamsType string
+
+// GetCarsParams defines parameters for GetCars.
+type GetCarsParams struct {
+	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
+	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
+	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
+
+	// XCurrency Код валюты ISO 4217 для отображения цен
+	XCurrency *string `json:"X-Currency,omitempty"`
+}
+
+// GetUserRentalsParams defines parameters for GetUserRentals.
+type GetUserRentalsParams struct {
+	// Status Статусы аренд, по умолчанию - все
+	Status *[]GetUserRentalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// DateFrom Начало периода, аренды которого пересекаются с периодом
+	DateFrom *string `form:"dateFrom,omitempty" json:"dateFrom,omitempty"`
+
+	// DateTo Конец периода, аренды которого пересекаются с периодом
+	DateTo *string `form:"dateTo,omitempty" json:"dateTo,omitempty"`
+
+	// Sort Сортировка по дате начала аренды
+	Sort *GetUserRentalsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page *int                      `form:"page,omitempty" json:"page,omitempty"`
+
+	// Size Количество аренд на странице, по умолчанию - 20
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// XCurrency Код валюты ISO 4217 для отображения цен
+	XCurrency *string `json:"X-Currency,omitempty"`
+}
+
+// GetUserRentalsParamsStatus defines parameters for GetUserRentals.
+type GetUserRentalsParamsStatus string
+
+// GetUserRentalsParamsSort defines parameters for GetUserRentals.
+type GetUserRentalsParamsSort string
+
+// CancelRentalParams defines parameters for CancelRental.
+type CancelRentalParams struct {
+	// Reason Причина отмены
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// GetUserRentalParams defines parameters for GetUserRental.
+type GetUserRentalParams struct {
+	// XCurrency Код валюты ISO 4217 для отображения цен
+	XCurrency *string `json:"X-Currency,omitempty"`
+}
+
+// CreateCarJSONRequestBody defines body for CreateCar for application/json ContentType.
+type CreateCarJSONRequestBody = CarRequest
+
+// UpdateCarJSONRequestBody defines body for UpdateCar for application/json ContentType.
+type UpdateCarJSONRequestBody = CarRequest
+
+// SetExchangeRateJSONRequestBody defines body for SetExchangeRate for application/json ContentType.
+type SetExchangeRateJSONRequestBody = ExchangeRateRequest
+
+// CreatePromoCodeJSONRequestBody defines body for CreatePromoCode for application/json ContentType.
+type CreatePromoCodeJSONRequestBody = PromoCodeRequest
+
+// ForceCancelRentalJSONRequestBody defines body for ForceCancelRental for application/json ContentType.
+type ForceCancelRentalJSONRequestBody = ForceCancelRequest
+
+// ForceFinishRentalJSONRequestBody defines body for ForceFinishRental for application/json ContentType.
+type ForceFinishRentalJSONRequestBody = ForceFinishRequest
+
+// DiscardRetryCommandsJSONRequestBody defines body for DiscardRetryCommands for application/json ContentType.
+type DiscardRetryCommandsJSONRequestBody = DiscardRetryCommandsRequest
+
+// ReplayRetryCommandsJSONRequestBody defines body for ReplayRetryCommands for application/json ContentType.
+type ReplayRetryCommandsJSONRequestBody = ReplayRetryCommandsRequest
+
+// QuoteRentalJSONRequestBody defines body for QuoteRental for application/json ContentType.
+type QuoteRentalJSONRequestBody = QuoteRequest
+
+// BookCarJSONRequestBody defines body for BookCar for application/json ContentType.
+type BookCarJSONRequestBody = CreateRentalRequest
+
+// ChangeRentalDatesJSONRequestBody defines body for ChangeRentalDates for application/json ContentType.
+type ChangeRentalDatesJSONRequestBody = ChangeRentalRequest
+
+// FinishRentalJSONRequestBody defines body for FinishRental for application/json ContentType.
+type FinishRentalJSONRequestBody = FinishRentalRequest
+
+// StartRentalJSONRequestBody defines body for StartRental for application/json ContentType.
+type StartRentalJSONRequestBody = CarReadings
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Добавить автомобиль в автопарк
+	// (POST /api/v1/admin/cars)
+	CreateCar(ctx echo.Context) error
+	// Изменить информацию об автомобиле
+	// (PUT /api/v1/admin/cars/{carUid})
+	UpdateCar(ctx echo.Context, carUid openapi_types.UUID) error
+	// Убрать автомобиль в архив
+	// (POST /api/v1/admin/cars/{carUid}/archive)
+	ArchiveCar(ctx echo.Context, carUid openapi_types.UUID) error
+	// Вернуть автомобиль из архива
+	// (POST /api/v1/admin/cars/{carUid}/restore)
+	RestoreCar(ctx echo.Context, carUid openapi_types.UUID) error
+	// Установить курс валюты
+	// (PUT /api/v1/admin/exchange-rates/{currency})
+	SetExchangeRate(ctx echo.Context, currency string) error
+	// Список промокодов
+	// (GET /api/v1/admin/promo-codes)
+	ListPromoCodes(ctx echo.Context) error
+	// Создать промокод
+	// (POST /api/v1/admin/promo-codes)
+	CreatePromoCode(ctx echo.Context) error
+	// Поиск аренд всех пользователей
+	// (GET /api/v1/admin/rentals)
+	SearchRentals(ctx echo.Context, params SearchRentalsParams) error
+	// Информация по аренде любого пользователя с автомобилем, платежом и историей
+	// (GET /api/v1/admin/rentals/{rentalUid})
+	GetAnyRental(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Принудительная отмена аренды
+	// (POST /api/v1/admin/rentals/{rentalUid}/cancel)
+	ForceCancelRental(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Принудительное завершение аренды
+	// (POST /api/v1/admin/rentals/{rentalUid}/finish)
+	ForceFinishRental(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Команды повторных попыток компенсаций
+	// (GET /api/v1/admin/retries)
+	ListRetryCommands(ctx echo.Context, params ListRetryCommandsParams) error
+	// Отставание обработки по топикам повторных попыток
+	// (GET /api/v1/admin/retries/backlog)
+	GetRetryBacklog(ctx echo.Context) error
+	// Отбросить команды
+	// (POST /api/v1/admin/retries/discard)
+	DiscardRetryCommands(ctx echo.Context) error
+	// Повторный запуск команд
+	// (POST /api/v1/admin/retries/replay)
+	ReplayRetryCommands(ctx echo.Context) error
+	// Получить список всех доступных для бронирования автомобилей
+	// (GET /api/v1/cars)
+	GetCars(ctx echo.Context, params GetCarsParams) error
+	// Курсы валют для отображения цен
+	// (GET /api/v1/exchange-rates)
+	ListExchangeRates(ctx echo.Context) error
+	// Рассчитать стоимость аренды автомобиля
+	// (POST /api/v1/quotes)
+	QuoteRental(ctx echo.Context) error
+	// Получить страницу аренд пользователя
+	// (GET /api/v1/rental)
+	GetUserRentals(ctx echo.Context, params GetUserRentalsParams) error
+	// Забронировать автомобиль
+	// (POST /api/v1/rental)
+	BookCar(ctx echo.Context) error
+	// Отмена аренды автомобиля
+	// (DELETE /api/v1/rental/{rentalUid})
+	CancelRental(ctx echo.Context, rentalUid openapi_types.UUID, params CancelRentalParams) error
+	// Информация по конкретной аренде пользователя
+	// (GET /api/v1/rental/{rentalUid})
+	GetUserRental(ctx echo.Context, rentalUid openapi_types.UUID, params GetUserRentalParams) error
+	// Изменение даты окончания аренды
+	// (PATCH /api/v1/rental/{rentalUid})
+	ChangeRentalDates(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Завершение аренды автомобиля
+	// (POST /api/v1/rental/{rentalUid}/finish)
+	FinishRental(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// История изменений статуса аренды
+	// (GET /api/v1/rental/{rentalUid}/history)
+	GetRentalHistory(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Счет по аренде в формате PDF
+	// (GET /api/v1/rental/{rentalUid}/invoice)
+	GetRentalInvoice(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Журнал проводок аренды
+	// (GET /api/v1/rental/{rentalUid}/ledger)
+	GetRentalLedger(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Подтвердить оплату аренды
+	// (POST /api/v1/rental/{rentalUid}/payment/confirm)
+	ConfirmRentalPayment(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Начало аренды автомобиля (автомобиль получен)
+	// (POST /api/v1/rental/{rentalUid}/start)
+	StartRental(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Liveness probe
+	// (GET /manage/health)
+	Live(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// CreateCar converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateCar(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateCar(ctx)
+	return err
+}
+
+// UpdateCar converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCar(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "carUid" -------------
+	var carUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "carUid", ctx.Param("carUid"), &carUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter carUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateCar(ctx, carUid)
+	return err
+}
+
+// ArchiveCar converts echo context to params.
+func (w *ServerInterfaceWrapper) ArchiveCar(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "carUid" -------------
+	var carUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "carUid", ctx.Param("carUid"), &carUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter carUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ArchiveCar(ctx, carUid)
+	return err
+}
+
+// RestoreCar converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreCar(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "carUid" -------------
+	var carUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "carUid", ctx.Param("carUid"), &carUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter carUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreCar(ctx, carUid)
+	return err
+}
+
+// SetExchangeRate converts echo context to params.
+func (w *ServerInterfaceWrapper) SetExchangeRate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "currency" -------------
+	var currency string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "currency", ctx.Param("currency"), &currency, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetExchangeRate(ctx, currency)
+	return err
+}
+
+// ListPromoCodes converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPromoCodes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPromoCodes(ctx)
+	return err
+}
+
+// CreatePromoCode converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePromoCode(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePromoCode(ctx)
+	return err
+}
+
+// SearchRentals converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchRentals(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchRentalsParams
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "carUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "carUid", ctx.QueryParams(), &params.CarUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter carUid: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateFrom", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateFrom: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateTo", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateTo: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchRentals(ctx, params)
+	return err
+}
+
+// GetAnyRental converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAnyRental(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAnyRental(ctx, rentalUid)
+	return err
+}
+
+// ForceCancelRental converts echo context to params.
+func (w *ServerInterfaceWrapper) ForceCancelRental(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForceCancelRental(ctx, rentalUid)
+	return err
+}
+
+// ForceFinishRental converts echo context to params.
+func (w *ServerInterfaceWrapper) ForceFinishRental(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForceFinishRental(ctx, rentalUid)
+	return err
+}
+
+// ListRetryCommands converts echo context to params.
+func (w *ServerInterfaceWrapper) ListRetryCommands(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRetryCommandsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListRetryCommands(ctx, params)
+	return err
+}
+
+// GetRetryBacklog converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRetryBacklog(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRetryBacklog(ctx)
+	return err
+}
+
+// DiscardRetryCommands converts echo context to params.
+func (w *ServerInterfaceWrapper) DiscardRetryCommands(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DiscardRetryCommands(ctx)
+	return err
+}
+
+// ReplayRetryCommands converts echo context to params.
+func (w *ServerInterfaceWrapper) ReplayRetryCommands(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReplayRetryCommands(ctx)
+	return err
+}
+
+// GetCars converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCars(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCarsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "showAll" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showAll", ctx.QueryParams(), &params.ShowAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showAll: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Currency" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Currency")]; found {
+		var XCurrency string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Currency, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Currency", valueList[0], &XCurrency, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Currency: %s", err))
+		}
+
+		params.XCurrency = &XCurrency
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCars(ctx, params)
+	return err
+}
+
+// ListExchangeRates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListExchangeRates(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListExchangeRates(ctx)
+	return err
+}
+
+// QuoteRental converts echo context to params.
+func (w *ServerInterfaceWrapper) QuoteRental(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.QuoteRental(ctx)
+	return err
+}
+
+// GetUserRentals converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUserRentals(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserRentalsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateFrom", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateFrom: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateTo", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateTo: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Currency" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Currency")]; found {
+		var XCurrency string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Currency, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Currency", valueList[0], &XCurrency, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Currency: %s", err))
+		}
+
+		params.XCurrency = &XCurrency
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUserRentals(ctx)
+	err = w.Handler.GetUserRentals(ctx, params)
 	return err
 }
 
@@ -292,8 +1651,17 @@ func (w *ServerInterfaceWrapper) CancelRental(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelRentalParams
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", ctx.QueryParams(), &params.Reason)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reason: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.CancelRental(ctx, rentalUid)
+	err = w.Handler.CancelRental(ctx, rentalUid, params)
 	return err
 }
 
@@ -308,8 +1676,44 @@ func (w *ServerInterfaceWrapper) GetUserRental(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserRentalParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Currency" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Currency")]; found {
+		var XCurrency string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Currency, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Currency", valueList[0], &XCurrency, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Currency: %s", err))
+		}
+
+		params.XCurrency = &XCurrency
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserRental(ctx, rentalUid, params)
+	return err
+}
+
+// ChangeRentalDates converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangeRentalDates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUserRental(ctx, rentalUid)
+	err = w.Handler.ChangeRentalDates(ctx, rentalUid)
 	return err
 }
 
@@ -329,6 +1733,86 @@ func (w *ServerInterfaceWrapper) FinishRental(ctx echo.Context) error {
 	return err
 }
 
+// GetRentalHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRentalHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRentalHistory(ctx, rentalUid)
+	return err
+}
+
+// GetRentalInvoice converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRentalInvoice(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRentalInvoice(ctx, rentalUid)
+	return err
+}
+
+// GetRentalLedger converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRentalLedger(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRentalLedger(ctx, rentalUid)
+	return err
+}
+
+// ConfirmRentalPayment converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmRentalPayment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmRentalPayment(ctx, rentalUid)
+	return err
+}
+
+// StartRental converts echo context to params.
+func (w *ServerInterfaceWrapper) StartRental(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartRental(ctx, rentalUid)
+	return err
+}
+
 // Live converts echo context to params.
 func (w *ServerInterfaceWrapper) Live(ctx echo.Context) error {
 	var err error
@@ -366,12 +1850,35 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/api/v1/admin/cars", wrapper.CreateCar)
+	router.PUT(baseURL+"/api/v1/admin/cars/:carUid", wrapper.UpdateCar)
+	router.POST(baseURL+"/api/v1/admin/cars/:carUid/archive", wrapper.ArchiveCar)
+	router.POST(baseURL+"/api/v1/admin/cars/:carUid/restore", wrapper.RestoreCar)
+	router.PUT(baseURL+"/api/v1/admin/exchange-rates/:currency", wrapper.SetExchangeRate)
+	router.GET(baseURL+"/api/v1/admin/promo-codes", wrapper.ListPromoCodes)
+	router.POST(baseURL+"/api/v1/admin/promo-codes", wrapper.CreatePromoCode)
+	router.GET(baseURL+"/api/v1/admin/rentals", wrapper.SearchRentals)
+	router.GET(baseURL+"/api/v1/admin/rentals/:rentalUid", wrapper.GetAnyRental)
+	router.POST(baseURL+"/api/v1/admin/rentals/:rentalUid/cancel", wrapper.ForceCancelRental)
+	router.POST(baseURL+"/api/v1/admin/rentals/:rentalUid/finish", wrapper.ForceFinishRental)
+	router.GET(baseURL+"/api/v1/admin/retries", wrapper.ListRetryCommands)
+	router.GET(baseURL+"/api/v1/admin/retries/backlog", wrapper.GetRetryBacklog)
+	router.POST(baseURL+"/api/v1/admin/retries/discard", wrapper.DiscardRetryCommands)
+	router.POST(baseURL+"/api/v1/admin/retries/replay", wrapper.ReplayRetryCommands)
 	router.GET(baseURL+"/api/v1/cars", wrapper.GetCars)
+	router.GET(baseURL+"/api/v1/exchange-rates", wrapper.ListExchangeRates)
+	router.POST(baseURL+"/api/v1/quotes", wrapper.QuoteRental)
 	router.GET(baseURL+"/api/v1/rental", wrapper.GetUserRentals)
 	router.POST(baseURL+"/api/v1/rental", wrapper.BookCar)
 	router.DELETE(baseURL+"/api/v1/rental/:rentalUid", wrapper.CancelRental)
 	router.GET(baseURL+"/api/v1/rental/:rentalUid", wrapper.GetUserRental)
+	router.PATCH(baseURL+"/api/v1/rental/:rentalUid", wrapper.ChangeRentalDates)
 	router.POST(baseURL+"/api/v1/rental/:rentalUid/finish", wrapper.FinishRental)
+	router.GET(baseURL+"/api/v1/rental/:rentalUid/history", wrapper.GetRentalHistory)
+	router.GET(baseURL+"/api/v1/rental/:rentalUid/invoice", wrapper.GetRentalInvoice)
+	router.GET(baseURL+"/api/v1/rental/:rentalUid/ledger", wrapper.GetRentalLedger)
+	router.POST(baseURL+"/api/v1/rental/:rentalUid/payment/confirm", wrapper.ConfirmRentalPayment)
+	router.POST(baseURL+"/api/v1/rental/:rentalUid/start", wrapper.StartRental)
 	router.GET(baseURL+"/manage/health", wrapper.Live)
 
 }
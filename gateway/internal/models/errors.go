@@ -6,7 +6,9 @@ import (
 )
 
 var (
-	ErrUnknownResponseStatus = errors.New("unknown response status")
+	ErrUnknownResponseStatus   = errors.New("unknown response status")
+	ErrProjectedRentalNotFound = errors.New("projected rental not found")
+	ErrInvalidRetryMsg         = errors.New("invalid retry message")
 )
 
 type ValidationError struct {
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DomainEventType string
+
+// Domain events of the services which are projected to the user rentals view.
+const (
+	CarBookedDomainEvent   DomainEventType = "CarBooked"
+	CarUnbookedDomainEvent DomainEventType = "CarUnbooked"
+	CarUpdatedDomainEvent  DomainEventType = "CarUpdated"
+
+	RentalCreatedDomainEvent      DomainEventType = "RentalCreated"
+	RentalStartedDomainEvent      DomainEventType = "RentalStarted"
+	RentalOverdueDomainEvent      DomainEventType = "RentalOverdue"
+	RentalFinishedDomainEvent     DomainEventType = "RentalFinished"
+	RentalCanceledDomainEvent     DomainEventType = "RentalCanceled"
+	RentalDatesChangedDomainEvent DomainEventType = "RentalDatesChanged"
+	// RentalNoShowDomainEvent isn't projected, the gateway settles the no-show rental by it.
+	RentalNoShowDomainEvent DomainEventType = "RentalNoShow"
+
+	PaymentCreatedDomainEvent    DomainEventType = "PaymentCreated"
+	PaymentAuthorizedDomainEvent DomainEventType = "PaymentAuthorized"
+	PaymentCapturedDomainEvent   DomainEventType = "PaymentCaptured"
+	PaymentFailedDomainEvent     DomainEventType = "PaymentFailed"
+	PaymentCanceledDomainEvent   DomainEventType = "PaymentCanceled"
+	PaymentRefundedDomainEvent   DomainEventType = "PaymentRefunded"
+)
+
+// DomainEventVersion is the only version of the payloads the gateway understands.
+const DomainEventVersion = 1
+
+// DomainEvent is the envelope of domain events published by the services, its schema is
+// api/asyncapi/domain-events.yml. Data is decoded by the type of the event.
+type DomainEvent struct {
+	ID          uuid.UUID       `json:"id"`
+	Type        DomainEventType `json:"type"`
+	Version     int             `json:"version"`
+	Source      string          `json:"source"`
+	AggregateID uuid.UUID       `json:"aggregate_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+type CarEventData struct {
+	CarUID             uuid.UUID `json:"car_uid"`
+	Brand              string    `json:"brand"`
+	Model              string    `json:"model"`
+	RegistrationNumber string    `json:"registration_number"`
+}
+
+type RentalEventData struct {
+	RentalUID  uuid.UUID `json:"rental_uid"`
+	Username   string    `json:"username"`
+	CarUID     uuid.UUID `json:"car_uid"`
+	PaymentUID uuid.UUID `json:"payment_uid"`
+	DateFrom   time.Time `json:"date_from"`
+	DateTo     time.Time `json:"date_to"`
+	Status     string    `json:"status"`
+	// NoShowPolicy is set only in RentalNoShowDomainEvent.
+	NoShowPolicy string `json:"no_show_policy,omitempty"`
+}
+
+type PaymentEventData struct {
+	PaymentUID    uuid.UUID  `json:"payment_uid"`
+	Kind          string     `json:"kind"`
+	Status        string     `json:"status"`
+	Price         int        `json:"price"`
+	Discount      int        `json:"discount"`
+	PromoCode     string     `json:"promo_code"`
+	Currency      string     `json:"currency"`
+	Captured      int        `json:"captured"`
+	Refunded      int        `json:"refunded"`
+	FailureReason string     `json:"failure_reason"`
+	Taxes         []EventTax `json:"taxes"`
+}
+
+type EventTax struct {
+	Jurisdiction string  `json:"jurisdiction"`
+	Name         string  `json:"name"`
+	Percent      float64 `json:"percent"`
+	Amount       int     `json:"amount"`
+	Mode         string  `json:"mode"`
+}
+
+// ProjectionMarker is the last domain event applied to the projection, responses served
+// from the projection reflect all events up to it.
+type ProjectionMarker struct {
+	EventID    uuid.UUID `gorm:"column:event_id;type:uuid"`
+	OccurredAt time.Time `gorm:"column:occurred_at;type:timestamptz"`
+}
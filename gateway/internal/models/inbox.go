@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Consumers which record handled messages in the inbox.
+const (
+	CarsRetryConsumer    = "cars-retry"
+	PaymentRetryConsumer = "payment-retry"
+	RentalEventsConsumer = "rental-events"
+)
+
+// InboxMessage is the kafka message handled by the consumer.
+type InboxMessage struct {
+	Consumer    string    `gorm:"column:consumer"`
+	MessageUUID uuid.UUID `gorm:"column:message_id;type:uuid"`
+	Topic       string    `gorm:"column:topic"`
+	ProcessedAt time.Time `gorm:"column:processed_at;type:timestamptz"`
+}
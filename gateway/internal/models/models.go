@@ -33,12 +33,51 @@ type CarResponse struct {
 
 type CarResponseType string
 
+// CarUnbookRetryMsg keeps CommandUid when it is requeued, so attempts of the command are counted together.
 type CarUnbookRetryMsg struct {
+	CommandUid    uuid.UUID
 	CarUid        uuid.UUID
 	LastProcessed time.Time
 }
 
-type PaymentCancelRetryMsg struct {
+// PaymentRetryAction is what the retried payment command does, messages without it cancel the payment.
+type PaymentRetryAction string
+
+const (
+	PaymentCancelAction  PaymentRetryAction = "CANCEL"
+	PaymentCaptureAction PaymentRetryAction = "CAPTURE"
+	// PaymentChargeAction creates and captures the payment of the extra charge.
+	PaymentChargeAction PaymentRetryAction = "CHARGE"
+)
+
+// PaymentRetryMsg cancels or captures the payment. The cancellation without RentalStart voids the payment,
+// otherwise it is refunded by the cancellation policy as of CanceledAt. The charge has no payment yet,
+// it is created from Charge. CommandUid is kept when it is requeued.
+type PaymentRetryMsg struct {
+	CommandUid    uuid.UUID
+	Action        PaymentRetryAction
 	PaymentUid    uuid.UUID
+	RentalUid     *uuid.UUID
+	RentalStart   *time.Time
+	CanceledAt    *time.Time
+	Charge        *PaymentCharge
 	LastProcessed time.Time
 }
+
+// PaymentCharge is the extra charge of the finished rental paid by a separate payment of the owner.
+type PaymentCharge struct {
+	Price    int
+	Currency string
+	Kind     string
+	Username string
+}
+
+// No-show policies of rental service, RentalNoShowDomainEvent carries the one applied to the rental.
+const (
+	// NoShowKeepPolicy means that a no-show rental stays reserved.
+	NoShowKeepPolicy = "keep"
+	// NoShowCancelPolicy means that the payment of a no-show rental is returned.
+	NoShowCancelPolicy = "cancel"
+	// NoShowForfeitPolicy means that the payment of a no-show rental is captured.
+	NoShowForfeitPolicy = "forfeit"
+)
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectedRental is the rental document of the user rentals view, the car and the payment are joined on read.
+type ProjectedRental struct {
+	RentalUUID  uuid.UUID `gorm:"column:rental_uid;type:uuid"`
+	Username    string    `gorm:"column:username"`
+	CarUUID     uuid.UUID `gorm:"column:car_uid;type:uuid"`
+	PaymentUUID uuid.UUID `gorm:"column:payment_uid;type:uuid"`
+	DateFrom    time.Time `gorm:"column:date_from;type:date"`
+	DateTo      time.Time `gorm:"column:date_to;type:date"`
+	Status      string    `gorm:"column:status"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;type:timestamptz"`
+}
+
+type ProjectedCar struct {
+	CarUUID            uuid.UUID `gorm:"column:car_uid;type:uuid"`
+	Brand              string    `gorm:"column:brand"`
+	Model              string    `gorm:"column:model"`
+	RegistrationNumber string    `gorm:"column:registration_number"`
+	UpdatedAt          time.Time `gorm:"column:updated_at;type:timestamptz"`
+}
+
+// ProjectedPayment keeps the payment info as the gateway returns it.
+type ProjectedPayment struct {
+	PaymentUUID uuid.UUID `gorm:"column:payment_uid;type:uuid"`
+	Info        []byte    `gorm:"column:info;type:jsonb"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;type:timestamptz"`
+}
+
+// ProjectedView is the rental joined with its car and payment, they are nil while unknown.
+type ProjectedView struct {
+	Rental  ProjectedRental
+	Car     *ProjectedCar
+	Payment *ProjectedPayment
+}
+
+// ProjectionFilter selects a page of the user rentals which overlap the dates.
+type ProjectionFilter struct {
+	Statuses []string
+	DateFrom *time.Time
+	DateTo   *time.Time
+	Asc      bool
+	Page     int
+	Size     int
+}
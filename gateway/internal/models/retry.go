@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RetryCommandType string
+
+const (
+	CarUnbookCommand      RetryCommandType = "CAR_UNBOOK"
+	PaymentCancelCommand  RetryCommandType = "PAYMENT_CANCEL"
+	PaymentCaptureCommand RetryCommandType = "PAYMENT_CAPTURE"
+	PaymentChargeCommand  RetryCommandType = "PAYMENT_CHARGE"
+)
+
+type RetryCommandStatus string
+
+const (
+	// RetryCommandPending is waiting in the retry topic.
+	RetryCommandPending RetryCommandStatus = "PENDING"
+	// RetryCommandDead ran out of attempts, it stays until replayed or discarded.
+	RetryCommandDead      RetryCommandStatus = "DEAD"
+	RetryCommandDone      RetryCommandStatus = "DONE"
+	RetryCommandDiscarded RetryCommandStatus = "DISCARDED"
+)
+
+// RetryCommand is the compensation retried through the retry topic. It is recorded
+// on the first attempt, Payload is the message which is published again on replay.
+type RetryCommand struct {
+	UUID          uuid.UUID          `gorm:"column:id;type:uuid;primaryKey"`
+	Type          RetryCommandType   `gorm:"column:type"`
+	Topic         string             `gorm:"column:topic"`
+	Target        uuid.UUID          `gorm:"column:target;type:uuid"`
+	Payload       []byte             `gorm:"column:payload;type:jsonb"`
+	Status        RetryCommandStatus `gorm:"column:status"`
+	Attempts      int                `gorm:"column:attempts"`
+	Replays       int                `gorm:"column:replays"`
+	LastError     *string            `gorm:"column:last_error"`
+	DiscardReason *string            `gorm:"column:discard_reason"`
+	DiscardedBy   *string            `gorm:"column:discarded_by"`
+	CreatedAt     time.Time          `gorm:"column:created_at;type:timestamptz"`
+	UpdatedAt     time.Time          `gorm:"column:updated_at;type:timestamptz"`
+}
+
+type RetryCommandFilter struct {
+	Statuses []RetryCommandStatus
+	Type     *RetryCommandType
+	Page     int
+	Size     int
+}
+
+// RetryCommandCounts is the number of commands of the type in each status.
+type RetryCommandCounts struct {
+	Type   RetryCommandType
+	Status RetryCommandStatus
+	Count  int
+}
+
+// RetryTopicLag is the number of messages of the topic not yet committed by the retry consumers.
+type RetryTopicLag struct {
+	Topic      string
+	Types      []RetryCommandType
+	Partitions int
+	Lag        int64
+}
@@ -1,15 +1,363 @@
 package openapi
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi"
+	cars_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/cars-service"
+	payment_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/payment-service"
+	rental_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/rental-service"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/projection"
+	"github.com/samber/lo"
 )
 
-func isLogicError(c echo.Context, err error) bool {
+func toCarsServiceCarRequest(req openapi.CarRequest) cars_service.CarRequest {
+	return cars_service.CarRequest{
+		Brand:              req.Brand,
+		Model:              req.Model,
+		Power:              req.Power,
+		Price:              req.Price,
+		Currency:           req.Currency,
+		RegistrationNumber: req.RegistrationNumber,
+		Type:               cars_service.CarRequestType(req.Type),
+	}
+}
+
+func fromCarsServiceCar(car cars_service.CarResponse, _ int) openapi.CarResponse {
+	return openapi.CarResponse{
+		Archived:           car.Archived,
+		Available:          car.Available,
+		Brand:              car.Brand,
+		CarUid:             car.CarUid,
+		Currency:           car.Currency,
+		Model:              car.Model,
+		Power:              car.Power,
+		Price:              car.Price,
+		RegistrationNumber: car.RegistrationNumber,
+		Type:               openapi.CarResponseType(car.Type),
+	}
+}
+
+func toPaymentServicePromoCodeRequest(req openapi.PromoCodeRequest) payment_service.PromoCodeRequest {
+	return payment_service.PromoCodeRequest{
+		CarTypes:       req.CarTypes,
+		Code:           req.Code,
+		Currency:       req.Currency,
+		Kind:           payment_service.PromoCodeRequestKind(req.Kind),
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		MinDays:        req.MinDays,
+		ValidFrom:      req.ValidFrom,
+		ValidTo:        req.ValidTo,
+		Value:          req.Value,
+	}
+}
+
+func toRentalServiceListParams(params openapi.GetUserRentalsParams) *rental_service.GetUserRentalsParams {
+	result := &rental_service.GetUserRentalsParams{
+		DateFrom: params.DateFrom,
+		DateTo:   params.DateTo,
+		Page:     params.Page,
+		Size:     params.Size,
+	}
+
+	if params.Status != nil {
+		result.Status = lo.ToPtr(lo.Map(*params.Status, func(status openapi.GetUserRentalsParamsStatus, _ int) rental_service.GetUserRentalsParamsStatus {
+			return rental_service.GetUserRentalsParamsStatus(status)
+		}))
+	}
+
+	if params.Sort != nil {
+		result.Sort = lo.ToPtr(rental_service.GetUserRentalsParamsSort(*params.Sort))
+	}
+
+	return result
+}
+
+// toProjectionFilter returns false for parameters which rental service rejects,
+// such requests are passed to it to answer with the validation error.
+func toProjectionFilter(params openapi.GetUserRentalsParams) (projection.Filter, bool) {
+	filter := projection.Filter{
+		DateFrom: lo.FromPtr(params.DateFrom),
+		DateTo:   lo.FromPtr(params.DateTo),
+		Page:     lo.FromPtr(params.Page),
+		Size:     lo.FromPtr(params.Size),
+	}
+
+	if filter.Size == 0 {
+		filter.Size = defaultRentalsPageSize
+	}
+
+	if filter.Page < 0 || filter.Size < 0 || filter.Size > maxRentalsPageSize {
+		return filter, false
+	}
+
+	for _, status := range lo.FromPtr(params.Status) {
+		switch status {
+		case openapi.RESERVED, openapi.INPROGRESS, openapi.OVERDUE, openapi.FINISHED, openapi.CANCELED:
+			filter.Statuses = append(filter.Statuses, openapi.RentalResponseStatus(status))
+		default:
+			return filter, false
+		}
+	}
+
+	for _, date := range []string{filter.DateFrom, filter.DateTo} {
+		if _, err := time.Parse(time.DateOnly, date); date != "" && err != nil {
+			return filter, false
+		}
+	}
+
+	if filter.DateFrom != "" && filter.DateTo != "" && filter.DateTo < filter.DateFrom {
+		return filter, false
+	}
+
+	switch lo.FromPtr(params.Sort) {
+	case openapi.GetUserRentalsParamsSortDATEFROMASC:
+		filter.Asc = true
+	case "", openapi.GetUserRentalsParamsSortDATEFROMDESC:
+	default:
+		return filter, false
+	}
+
+	return filter, true
+}
+
+func fromPaymentServicePayment(payment *payment_service.PaymentInfo) openapi.PaymentInfo {
+	return openapi.PaymentInfo{
+		Discount:      payment.Discount,
+		PaymentUid:    payment.PaymentUid,
+		Price:         payment.Price,
+		Currency:      lo.EmptyableToPtr(payment.Currency),
+		PromoCode:     payment.PromoCode,
+		Refunded:      payment.Refunded,
+		Kind:          (*openapi.PaymentInfoKind)(payment.Kind),
+		Status:        openapi.PaymentInfoStatus(payment.Status),
+		Captured:      payment.Captured,
+		FailureReason: payment.FailureReason,
+		Taxes:         fromPaymentServiceTaxes(payment.Taxes),
+	}
+}
+
+func fromPaymentServiceTaxes(taxes *[]payment_service.Tax) *[]openapi.Tax {
+	if taxes == nil {
+		return nil
+	}
+
+	return lo.ToPtr(lo.Map(*taxes, func(tax payment_service.Tax, _ int) openapi.Tax {
+		return openapi.Tax{
+			Amount:       tax.Amount,
+			Jurisdiction: tax.Jurisdiction,
+			Mode:         openapi.TaxMode(tax.Mode),
+			Name:         tax.Name,
+			Percent:      tax.Percent,
+		}
+	}))
+}
+
+func fromPaymentServiceRentalLedger(ledger *payment_service.RentalLedger) openapi.RentalLedger {
+	return openapi.RentalLedger{
+		RentalUid: ledger.RentalUid,
+		Balances: lo.Map(ledger.Balances, func(balance payment_service.LedgerBalance, _ int) openapi.LedgerBalance {
+			return openapi.LedgerBalance(balance)
+		}),
+		Entries: lo.Map(ledger.Entries, func(entry payment_service.JournalEntry, _ int) openapi.JournalEntry {
+			return openapi.JournalEntry{
+				Amount:     entry.Amount,
+				CreatedAt:  entry.CreatedAt,
+				Currency:   entry.Currency,
+				EntryUid:   entry.EntryUid,
+				Kind:       openapi.JournalEntryKind(entry.Kind),
+				PaymentUid: entry.PaymentUid,
+				Postings: lo.Map(entry.Postings, func(posting payment_service.Posting, _ int) openapi.Posting {
+					return openapi.Posting(posting)
+				}),
+			}
+		}),
+	}
+}
+
+// toPaymentServiceInvoiceRequest lists the rental price items and extra charges. Rentals made before
+// price items were introduced have one line with the whole price.
+func toPaymentServiceInvoiceRequest(rental *rental_service.RentalResponse, car *cars_service.CarResponse) payment_service.InvoiceRequest {
+	lines := lo.Map(lo.FromPtr(rental.PriceItems), func(item rental_service.PriceItem, _ int) payment_service.InvoiceLine {
+		return payment_service.InvoiceLine{
+			Amount:      item.Amount,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+		}
+	})
+	if len(lines) == 0 {
+		lines = append(lines, payment_service.InvoiceLine{
+			Amount:      lo.FromPtr(rental.Price),
+			Description: "rental",
+			Quantity:    1,
+		})
+	}
+
+	for _, charge := range lo.FromPtr(rental.Charges) {
+		lines = append(lines, payment_service.InvoiceLine{
+			Amount:      charge.Amount,
+			Description: charge.Description,
+			Quantity:    charge.Quantity,
+		})
+	}
+
+	return payment_service.InvoiceRequest{
+		Car: payment_service.InvoiceCar{
+			Brand:              car.Brand,
+			Model:              car.Model,
+			RegistrationNumber: car.RegistrationNumber,
+			Type:               string(car.Type),
+		},
+		Currency:     lo.FromPtr(rental.Currency),
+		DateFrom:     rental.DateFrom,
+		DateTo:       rental.DateTo,
+		RentalStatus: payment_service.InvoiceRequestRentalStatus(rental.Status),
+		Lines:        lines,
+	}
+}
+
+func fromRentalServiceCharge(charge rental_service.Charge) openapi.Charge {
+	return openapi.Charge{
+		Amount:      charge.Amount,
+		Description: charge.Description,
+		Kind:        openapi.ChargeKind(charge.Kind),
+		Quantity:    charge.Quantity,
+		Unit:        openapi.ChargeUnit(charge.Unit),
+	}
+}
+
+// decodeOptionalBody leaves v empty if the request has no body.
+func decodeOptionalBody(c echo.Context, v any) error {
+	err := json.NewDecoder(c.Request().Body).Decode(v)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("decode body: %w", err)
+	}
+
+	return nil
+}
+
+func toRentalServiceQuoteRequest(car *cars_service.CarResponse, dateFrom, dateTo string) rental_service.QuoteRequest {
+	return rental_service.QuoteRequest{
+		CarType:    string(car.Type),
+		CarUid:     car.CarUid,
+		DailyPrice: car.Price,
+		Currency:   lo.EmptyableToPtr(car.Currency),
+		DateFrom:   dateFrom,
+		DateTo:     dateTo,
+	}
+}
+
+func fromRentalServiceQuote(quote rental_service.QuoteResponse) openapi.QuoteResponse {
+	return openapi.QuoteResponse{
+		CarUid:     quote.CarUid,
+		Currency:   quote.Currency,
+		DateFrom:   quote.DateFrom,
+		DateTo:     quote.DateTo,
+		Days:       quote.Days,
+		ExpiresAt:  quote.ExpiresAt,
+		QuoteId:    quote.QuoteUid,
+		Items:      lo.Map(quote.Items, fromRentalServicePriceItem),
+		TotalPrice: quote.TotalPrice,
+	}
+}
+
+func fromRentalServicePriceItem(item rental_service.PriceItem, _ int) openapi.PriceItem {
+	return openapi.PriceItem{
+		Amount:      item.Amount,
+		Description: item.Description,
+		Kind:        openapi.PriceItemKind(item.Kind),
+		Quantity:    item.Quantity,
+	}
+}
+
+func toRentalServiceSearchParams(params openapi.SearchRentalsParams) *rental_service.SearchRentalsParams {
+	result := &rental_service.SearchRentalsParams{
+		Username: params.Username,
+		CarUid:   params.CarUid,
+		DateFrom: params.DateFrom,
+		DateTo:   params.DateTo,
+		Page:     params.Page,
+		Size:     params.Size,
+	}
+
+	if params.Status != nil {
+		result.Status = lo.ToPtr(lo.Map(*params.Status, func(status openapi.SearchRentalsParamsStatus, _ int) rental_service.SearchRentalsParamsStatus {
+			return rental_service.SearchRentalsParamsStatus(status)
+		}))
+	}
+
+	if params.Sort != nil {
+		result.Sort = lo.ToPtr(rental_service.SearchRentalsParamsSort(*params.Sort))
+	}
+
+	return result
+}
+
+func fromRentalServiceAdminRental(rental rental_service.RentalResponse, _ int) openapi.AdminRental {
+	return openapi.AdminRental{
+		RentalUid:  rental.RentalUid,
+		Username:   lo.FromPtr(rental.Username),
+		Status:     string(rental.Status),
+		DateFrom:   rental.DateFrom,
+		DateTo:     rental.DateTo,
+		CarUid:     rental.CarUid,
+		PaymentUid: rental.PaymentUid,
+		Price:      rental.Price,
+		Currency:   rental.Currency,
+		ReturnedAt: rental.ReturnedAt,
+	}
+}
+
+func toRetryCommandFilter(params openapi.ListRetryCommandsParams) models.RetryCommandFilter {
+	filter := models.RetryCommandFilter{
+		Statuses: []models.RetryCommandStatus{models.RetryCommandPending, models.RetryCommandDead},
+		Page:     max(lo.FromPtr(params.Page), 0),
+		Size:     defaultRetriesPageSize,
+	}
+
+	if params.Status != nil && len(*params.Status) > 0 {
+		filter.Statuses = lo.Map(*params.Status, func(status openapi.ListRetryCommandsParamsStatus, _ int) models.RetryCommandStatus {
+			return models.RetryCommandStatus(status)
+		})
+	}
+
+	if params.Type != nil {
+		filter.Type = lo.ToPtr(models.RetryCommandType(*params.Type))
+	}
+
+	if params.Size != nil {
+		filter.Size = min(max(*params.Size, 1), maxRetriesPageSize)
+	}
+
+	return filter
+}
+
+func fromRetryCommand(command models.RetryCommand, _ int) openapi.RetryCommand {
+	return openapi.RetryCommand{
+		CommandUid:    command.UUID,
+		Type:          openapi.RetryCommandType(command.Type),
+		Topic:         command.Topic,
+		TargetUid:     command.Target,
+		Status:        openapi.RetryCommandStatus(command.Status),
+		Attempts:      command.Attempts,
+		Replays:       command.Replays,
+		LastError:     command.LastError,
+		DiscardReason: command.DiscardReason,
+		DiscardedBy:   command.DiscardedBy,
+		CreatedAt:     command.CreatedAt,
+		UpdatedAt:     command.UpdatedAt,
+	}
+}
+
+func isLogicError(err error) bool {
 	var validationError models.ValidationError
 	if errors.As(err, &validationError) {
 		return true
@@ -24,9 +372,10 @@ func isUnavailableError(c echo.Context, err error) bool {
 		return false
 	}
 
+	// Payment service answers 503 when the payment provider is unavailable.
 	var internalError models.InternalError
 	if errors.As(err, &internalError) {
-		return false
+		return internalError.StatusCode == http.StatusServiceUnavailable
 	}
 
 	return true
@@ -0,0 +1,157 @@
+package openapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/auth"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi"
+	rental_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/rental-service"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/samber/lo"
+)
+
+const (
+	headerReadModel          = "X-Read-Model"
+	headerLastAppliedEvent   = "X-Last-Applied-Event"
+	headerLastAppliedEventAt = "X-Last-Applied-Event-At"
+
+	readModelProjection = "PROJECTION"
+	readModelLive       = "LIVE"
+
+	// Page sizes of rental service.
+	defaultRentalsPageSize = 20
+	maxRentalsPageSize     = 100
+
+	// seedTimeout limits the load of the user rentals to the projection.
+	seedTimeout = time.Minute
+)
+
+// projectedRentals returns the page of the user rentals from the projection. False is returned
+// if the rentals must be read from the services.
+func (s *Server) projectedRentals(c echo.Context, params openapi.GetUserRentalsParams) ([]openapi.RentalResponse, int, bool) {
+	filter, ok := toProjectionFilter(params)
+	if !ok || !s.seedProjection(c) {
+		setReadModel(c, nil)
+		return nil, 0, false
+	}
+
+	rentals, total, marker, ok := s.projection.Rentals(c.Request().Context(), auth.GetUsername(c.Request().Context()), filter)
+	if !ok {
+		setReadModel(c, nil)
+		return nil, 0, false
+	}
+
+	setReadModel(c, &marker)
+
+	return rentals, total, true
+}
+
+// projectedRental returns the user rental from the projection. False is returned
+// if the rental must be read from the services.
+func (s *Server) projectedRental(c echo.Context, rentalUid uuid.UUID) (openapi.RentalResponse, bool) {
+	if !s.seedProjection(c) {
+		setReadModel(c, nil)
+		return openapi.RentalResponse{}, false
+	}
+
+	rental, marker, ok := s.projection.Rental(c.Request().Context(), auth.GetUsername(c.Request().Context()), rentalUid)
+	if !ok {
+		setReadModel(c, nil)
+		return openapi.RentalResponse{}, false
+	}
+
+	setReadModel(c, &marker)
+
+	return rental, true
+}
+
+// seedProjection reports whether rentals of the user are loaded to the projection. On the first read they are
+// loaded from the services in the background, the user is served by the services until then.
+// False is returned if the projection lags behind or the rentals aren't loaded yet.
+func (s *Server) seedProjection(c echo.Context) bool {
+	ctx := c.Request().Context()
+
+	username := auth.GetUsername(ctx)
+	if s.projection == nil || username == "" || !s.projection.CaughtUp() {
+		return false
+	}
+
+	seeded, err := s.projection.Seeded(ctx, username)
+	if err != nil {
+		s.logger.Errorw("cannot check projected rentals", "username", username, "error", err)
+		return false
+	}
+
+	if seeded {
+		return true
+	}
+
+	if _, loading := s.seeding.LoadOrStore(username, struct{}{}); !loading {
+		go s.seed(context.WithoutCancel(ctx), username)
+	}
+
+	return false
+}
+
+// seed loads all rentals of the user from the services. Rentals are loaded from the oldest, so rentals
+// created meanwhile may only repeat on the next page. The context keeps the token of the request
+// which started the load. The rentals are loaded again on the next read if the services fail.
+func (s *Server) seed(ctx context.Context, username string) {
+	defer s.seeding.Delete(username)
+
+	ctx, cancel := context.WithTimeout(ctx, seedTimeout)
+	defer cancel()
+
+	startedAt := time.Now()
+
+	var rentals []openapi.RentalResponse
+	for page := 0; ; page++ {
+		list, err := s.rental.List(ctx, auth.GetToken(ctx), &rental_service.GetUserRentalsParams{
+			Sort: lo.ToPtr(rental_service.GetUserRentalsParamsSortDATEFROMASC),
+			Page: lo.ToPtr(page),
+			Size: lo.ToPtr(maxRentalsPageSize),
+		})
+		if err != nil {
+			s.logger.Warnw("cannot load rentals to projection", "username", username, "error", err)
+			return
+		}
+
+		for i := range list.Items {
+			response, err := s.rentalResponse(ctx, &list.Items[i])
+			if err != nil {
+				s.logger.Warnw("cannot load rentals to projection", "username", username, "rental", list.Items[i].RentalUid, "error", err)
+				return
+			}
+
+			rentals = append(rentals, *response)
+		}
+
+		if len(list.Items) < maxRentalsPageSize || (page+1)*maxRentalsPageSize >= list.TotalElements {
+			break
+		}
+	}
+
+	err := s.projection.Seed(ctx, username, rentals, startedAt)
+	if err != nil {
+		s.logger.Errorw("cannot seed projected rentals", "username", username, "error", err)
+	}
+}
+
+// setReadModel tells the client where the response comes from, responses of the projection
+// are marked with the last applied event.
+func setReadModel(c echo.Context, marker *models.ProjectionMarker) {
+	header := c.Response().Header()
+	if marker == nil {
+		header.Set(headerReadModel, readModelLive)
+		return
+	}
+
+	header.Set(headerReadModel, readModelProjection)
+	if marker.EventID != uuid.Nil {
+		header.Set(headerLastAppliedEvent, marker.EventID.String())
+		header.Set(headerLastAppliedEventAt, marker.OccurredAt.Format(time.RFC3339Nano))
+	}
+}
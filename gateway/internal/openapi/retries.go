@@ -0,0 +1,139 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/auth"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultRetriesPageSize = 20
+	maxRetriesPageSize     = 100
+)
+
+// ListRetryCommands shows compensations which are retried or dead-lettered with their last errors.
+func (s *Server) ListRetryCommands(c echo.Context, params openapi.ListRetryCommandsParams) error {
+	filter := toRetryCommandFilter(params)
+
+	commands, total, err := s.retries.List(c.Request().Context(), filter)
+	if err != nil {
+		return processError(c, err, "list retry commands")
+	}
+
+	return c.JSON(http.StatusOK, openapi.RetryCommandPage{
+		Items:         lo.Map(commands, fromRetryCommand),
+		Page:          filter.Page,
+		PageSize:      filter.Size,
+		TotalElements: total,
+	})
+}
+
+// ReplayRetryCommands publishes the selected commands, or all dead commands of the type, to their retry topics again.
+func (s *Server) ReplayRetryCommands(c echo.Context) error {
+	var req openapi.ReplayRetryCommandsJSONRequestBody
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	commandUUIDs := lo.FromPtr(req.CommandUids)
+	if len(commandUUIDs) == 0 && req.Type == nil {
+		return processError(c, models.ValidationError{
+			Message: "nothing to replay",
+			Errors:  []models.ErrorDescription{{Field: "commandUids", Error: "commands or their type must be set"}},
+		}, "replay retry commands")
+	}
+
+	var commandType *models.RetryCommandType
+	if req.Type != nil {
+		commandType = lo.ToPtr(models.RetryCommandType(*req.Type))
+	}
+
+	commands, err := s.retries.Replay(c.Request().Context(), commandUUIDs, commandType)
+	if err != nil {
+		return processError(c, err, "replay retry commands")
+	}
+
+	for _, command := range commands {
+		s.retryQueue.Replay(command)
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(commands, fromRetryCommand))
+}
+
+// DiscardRetryCommands stops retrying the selected commands, the admin and the reason are recorded.
+func (s *Server) DiscardRetryCommands(c echo.Context) error {
+	var req openapi.DiscardRetryCommandsJSONRequestBody
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	var errs []models.ErrorDescription
+	if len(req.CommandUids) == 0 {
+		errs = append(errs, models.ErrorDescription{Field: "commandUids", Error: "commands must be set"})
+	}
+
+	if strings.TrimSpace(req.Reason) == "" {
+		errs = append(errs, models.ErrorDescription{Field: "reason", Error: "reason must be set"})
+	}
+
+	if len(errs) > 0 {
+		return processError(c, models.ValidationError{Message: "invalid discard request", Errors: errs}, "discard retry commands")
+	}
+
+	commands, err := s.retries.Discard(c.Request().Context(), req.CommandUids, req.Reason, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "discard retry commands")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(commands, fromRetryCommand))
+}
+
+// GetRetryBacklog shows the messages the retry consumers haven't handled yet together with
+// the commands which failed and wait for another attempt or were dead-lettered.
+func (s *Server) GetRetryBacklog(c echo.Context) error {
+	lags, err := s.backlog.Lags()
+	if err != nil {
+		return processError(c, err, "get retry topics lag")
+	}
+
+	counts, err := s.retries.Count(c.Request().Context())
+	if err != nil {
+		return processError(c, err, "count retry commands")
+	}
+
+	backlog := lo.Map(lags, func(lag models.RetryTopicLag, _ int) openapi.RetryTopicBacklog {
+		result := openapi.RetryTopicBacklog{
+			Topic: lag.Topic,
+			Types: lo.Map(lag.Types, func(commandType models.RetryCommandType, _ int) openapi.RetryTopicBacklogTypes {
+				return openapi.RetryTopicBacklogTypes(commandType)
+			}),
+			Partitions: lag.Partitions,
+			Lag:        lag.Lag,
+		}
+
+		for _, count := range counts {
+			if !lo.Contains(lag.Types, count.Type) {
+				continue
+			}
+
+			switch count.Status {
+			case models.RetryCommandPending:
+				result.Pending += count.Count
+			case models.RetryCommandDead:
+				result.Dead += count.Count
+			}
+		}
+
+		return result
+	})
+
+	return c.JSON(http.StatusOK, backlog)
+}
@@ -1,8 +1,13 @@
 package openapi
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,8 +20,11 @@ import (
 	payment_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/payment-service"
 	rental_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/rental-service"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/projection"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka/retryqueue"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/postgres"
 	"github.com/samber/lo"
+	"go.uber.org/zap"
 )
 
 type Server struct {
@@ -24,19 +32,35 @@ type Server struct {
 	payment    *clients.PaymentServiceClient
 	rental     *clients.RentalServiceClient
 	retryQueue *retryqueue.RetryQueueProducer
+	retries    *postgres.RetryCommands
+	backlog    *retryqueue.Backlog
+	projection *projection.Projection
+	logger     *zap.SugaredLogger
+
+	// seeding has users whose rentals are being loaded to the projection.
+	seeding sync.Map
 }
 
+// New creates the server, user rentals are read from the services if projection is nil.
 func New(
 	cars *clients.CarsServiceClient,
 	payment *clients.PaymentServiceClient,
 	rental *clients.RentalServiceClient,
 	retryQueue *retryqueue.RetryQueueProducer,
+	retries *postgres.RetryCommands,
+	backlog *retryqueue.Backlog,
+	projection *projection.Projection,
+	logger *zap.SugaredLogger,
 ) *Server {
 	return &Server{
 		cars:       cars,
 		payment:    payment,
 		rental:     rental,
 		retryQueue: retryQueue,
+		retries:    retries,
+		backlog:    backlog,
+		projection: projection,
+		logger:     logger,
 	}
 }
 
@@ -50,59 +74,141 @@ func (s *Server) GetCars(c echo.Context, params openapi.GetCarsParams) error {
 		return processError(c, err, "list cars")
 	}
 
-	return c.JSON(http.StatusOK, cars)
-}
+	result := openapi.PaginationResponse{
+		Items:         lo.Map(cars.Items, fromCarsServiceCar),
+		Page:          cars.Page,
+		PageSize:      cars.PageSize,
+		TotalElements: cars.TotalElements,
+	}
 
-func (s *Server) GetUserRentals(c echo.Context) error {
-	rentals, err := s.rental.List(c.Request().Context(), auth.GetToken(c.Request().Context()))
+	var prices priceDisplay
+	for i := range result.Items {
+		prices.add(result.Items[i].Price, result.Items[i].Currency, &result.Items[i].DisplayPrice)
+	}
+
+	err = s.displayPrices(c, params.XCurrency, prices)
 	if err != nil {
-		return processError(c, err, "list user rentals")
+		return processError(c, err, "display prices")
 	}
 
-	result := make([]openapi.RentalResponse, len(rentals))
-	for i, rental := range rentals {
-		car, err := s.cars.Get(c.Request().Context(), rental.CarUid)
+	return c.JSON(http.StatusOK, result)
+}
+
+func (s *Server) GetUserRentals(c echo.Context, params openapi.GetUserRentalsParams) error {
+	result, total, ok := s.projectedRentals(c, params)
+	if !ok {
+		rentals, err := s.rental.List(c.Request().Context(), auth.GetToken(c.Request().Context()), toRentalServiceListParams(params))
 		if err != nil {
-			if isLogicError(c, err) {
-				return processError(c, err, "get car info")
-			}
+			return processError(c, err, "list user rentals")
+		}
 
-			car = &cars_service.CarResponse{
-				CarUid: rental.CarUid,
+		result = make([]openapi.RentalResponse, len(rentals.Items))
+		for i := range rentals.Items {
+			response, err := s.rentalResponse(c.Request().Context(), &rentals.Items[i])
+			if err != nil {
+				return processError(c, err, "get rental info")
 			}
+
+			result[i] = *response
 		}
+		total = rentals.TotalElements
+	}
 
-		payment, err := s.payment.Get(c.Request().Context(), rental.PaymentUid)
-		if err != nil {
-			if isLogicError(c, err) {
-				return processError(c, err, "get payment info")
-			}
+	var prices priceDisplay
+	for i := range result {
+		prices.add(result[i].Payment.Price, lo.FromPtr(result[i].Payment.Currency), &result[i].Payment.DisplayPrice)
+	}
 
-			payment = &payment_service.PaymentInfo{
-				PaymentUid: rental.PaymentUid,
-			}
+	err := s.displayPrices(c, params.XCurrency, prices)
+	if err != nil {
+		return processError(c, err, "display prices")
+	}
+
+	c.Response().Header().Set("X-Total-Count", strconv.Itoa(total))
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// rentalResponse joins the rental with its car and payment. Car and payment are reduced to their uids
+// if the services are unavailable.
+func (s *Server) rentalResponse(ctx context.Context, rental *rental_service.RentalResponse) (*openapi.RentalResponse, error) {
+	car, err := s.cars.Get(ctx, rental.CarUid)
+	if err != nil {
+		if isLogicError(err) {
+			return nil, fmt.Errorf("get car info: %w", err)
 		}
 
-		result[i] = openapi.RentalResponse{
-			Car: openapi.CarInfo{
-				Brand:              car.Brand,
-				CarUid:             car.CarUid,
-				Model:              car.Model,
-				RegistrationNumber: car.RegistrationNumber,
-			},
-			DateFrom: rental.DateFrom,
-			DateTo:   rental.DateTo,
-			Payment: openapi.PaymentInfo{
-				PaymentUid: payment.PaymentUid,
-				Price:      payment.Price,
-				Status:     openapi.PaymentInfoStatus(payment.Status),
-			},
-			RentalUid: rental.RentalUid,
-			Status:    openapi.RentalResponseStatus(rental.Status),
+		car = &cars_service.CarResponse{
+			CarUid: rental.CarUid,
 		}
 	}
 
-	return c.JSON(http.StatusOK, result)
+	payment, err := s.payment.Get(ctx, rental.PaymentUid)
+	if err != nil {
+		if isLogicError(err) {
+			return nil, fmt.Errorf("get payment info: %w", err)
+		}
+
+		payment = &payment_service.PaymentInfo{
+			PaymentUid: rental.PaymentUid,
+		}
+	}
+
+	return &openapi.RentalResponse{
+		Car: openapi.CarInfo{
+			Brand:              car.Brand,
+			CarUid:             car.CarUid,
+			Model:              car.Model,
+			RegistrationNumber: car.RegistrationNumber,
+		},
+		DateFrom:  rental.DateFrom,
+		DateTo:    rental.DateTo,
+		Payment:   fromPaymentServicePayment(payment),
+		RentalUid: rental.RentalUid,
+		Status:    openapi.RentalResponseStatus(rental.Status),
+	}, nil
+}
+
+// priceDisplay collects prices to show in the currency the user prefers.
+type priceDisplay struct {
+	amounts []payment_service.Money
+	targets []**openapi.Money
+}
+
+// add skips prices without currency, e.g. of payments which weren't received from payment service.
+func (d *priceDisplay) add(amount int, currency string, target **openapi.Money) {
+	if currency == "" {
+		return
+	}
+
+	d.amounts = append(d.amounts, payment_service.Money{Amount: amount, Currency: currency})
+	d.targets = append(d.targets, target)
+}
+
+// displayPrices converts collected prices by the exchange rates of payment service. Converted prices are only
+// displayed, so they are omitted if payment service is unavailable.
+func (s *Server) displayPrices(c echo.Context, currency *string, prices priceDisplay) error {
+	if currency == nil || len(prices.amounts) == 0 {
+		return nil
+	}
+
+	converted, err := s.payment.Convert(c.Request().Context(), prices.amounts, *currency)
+	if err != nil {
+		if isLogicError(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	for i, money := range converted {
+		*prices.targets[i] = &openapi.Money{
+			Amount:   money.Amount,
+			Currency: money.Currency,
+		}
+	}
+
+	return nil
 }
 
 func (s *Server) revertBook(c echo.Context, carUid uuid.UUID) error {
@@ -115,7 +221,7 @@ func (s *Server) revertBook(c echo.Context, carUid uuid.UUID) error {
 }
 
 func (s *Server) revertPayment(c echo.Context, paymentUid uuid.UUID) error {
-	err := s.payment.Cancel(c.Request().Context(), paymentUid)
+	_, err := s.payment.Cancel(c.Request().Context(), paymentUid, nil)
 	if err != nil {
 		return processError(c, err, "cancel payment")
 	}
@@ -130,24 +236,14 @@ func (s *Server) BookCar(c echo.Context) error {
 		return processError(c, err, "cannot unmarshal request body")
 	}
 
-	dateFrom, err := time.Parse(time.DateOnly, req.DateFrom)
-	if err != nil {
-		return processError(c, models.ValidationError{Message: err.Error()}, "parse date from")
-	}
-
-	dateTo, err := time.Parse(time.DateOnly, req.DateTo)
+	car, err := s.cars.Get(c.Request().Context(), req.CarUid)
 	if err != nil {
-		return processError(c, models.ValidationError{Message: err.Error()}, "parse date to")
-	}
-
-	numDays := int(dateTo.Sub(dateFrom).Hours()) / 24
-	if numDays < 1 {
-		return processError(c, models.ValidationError{Message: "should rent min to 1 day"}, "check rent dates")
+		return processError(c, err, "get car")
 	}
 
-	car, err := s.cars.Get(c.Request().Context(), req.CarUid)
+	quote, err := s.bookingQuote(c, car, req)
 	if err != nil {
-		return processError(c, err, "get car")
+		return processError(c, err, "quote rental")
 	}
 
 	_, err = s.cars.Book(c.Request().Context(), car.CarUid)
@@ -155,16 +251,19 @@ func (s *Server) BookCar(c echo.Context) error {
 		return processError(c, err, "book car")
 	}
 
-	totalPrice := car.Price * numDays
 	payment, err := s.payment.Create(c.Request().Context(), payment_service.CreatePaymentRequest{
-		Price: totalPrice,
+		Price:         quote.TotalPrice,
+		Currency:      quote.Currency,
+		PromoCode:     req.PromoCode,
+		CarType:       lo.ToPtr(string(car.Type)),
+		RentalDays:    &quote.Days,
+		PaymentMethod: req.PaymentMethod,
 	})
 	if err != nil {
-		if !isLogicError(c, err) {
-			revertErr := s.revertBook(c, car.CarUid)
-			if revertErr != nil {
-				return processError(c, revertErr, "revert book")
-			}
+		// A rejected promo code is a logic error, but the car is already booked and has to be released anyway.
+		revertErr := s.revertBook(c, car.CarUid)
+		if revertErr != nil {
+			return processError(c, revertErr, "revert book")
 		}
 		return processAndHideError(c, err, "Payment Service unavailable")
 	}
@@ -174,31 +273,29 @@ func (s *Server) BookCar(c echo.Context) error {
 		DateFrom:   req.DateFrom,
 		DateTo:     req.DateTo,
 		PaymentUid: payment.PaymentUid,
+		QuoteUid:   quote.QuoteUid,
 	})
 	if err != nil {
-		if !isLogicError(c, err) {
-			revertErr := s.revertBook(c, car.CarUid)
-			if revertErr != nil {
-				return processError(c, revertErr, "revert book")
-			}
+		// Rental service validates the period only after the car is booked and paid,
+		// so both have to be reverted even if the rental was rejected.
+		revertErr := s.revertBook(c, car.CarUid)
+		if revertErr != nil {
+			return processError(c, revertErr, "revert book")
+		}
 
-			revertErr = s.revertPayment(c, payment.PaymentUid)
-			if revertErr != nil {
-				return processError(c, revertErr, "revert payment")
-			}
+		revertErr = s.revertPayment(c, payment.PaymentUid)
+		if revertErr != nil {
+			return processError(c, revertErr, "revert payment")
 		}
+
 		return processError(c, err, "create rental")
 	}
 
 	result := openapi.CreateRentalResponse{
-		CarUid:   car.CarUid,
-		DateFrom: rental.DateFrom,
-		DateTo:   rental.DateTo,
-		Payment: openapi.PaymentInfo{
-			PaymentUid: payment.PaymentUid,
-			Price:      payment.Price,
-			Status:     openapi.PaymentInfoStatus(payment.Status),
-		},
+		CarUid:    car.CarUid,
+		DateFrom:  rental.DateFrom,
+		DateTo:    rental.DateTo,
+		Payment:   fromPaymentServicePayment(payment),
 		RentalUid: rental.RentalUid,
 		Status:    openapi.CreateRentalResponseStatus(rental.Status),
 	}
@@ -206,47 +303,504 @@ func (s *Server) BookCar(c echo.Context) error {
 	return c.JSON(http.StatusOK, result)
 }
 
-func (s *Server) CancelRental(c echo.Context, rentalUid openapi_types.UUID) error {
+func (s *Server) QuoteRental(c echo.Context) error {
+	var req openapi.QuoteRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	car, err := s.cars.Get(c.Request().Context(), req.CarUid)
+	if err != nil {
+		return processError(c, err, "get car")
+	}
+
+	quote, err := s.rental.Quote(c.Request().Context(), toRentalServiceQuoteRequest(car, req.DateFrom, req.DateTo))
+	if err != nil {
+		return processError(c, err, "quote rental")
+	}
+
+	return c.JSON(http.StatusOK, fromRentalServiceQuote(*quote))
+}
+
+// bookingQuote returns the quote the user has seen or calculates the price now if there is none.
+// The quote is checked before the car is booked and paid, rental service checks it again on creation.
+func (s *Server) bookingQuote(c echo.Context, car *cars_service.CarResponse, req openapi.CreateRentalRequest) (*rental_service.QuoteResponse, error) {
+	if req.QuoteId == nil {
+		return s.rental.Quote(c.Request().Context(), toRentalServiceQuoteRequest(car, req.DateFrom, req.DateTo))
+	}
+
+	quote, err := s.rental.GetQuote(c.Request().Context(), *req.QuoteId)
+	if err != nil {
+		return nil, fmt.Errorf("get quote: %w", err)
+	}
+
+	var errs []models.ErrorDescription
+	if quote.CarUid != car.CarUid || quote.DateFrom != req.DateFrom || quote.DateTo != req.DateTo {
+		errs = append(errs, models.ErrorDescription{Field: "quoteId", Error: "quote was made for another car or period"})
+	}
+
+	if quote.ExpiresAt.Before(time.Now()) {
+		errs = append(errs, models.ErrorDescription{Field: "quoteId", Error: "quote has expired"})
+	}
+
+	if len(errs) > 0 {
+		return nil, models.ValidationError{Message: "invalid quote", Errors: errs}
+	}
+
+	return quote, nil
+}
+
+func (s *Server) CancelRental(c echo.Context, rentalUid openapi_types.UUID, params openapi.CancelRentalParams) error {
 	rental, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
 	if err != nil {
 		return processError(c, err, "get user rental")
 	}
 
-	err = s.cars.Unbook(c.Request().Context(), rental.CarUid)
+	err = s.rental.Cancel(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid, params.Reason)
+	if err != nil {
+		return processError(c, err, "cancel rental")
+	}
+
+	result, err := s.settleCancel(c, rental)
+	if err != nil {
+		return processError(c, err, "settle canceled rental")
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// settleCancel releases the car and refunds the payment of the canceled rental by the cancellation policy.
+// Failed car unbooks and payment calls which failed because services are unavailable are retried from the queue.
+func (s *Server) settleCancel(c echo.Context, rental *rental_service.RentalResponse) (*openapi.CancelRentalResponse, error) {
+	// The rental is already canceled, so the payment is settled whatever the car unbook returns.
+	err := s.cars.Unbook(c.Request().Context(), rental.CarUid)
 	if err != nil {
 		if isUnavailableError(c, err) {
-			s.retryQueue.RetryCarUnbook(rental.CarUid)
+			s.logger.Warnw("cannot make car available, retrying", "car", rental.CarUid, "rental", rental.RentalUid, "error", err)
 		} else {
-			return processError(c, err, "make car available")
+			s.logger.Errorw("cannot make car available, retrying", "car", rental.CarUid, "rental", rental.RentalUid, "error", err)
 		}
+
+		s.retryQueue.RetryCarUnbook(rental.CarUid)
+	}
+
+	result := openapi.CancelRentalResponse{
+		RentalUid: rental.RentalUid,
+		Status:    string(rental_service.RentalResponseStatusCANCELED),
 	}
 
-	err = s.rental.Cancel(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+	rentalStart, err := time.Parse(time.DateOnly, rental.DateFrom)
 	if err != nil {
-		return processError(c, err, "cancel rental")
+		return nil, fmt.Errorf("parse rental start: %w", err)
+	}
+	canceledAt := time.Now().UTC()
+
+	payment, err := s.payment.Cancel(c.Request().Context(), rental.PaymentUid, &payment_service.CancelParams{
+		RentalStart: &rentalStart,
+		CanceledAt:  &canceledAt,
+		RentalUid:   &rental.RentalUid,
+	})
+	if err != nil {
+		if !isUnavailableError(c, err) {
+			return nil, fmt.Errorf("cancel payment: %w", err)
+		}
+
+		s.retryQueue.RetryPaymentRefund(rental.PaymentUid, rental.RentalUid, rentalStart, canceledAt)
+	} else {
+		result.Payment = lo.ToPtr(fromPaymentServicePayment(payment))
+	}
+
+	return &result, nil
+}
+
+func (s *Server) GetUserRental(c echo.Context, rentalUid openapi_types.UUID, params openapi.GetUserRentalParams) error {
+	result, ok := s.projectedRental(c, rentalUid)
+	if !ok {
+		rental, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+		if err != nil {
+			return processError(c, err, "get user rental")
+		}
+
+		response, err := s.rentalResponse(c.Request().Context(), rental)
+		if err != nil {
+			return processError(c, err, "get rental info")
+		}
+
+		result = *response
+	}
+
+	var prices priceDisplay
+	prices.add(result.Payment.Price, lo.FromPtr(result.Payment.Currency), &result.Payment.DisplayPrice)
+
+	err := s.displayPrices(c, params.XCurrency, prices)
+	if err != nil {
+		return processError(c, err, "display prices")
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (s *Server) GetRentalHistory(c echo.Context, rentalUid openapi_types.UUID) error {
+	events, err := s.rental.GetHistory(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rental history")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(events, func(e rental_service.RentalEvent, _ int) openapi.RentalEvent {
+		return openapi.RentalEvent(e)
+	}))
+}
+
+// GetRentalLedger checks that the rental belongs to the user before showing its payments.
+func (s *Server) GetRentalLedger(c echo.Context, rentalUid openapi_types.UUID) error {
+	_, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rental")
+	}
+
+	ledger, err := s.payment.GetRentalLedger(c.Request().Context(), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rental ledger")
+	}
+
+	return c.JSON(http.StatusOK, fromPaymentServiceRentalLedger(ledger))
+}
+
+// GetRentalInvoice returns the invoice generated before or generates it from the rental and car details.
+// The invoice is generated only for finished and canceled rentals, their charges and refunds don't change anymore.
+func (s *Server) GetRentalInvoice(c echo.Context, rentalUid openapi_types.UUID) error {
+	rental, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rental")
+	}
+
+	invoice, err := s.payment.GetInvoice(c.Request().Context(), rentalUid)
+	if err != nil {
+		return processError(c, err, "get invoice")
+	}
+
+	if invoice == nil {
+		if rental.Status != rental_service.RentalResponseStatusFINISHED && rental.Status != rental_service.RentalResponseStatusCANCELED {
+			return processError(c, models.InternalError{
+				Message:    fmt.Sprintf("rental is %s", rental.Status),
+				StatusCode: http.StatusConflict,
+			}, "generate invoice")
+		}
+
+		car, err := s.cars.Get(c.Request().Context(), rental.CarUid)
+		if err != nil {
+			return processError(c, err, "get car")
+		}
+
+		invoice, err = s.payment.GenerateInvoice(c.Request().Context(), rentalUid, toPaymentServiceInvoiceRequest(rental, car))
+		if err != nil {
+			return processError(c, err, "generate invoice")
+		}
+	}
+
+	return c.Blob(http.StatusOK, "application/pdf", invoice)
+}
+
+func (s *Server) StartRental(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.CarReadings
+	err := decodeOptionalBody(c, &req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	err = s.rental.Start(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid, rental_service.CarReadings{
+		FuelLevel: req.FuelLevel,
+		Odometer:  req.Odometer,
+	})
+	if err != nil {
+		return processError(c, err, "start rental")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// FinishRental returns the car, captures the amount authorized at booking and pays extra charges
+// calculated by rental service with separate payments. The rental is already finished when payments are made,
+// so amounts which weren't captured are reported as outstanding.
+func (s *Server) FinishRental(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.FinishRentalRequest
+	err := decodeOptionalBody(c, &req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	rental, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+	if err != nil {
+		return processError(c, err, "get user rental")
 	}
 
-	err = s.payment.Cancel(c.Request().Context(), rental.PaymentUid)
+	finished, err := s.rental.Finish(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid, rental_service.FinishRentalRequest{
+		FuelLevel:  req.FuelLevel,
+		Odometer:   req.Odometer,
+		ReturnedAt: req.ReturnedAt,
+	})
+	if err != nil {
+		return processError(c, err, "finish rental")
+	}
+
+	result, err := s.settleFinish(c, rental, finished, nil)
+	if err != nil {
+		return processError(c, err, "settle finished rental")
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// settleFinish releases the car, captures the amount authorized at booking and pays extra charges with separate
+// payments of the owner. The capture is retried through the queue if payment service is unavailable, a failed
+// extra charge is always paid through the queue. Owner is empty when the user finishes the rental, payments
+// are created for the caller then.
+func (s *Server) settleFinish(c echo.Context, rental, finished *rental_service.RentalResponse, owner *string) (*openapi.RentalSettlement, error) {
+	// The rental is already finished, so the payment is settled whatever the car unbook returns.
+	err := s.cars.Unbook(c.Request().Context(), rental.CarUid)
 	if err != nil {
 		if isUnavailableError(c, err) {
-			s.retryQueue.RetryPaymentCancel(rental.PaymentUid)
+			s.logger.Warnw("cannot make car available, retrying", "car", rental.CarUid, "rental", rental.RentalUid, "error", err)
 		} else {
-			return processError(c, err, "cancel payment")
+			s.logger.Errorw("cannot make car available, retrying", "car", rental.CarUid, "rental", rental.RentalUid, "error", err)
 		}
+
+		s.retryQueue.RetryCarUnbook(rental.CarUid)
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	result := openapi.RentalSettlement{
+		Charges:       []openapi.Charge{},
+		ExtraPayments: []openapi.PaymentInfo{},
+		RentalPrice:   finished.Price,
+		RentalUid:     finished.RentalUid,
+		ReturnedAt:    finished.ReturnedAt,
+		Status:        string(finished.Status),
+	}
+
+	payment, err := s.payment.Capture(c.Request().Context(), finished.PaymentUid, finished.RentalUid)
+	if err != nil {
+		result.Outstanding += lo.FromPtr(finished.Price)
+
+		if isUnavailableError(c, err) {
+			s.logger.Warnw("cannot capture rental payment, retrying", "rental", finished.RentalUid, "payment", finished.PaymentUid, "error", err)
+			s.retryQueue.RetryPaymentCapture(finished.PaymentUid, finished.RentalUid)
+		} else {
+			s.logger.Errorw("cannot capture rental payment", "rental", finished.RentalUid, "payment", finished.PaymentUid, "error", err)
+		}
+	} else {
+		result.Payment = lo.ToPtr(fromPaymentServicePayment(payment))
+	}
+
+	username := lo.FromPtr(owner)
+	if username == "" {
+		username = auth.GetUsername(c.Request().Context())
+	}
+
+	for _, charge := range lo.FromPtr(finished.Charges) {
+		result.Charges = append(result.Charges, fromRentalServiceCharge(charge))
+		result.TotalCharges += charge.Amount
+
+		payment, err := s.payment.Create(c.Request().Context(), payment_service.CreatePaymentRequest{
+			Price:     charge.Amount,
+			Currency:  lo.FromPtr(finished.Currency),
+			RentalUid: &finished.RentalUid,
+			Kind:      lo.ToPtr(payment_service.CreatePaymentRequestKind(charge.Kind)),
+			Username:  owner,
+		})
+		if err != nil {
+			result.Outstanding += charge.Amount
+
+			s.logChargeError(c, "cannot create charge payment, retrying", finished.RentalUid, uuid.Nil, err)
+			s.retryQueue.RetryPaymentCharge(finished.RentalUid, models.PaymentCharge{
+				Price:    charge.Amount,
+				Currency: lo.FromPtr(finished.Currency),
+				Kind:     string(charge.Kind),
+				Username: username,
+			})
+			continue
+		}
+
+		captured, err := s.payment.Capture(c.Request().Context(), payment.PaymentUid, finished.RentalUid)
+		if err != nil {
+			result.Outstanding += charge.Amount
+
+			s.logChargeError(c, "cannot capture charge payment, retrying", finished.RentalUid, payment.PaymentUid, err)
+			s.retryQueue.RetryPaymentCapture(payment.PaymentUid, finished.RentalUid)
+		} else {
+			payment = captured
+		}
+
+		result.ExtraPayments = append(result.ExtraPayments, fromPaymentServicePayment(payment))
+	}
+
+	return &result, nil
+}
+
+func (s *Server) logChargeError(c echo.Context, msg string, rentalUid, paymentUid uuid.UUID, err error) {
+	if isUnavailableError(c, err) {
+		s.logger.Warnw(msg, "rental", rentalUid, "payment", paymentUid, "error", err)
+	} else {
+		s.logger.Errorw(msg, "rental", rentalUid, "payment", paymentUid, "error", err)
+	}
+}
+
+// ChangeRentalDates moves the end date of the rental and settles the difference of prices. The extension is paid
+// by a separate payment which is captured at once, the shortening refunds a part of the rental payment.
+// The rental change is reverted if the payment fails, so the rental and its payments stay consistent.
+func (s *Server) ChangeRentalDates(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.ChangeRentalDatesJSONRequestBody
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	rental, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+	if err != nil {
+		return processError(c, err, "get user rental")
+	}
+
+	change, err := s.rental.ChangeDates(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid, rental_service.ChangeRentalRequest{
+		DateTo: req.DateTo,
+	})
+	if err != nil {
+		return processError(c, err, "change rental dates")
+	}
+
+	result := openapi.RentalChangeResponse{
+		RentalUid:      change.RentalUid,
+		ChangeUid:      change.ChangeUid,
+		PreviousDateTo: change.PreviousDateTo,
+		DateTo:         change.DateTo,
+		PreviousPrice:  change.PreviousPrice,
+		Price:          change.Price,
+		Difference:     change.Difference,
+		Currency:       change.Currency,
+	}
+
+	switch {
+	case change.Difference > 0:
+		payment, err := s.payment.Create(c.Request().Context(), payment_service.CreatePaymentRequest{
+			Price:         change.Difference,
+			Currency:      change.Currency,
+			RentalUid:     &rentalUid,
+			Kind:          lo.ToPtr(payment_service.CreatePaymentRequestKindEXTENSION),
+			PaymentMethod: req.PaymentMethod,
+		})
+		if err != nil {
+			revertErr := s.revertChange(c, rentalUid, change.ChangeUid)
+			if revertErr != nil {
+				return processError(c, revertErr, "revert rental change")
+			}
+			return processAndHideError(c, err, "Payment Service unavailable")
+		}
+
+		captured, err := s.payment.Capture(c.Request().Context(), payment.PaymentUid, rentalUid)
+		if err != nil {
+			// The rental change is reverted even if the extension payment can't be canceled now,
+			// the payment is canceled through the queue then.
+			_, cancelErr := s.payment.Cancel(c.Request().Context(), payment.PaymentUid, nil)
+			if cancelErr != nil {
+				s.logger.Warnw("cannot cancel extension payment, retrying", "rental", rentalUid, "payment", payment.PaymentUid, "error", cancelErr)
+				s.retryQueue.RetryPaymentCancel(payment.PaymentUid)
+			}
+
+			revertErr := s.revertChange(c, rentalUid, change.ChangeUid)
+			if revertErr != nil {
+				return processError(c, revertErr, "revert rental change")
+			}
+			return processAndHideError(c, err, "Payment Service unavailable")
+		}
+
+		result.Payment = lo.ToPtr(fromPaymentServicePayment(captured))
+	case change.Difference < 0:
+		payment, err := s.payment.Get(c.Request().Context(), rental.PaymentUid)
+		if err == nil {
+			payment, err = s.payment.Refund(c.Request().Context(), rental.PaymentUid, payment_service.RefundRequest{
+				Amount:    shorteningRefund(payment, change),
+				RentalUid: &rentalUid,
+			})
+		}
+		if err != nil {
+			revertErr := s.revertChange(c, rentalUid, change.ChangeUid)
+			if revertErr != nil {
+				return processError(c, revertErr, "revert rental change")
+			}
+			return processAndHideError(c, err, "Payment Service unavailable")
+		}
+
+		result.Payment = lo.ToPtr(fromPaymentServicePayment(payment))
+	}
+
+	return c.JSON(http.StatusOK, result)
 }
 
-func (s *Server) GetUserRental(c echo.Context, rentalUid openapi_types.UUID) error {
+// shorteningRefund is the part of the rental payment returned for the shortened rental. The change is priced
+// by the rental quote, so the refund is proportional to the amount actually paid, which differs from the quote
+// by promo discounts and exclusive taxes. The payment is only authorized until the first shortening captures
+// a part of it, the captured amount excludes the refunds of previous shortenings. Nothing is refunded for the rental
+// which cost nothing.
+func shorteningRefund(payment *payment_service.PaymentInfo, change *rental_service.RentalChange) int {
+	if change.PreviousPrice == 0 {
+		return 0
+	}
+
+	paid := lo.FromPtr(payment.Captured)
+	if paid == 0 {
+		paid = payment.Price
+	}
+
+	return int(math.Round(float64(paid) * float64(-change.Difference) / float64(change.PreviousPrice)))
+}
+
+func (s *Server) revertChange(c echo.Context, rentalUid, changeUid uuid.UUID) error {
+	err := s.rental.RevertChange(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid, changeUid)
+	if err != nil {
+		return processError(c, err, "revert change")
+	}
+
+	return nil
+}
+
+// ConfirmRentalPayment completes the booking payment which is waiting for 3-D Secure.
+func (s *Server) ConfirmRentalPayment(c echo.Context, rentalUid openapi_types.UUID) error {
 	rental, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
 	if err != nil {
 		return processError(c, err, "get user rental")
 	}
 
+	payment, err := s.payment.Confirm(c.Request().Context(), rental.PaymentUid)
+	if err != nil {
+		return processError(c, err, "confirm payment")
+	}
+
+	return c.JSON(http.StatusOK, fromPaymentServicePayment(payment))
+}
+
+func (s *Server) SearchRentals(c echo.Context, params openapi.SearchRentalsParams) error {
+	rentals, err := s.rental.Search(c.Request().Context(), toRentalServiceSearchParams(params))
+	if err != nil {
+		return processError(c, err, "search rentals")
+	}
+
+	return c.JSON(http.StatusOK, openapi.AdminRentalPage{
+		Items:         lo.Map(rentals.Items, fromRentalServiceAdminRental),
+		Page:          rentals.Page,
+		PageSize:      rentals.PageSize,
+		TotalElements: rentals.TotalElements,
+	})
+}
+
+// GetAnyRental shows the rental of any user with its car, booking payment and status history to support staff.
+func (s *Server) GetAnyRental(c echo.Context, rentalUid openapi_types.UUID) error {
+	rental, err := s.rental.GetAny(c.Request().Context(), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rental")
+	}
+
 	car, err := s.cars.Get(c.Request().Context(), rental.CarUid)
 	if err != nil {
-		if isLogicError(c, err) {
+		if isLogicError(err) {
 			return processError(c, err, "get car info")
 		}
 
@@ -257,7 +811,7 @@ func (s *Server) GetUserRental(c echo.Context, rentalUid openapi_types.UUID) err
 
 	payment, err := s.payment.Get(c.Request().Context(), rental.PaymentUid)
 	if err != nil {
-		if isLogicError(c, err) {
+		if isLogicError(err) {
 			return processError(c, err, "get payment info")
 		}
 
@@ -266,48 +820,193 @@ func (s *Server) GetUserRental(c echo.Context, rentalUid openapi_types.UUID) err
 		}
 	}
 
-	result := openapi.RentalResponse{
+	history, err := s.rental.GetAnyHistory(c.Request().Context(), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rental history")
+	}
+
+	summary := fromRentalServiceAdminRental(*rental, 0)
+
+	return c.JSON(http.StatusOK, openapi.AdminRentalDetails{
+		RentalUid:  summary.RentalUid,
+		Username:   summary.Username,
+		Status:     summary.Status,
+		DateFrom:   summary.DateFrom,
+		DateTo:     summary.DateTo,
+		CarUid:     summary.CarUid,
+		PaymentUid: summary.PaymentUid,
+		Price:      summary.Price,
+		Currency:   summary.Currency,
+		ReturnedAt: summary.ReturnedAt,
 		Car: openapi.CarInfo{
 			Brand:              car.Brand,
 			CarUid:             car.CarUid,
 			Model:              car.Model,
 			RegistrationNumber: car.RegistrationNumber,
 		},
-		DateFrom: rental.DateFrom,
-		DateTo:   rental.DateTo,
-		Payment: openapi.PaymentInfo{
-			PaymentUid: payment.PaymentUid,
-			Price:      payment.Price,
-			Status:     openapi.PaymentInfoStatus(payment.Status),
-		},
-		RentalUid: rental.RentalUid,
-		Status:    openapi.RentalResponseStatus(rental.Status),
+		Payment:    fromPaymentServicePayment(payment),
+		PriceItems: lo.Map(lo.FromPtr(rental.PriceItems), fromRentalServicePriceItem),
+		Charges: lo.Map(lo.FromPtr(rental.Charges), func(charge rental_service.Charge, _ int) openapi.Charge {
+			return fromRentalServiceCharge(charge)
+		}),
+		History: lo.Map(history, func(e rental_service.RentalEvent, _ int) openapi.RentalEvent {
+			return openapi.RentalEvent(e)
+		}),
+	})
+}
+
+// ForceCancelRental cancels the rental of any user and compensates the car and the payment like CancelRental.
+func (s *Server) ForceCancelRental(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.ForceCancelRentalJSONRequestBody
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	rental, err := s.rental.GetAny(c.Request().Context(), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rental")
+	}
+
+	err = s.rental.ForceCancel(c.Request().Context(), rentalUid, req.Reason)
+	if err != nil {
+		return processError(c, err, "cancel rental")
+	}
+
+	result, err := s.settleCancel(c, rental)
+	if err != nil {
+		return processError(c, err, "settle canceled rental")
 	}
 
 	return c.JSON(http.StatusOK, result)
 }
 
-func (s *Server) FinishRental(c echo.Context, rentalUid openapi_types.UUID) error {
-	rental, err := s.rental.Get(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+// ForceFinishRental finishes the rental of any user and settles it like FinishRental,
+// extra charges are paid by the rental owner.
+func (s *Server) ForceFinishRental(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.ForceFinishRentalJSONRequestBody
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
 	if err != nil {
-		return processError(c, err, "get user rental")
+		return processError(c, err, "cannot unmarshal request body")
 	}
 
-	err = s.cars.Unbook(c.Request().Context(), rental.CarUid)
+	rental, err := s.rental.GetAny(c.Request().Context(), rentalUid)
 	if err != nil {
-		if isUnavailableError(c, err) {
-			s.retryQueue.RetryCarUnbook(rental.CarUid)
-		} else {
-			return processError(c, err, "make car available")
-		}
+		return processError(c, err, "get rental")
 	}
 
-	err = s.rental.Finish(c.Request().Context(), auth.GetToken(c.Request().Context()), rentalUid)
+	finished, err := s.rental.ForceFinish(c.Request().Context(), rentalUid, rental_service.ForceFinishRequest{
+		FuelLevel:  req.FuelLevel,
+		Odometer:   req.Odometer,
+		Reason:     req.Reason,
+		ReturnedAt: req.ReturnedAt,
+	})
 	if err != nil {
 		return processError(c, err, "finish rental")
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	result, err := s.settleFinish(c, rental, finished, rental.Username)
+	if err != nil {
+		return processError(c, err, "settle finished rental")
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func (s *Server) CreateCar(c echo.Context) error {
+	var req openapi.CarRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	car, err := s.cars.Create(c.Request().Context(), toCarsServiceCarRequest(req))
+	if err != nil {
+		return processError(c, err, "create car")
+	}
+
+	return c.JSON(http.StatusCreated, car)
+}
+
+func (s *Server) UpdateCar(c echo.Context, carUid openapi_types.UUID) error {
+	var req openapi.CarRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	car, err := s.cars.Update(c.Request().Context(), carUid, toCarsServiceCarRequest(req))
+	if err != nil {
+		return processError(c, err, "update car")
+	}
+
+	return c.JSON(http.StatusOK, car)
+}
+
+func (s *Server) ArchiveCar(c echo.Context, carUid openapi_types.UUID) error {
+	car, err := s.cars.Archive(c.Request().Context(), carUid)
+	if err != nil {
+		return processError(c, err, "archive car")
+	}
+
+	return c.JSON(http.StatusOK, car)
+}
+
+func (s *Server) RestoreCar(c echo.Context, carUid openapi_types.UUID) error {
+	car, err := s.cars.Restore(c.Request().Context(), carUid)
+	if err != nil {
+		return processError(c, err, "restore car")
+	}
+
+	return c.JSON(http.StatusOK, car)
+}
+
+func (s *Server) CreatePromoCode(c echo.Context) error {
+	var req openapi.PromoCodeRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	promo, err := s.payment.CreatePromoCode(c.Request().Context(), toPaymentServicePromoCodeRequest(req))
+	if err != nil {
+		return processError(c, err, "create promo code")
+	}
+
+	return c.JSON(http.StatusCreated, promo)
+}
+
+func (s *Server) ListExchangeRates(c echo.Context) error {
+	rates, err := s.payment.ListExchangeRates(c.Request().Context())
+	if err != nil {
+		return processError(c, err, "list exchange rates")
+	}
+
+	return c.JSON(http.StatusOK, rates)
+}
+
+func (s *Server) SetExchangeRate(c echo.Context, currency string) error {
+	var req openapi.ExchangeRateRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	rate, err := s.payment.SetExchangeRate(c.Request().Context(), currency, payment_service.ExchangeRateRequest(req))
+	if err != nil {
+		return processError(c, err, "set exchange rate")
+	}
+
+	return c.JSON(http.StatusOK, rate)
+}
+
+func (s *Server) ListPromoCodes(c echo.Context) error {
+	promos, err := s.payment.ListPromoCodes(c.Request().Context())
+	if err != nil {
+		return processError(c, err, "list promo codes")
+	}
+
+	return c.JSON(http.StatusOK, promos)
 }
 
 func (s *Server) Live(c echo.Context) error {
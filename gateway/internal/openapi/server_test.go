@@ -0,0 +1,44 @@
+package openapi
+
+import (
+	"testing"
+
+	payment_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/payment-service"
+	rental_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/rental-service"
+	"github.com/samber/lo"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestShorteningRefund(t *testing.T) {
+	tests := []struct {
+		name    string
+		payment payment_service.PaymentInfo
+		change  rental_service.RentalChange
+		want    int
+	}{
+		{
+			name:    "authorized payment",
+			payment: payment_service.PaymentInfo{Price: 8100},
+			change:  rental_service.RentalChange{PreviousPrice: 9000, Price: 3000, Difference: -6000},
+			want:    5400,
+		},
+		{
+			name:    "captured payment",
+			payment: payment_service.PaymentInfo{Price: 9000, Captured: lo.ToPtr(6000)},
+			change:  rental_service.RentalChange{PreviousPrice: 6000, Price: 3000, Difference: -3000},
+			want:    3000,
+		},
+		{
+			name:    "free rental",
+			payment: payment_service.PaymentInfo{Price: 0},
+			change:  rental_service.RentalChange{PreviousPrice: 0, Price: 0, Difference: 0},
+			want:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, shorteningRefund(&tt.payment, &tt.change))
+		})
+	}
+}
@@ -0,0 +1,431 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrUnknownEventVersion = errors.New("unknown event version")
+	ErrMalformedEvent      = errors.New("malformed event")
+)
+
+// Projection is the read model of the user rentals view built from the domain events of the services.
+// Documents are kept in postgres and shared by all gateway replicas, which consume events in one group.
+// Documents of a user are loaded from the services once, later changes of the user rentals come with
+// the events. Events are ordered by their time per document, services are expected to have synchronized clocks.
+type Projection struct {
+	documents documents
+	logger    *zap.SugaredLogger
+
+	mu      sync.RWMutex
+	lag     int64
+	tracked bool
+	maxLag  int64
+}
+
+// New creates the projection which serves reads while the consumer group lags behind
+// each partition by no more than maxLag messages.
+func New(documents documents, maxLag int64, logger *zap.SugaredLogger) *Projection {
+	return &Projection{
+		documents: documents,
+		logger:    logger,
+		maxLag:    maxLag,
+	}
+}
+
+// Apply updates documents by the event. Events older than the document are skipped, so redelivered
+// events and events already seen by the services on load don't roll documents back.
+func (p *Projection) Apply(ctx context.Context, event models.DomainEvent) error {
+	if event.Version != models.DomainEventVersion {
+		return fmt.Errorf("apply %s v%d: %w", event.Type, event.Version, ErrUnknownEventVersion)
+	}
+
+	marker := models.ProjectionMarker{
+		EventID:    event.ID,
+		OccurredAt: event.OccurredAt,
+	}
+
+	var err error
+	switch event.Type {
+	case models.CarBookedDomainEvent, models.CarUnbookedDomainEvent, models.CarUpdatedDomainEvent:
+		err = p.applyCar(ctx, event, marker)
+	case models.RentalCreatedDomainEvent, models.RentalStartedDomainEvent, models.RentalOverdueDomainEvent,
+		models.RentalFinishedDomainEvent, models.RentalCanceledDomainEvent, models.RentalDatesChangedDomainEvent:
+		err = p.applyRental(ctx, event, marker)
+	case models.PaymentCreatedDomainEvent, models.PaymentAuthorizedDomainEvent, models.PaymentCapturedDomainEvent,
+		models.PaymentFailedDomainEvent, models.PaymentCanceledDomainEvent, models.PaymentRefundedDomainEvent:
+		err = p.applyPayment(ctx, event, marker)
+	}
+	if err != nil {
+		return fmt.Errorf("apply %s: %w", event.Type, err)
+	}
+
+	return nil
+}
+
+func (p *Projection) applyCar(ctx context.Context, event models.DomainEvent, marker models.ProjectionMarker) error {
+	var data models.CarEventData
+	err := json.Unmarshal(event.Data, &data)
+	if err != nil {
+		return fmt.Errorf("unmarshal car: %w: %w", ErrMalformedEvent, err)
+	}
+
+	return p.documents.PutCar(ctx, models.ProjectedCar{
+		CarUUID:            data.CarUID,
+		Brand:              data.Brand,
+		Model:              data.Model,
+		RegistrationNumber: data.RegistrationNumber,
+		UpdatedAt:          event.OccurredAt,
+	}, marker)
+}
+
+func (p *Projection) applyRental(ctx context.Context, event models.DomainEvent, marker models.ProjectionMarker) error {
+	var data models.RentalEventData
+	err := json.Unmarshal(event.Data, &data)
+	if err != nil {
+		return fmt.Errorf("unmarshal rental: %w: %w", ErrMalformedEvent, err)
+	}
+
+	return p.documents.PutRental(ctx, models.ProjectedRental{
+		RentalUUID:  data.RentalUID,
+		Username:    data.Username,
+		CarUUID:     data.CarUID,
+		PaymentUUID: data.PaymentUID,
+		DateFrom:    data.DateFrom,
+		DateTo:      data.DateTo,
+		Status:      data.Status,
+		UpdatedAt:   event.OccurredAt,
+	}, marker)
+}
+
+func (p *Projection) applyPayment(ctx context.Context, event models.DomainEvent, marker models.ProjectionMarker) error {
+	var data models.PaymentEventData
+	err := json.Unmarshal(event.Data, &data)
+	if err != nil {
+		return fmt.Errorf("unmarshal payment: %w: %w", ErrMalformedEvent, err)
+	}
+
+	info := openapi.PaymentInfo{
+		Captured:      lo.EmptyableToPtr(data.Captured),
+		Currency:      lo.EmptyableToPtr(data.Currency),
+		Discount:      lo.EmptyableToPtr(data.Discount),
+		FailureReason: lo.EmptyableToPtr(data.FailureReason),
+		Kind:          lo.EmptyableToPtr(openapi.PaymentInfoKind(data.Kind)),
+		PaymentUid:    data.PaymentUID,
+		Price:         data.Price,
+		PromoCode:     lo.EmptyableToPtr(data.PromoCode),
+		Refunded:      lo.EmptyableToPtr(data.Refunded),
+		Status:        openapi.PaymentInfoStatus(data.Status),
+	}
+
+	if len(data.Taxes) > 0 {
+		info.Taxes = lo.ToPtr(lo.Map(data.Taxes, func(tax models.EventTax, _ int) openapi.Tax {
+			return openapi.Tax{
+				Amount:       tax.Amount,
+				Jurisdiction: tax.Jurisdiction,
+				Mode:         openapi.TaxMode(tax.Mode),
+				Name:         tax.Name,
+				Percent:      float32(tax.Percent),
+			}
+		}))
+	}
+
+	payment, err := toProjectedPayment(info, event.OccurredAt)
+	if err != nil {
+		return err
+	}
+
+	return p.documents.PutPayment(ctx, payment, marker)
+}
+
+// Seed loads the documents of the user received from the services. Documents changed by events
+// which occurred after the load had started are kept. Cars and payments which services didn't return
+// are left unknown, so reads of the user fall back to the services until events bring them.
+func (p *Projection) Seed(ctx context.Context, username string, rentals []openapi.RentalResponse, startedAt time.Time) error {
+	views := make([]models.ProjectedView, 0, len(rentals))
+	for _, response := range rentals {
+		dateFrom, err := time.Parse(time.DateOnly, response.DateFrom)
+		if err != nil {
+			return fmt.Errorf("parse date from of %s: %w", response.RentalUid, err)
+		}
+
+		dateTo, err := time.Parse(time.DateOnly, response.DateTo)
+		if err != nil {
+			return fmt.Errorf("parse date to of %s: %w", response.RentalUid, err)
+		}
+
+		view := models.ProjectedView{
+			Rental: models.ProjectedRental{
+				RentalUUID:  response.RentalUid,
+				Username:    username,
+				CarUUID:     response.Car.CarUid,
+				PaymentUUID: response.Payment.PaymentUid,
+				DateFrom:    dateFrom,
+				DateTo:      dateTo,
+				Status:      string(response.Status),
+				UpdatedAt:   startedAt,
+			},
+		}
+
+		if response.Car.Brand != "" {
+			view.Car = &models.ProjectedCar{
+				CarUUID:            response.Car.CarUid,
+				Brand:              response.Car.Brand,
+				Model:              response.Car.Model,
+				RegistrationNumber: response.Car.RegistrationNumber,
+				UpdatedAt:          startedAt,
+			}
+		}
+
+		if response.Payment.Currency != nil {
+			info := response.Payment
+			info.DisplayPrice = nil
+
+			payment, err := toProjectedPayment(info, startedAt)
+			if err != nil {
+				return err
+			}
+
+			view.Payment = &payment
+		}
+
+		views = append(views, view)
+	}
+
+	err := p.documents.Seed(ctx, username, views, startedAt)
+	if err != nil {
+		return fmt.Errorf("seed documents of %s: %w", username, err)
+	}
+
+	return nil
+}
+
+// Seeded reports whether documents of the user were loaded from the services.
+func (p *Projection) Seeded(ctx context.Context, username string) (bool, error) {
+	seeded, err := p.documents.Seeded(ctx, username)
+	if err != nil {
+		return false, fmt.Errorf("check documents of %s: %w", username, err)
+	}
+
+	return seeded, nil
+}
+
+// Track saves the largest lag of the consumer group behind the partitions of the topics.
+func (p *Projection) Track(lag int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lag = lag
+	p.tracked = true
+}
+
+// Revoke marks the projection as falling behind until the lag is tracked again.
+func (p *Projection) Revoke() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tracked = false
+}
+
+// CaughtUp reports whether the projection is consumed and doesn't lag behind the services.
+func (p *Projection) CaughtUp() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.tracked && p.lag <= p.maxLag
+}
+
+// Filter selects a page of the user rentals like rental service does.
+// Ties of the start dates are ordered by the rental uid.
+type Filter struct {
+	Statuses []openapi.RentalResponseStatus
+	DateFrom string
+	DateTo   string
+	Asc      bool
+	Page     int
+	Size     int
+}
+
+func (f Filter) toModel() (models.ProjectionFilter, error) {
+	filter := models.ProjectionFilter{
+		Statuses: lo.Map(f.Statuses, func(status openapi.RentalResponseStatus, _ int) string {
+			return string(status)
+		}),
+		Asc:  f.Asc,
+		Page: f.Page,
+		Size: f.Size,
+	}
+
+	if f.DateFrom != "" {
+		dateFrom, err := time.Parse(time.DateOnly, f.DateFrom)
+		if err != nil {
+			return filter, fmt.Errorf("parse date from: %w", err)
+		}
+		filter.DateFrom = &dateFrom
+	}
+
+	if f.DateTo != "" {
+		dateTo, err := time.Parse(time.DateOnly, f.DateTo)
+		if err != nil {
+			return filter, fmt.Errorf("parse date to: %w", err)
+		}
+		filter.DateTo = &dateTo
+	}
+
+	return filter, nil
+}
+
+// Rentals returns a page of the user rentals with the total number of rentals matching the filter.
+// False is returned if the projection can't serve the user, then rentals are read from the services.
+func (p *Projection) Rentals(ctx context.Context, username string, filter Filter) ([]openapi.RentalResponse, int, models.ProjectionMarker, bool) {
+	if !p.serves(ctx, username) {
+		return nil, 0, models.ProjectionMarker{}, false
+	}
+
+	modelFilter, err := filter.toModel()
+	if err != nil {
+		p.logger.Errorw("cannot read projected rentals", "error", err)
+		return nil, 0, models.ProjectionMarker{}, false
+	}
+
+	views, total, err := p.documents.List(ctx, username, modelFilter)
+	if err != nil {
+		p.logger.Errorw("cannot read projected rentals", "error", err)
+		return nil, 0, models.ProjectionMarker{}, false
+	}
+
+	result := make([]openapi.RentalResponse, 0, len(views))
+	for _, view := range views {
+		response, ok := p.join(view)
+		if !ok {
+			return nil, 0, models.ProjectionMarker{}, false
+		}
+
+		result = append(result, response)
+	}
+
+	marker, ok := p.marker(ctx)
+	if !ok {
+		return nil, 0, models.ProjectionMarker{}, false
+	}
+
+	return result, total, marker, true
+}
+
+// Rental returns the rental of the user. False is returned if the projection can't serve the user
+// or doesn't know the rental, then it is read from the services.
+func (p *Projection) Rental(ctx context.Context, username string, uid uuid.UUID) (openapi.RentalResponse, models.ProjectionMarker, bool) {
+	if !p.serves(ctx, username) {
+		return openapi.RentalResponse{}, models.ProjectionMarker{}, false
+	}
+
+	view, err := p.documents.Get(ctx, username, uid)
+	if err != nil {
+		if !errors.Is(err, models.ErrProjectedRentalNotFound) {
+			p.logger.Errorw("cannot read projected rental", "rental", uid, "error", err)
+		}
+		return openapi.RentalResponse{}, models.ProjectionMarker{}, false
+	}
+
+	response, ok := p.join(*view)
+	if !ok {
+		return openapi.RentalResponse{}, models.ProjectionMarker{}, false
+	}
+
+	marker, ok := p.marker(ctx)
+	if !ok {
+		return openapi.RentalResponse{}, models.ProjectionMarker{}, false
+	}
+
+	return response, marker, true
+}
+
+// serves reports whether the projection is caught up and the documents of the user are loaded.
+func (p *Projection) serves(ctx context.Context, username string) bool {
+	if !p.CaughtUp() {
+		return false
+	}
+
+	seeded, err := p.Seeded(ctx, username)
+	if err != nil {
+		p.logger.Errorw("cannot read projected rentals", "error", err)
+		return false
+	}
+
+	return seeded
+}
+
+// join adds the car and the payment to the rental, false is returned if any of them is unknown.
+func (p *Projection) join(view models.ProjectedView) (openapi.RentalResponse, bool) {
+	if view.Car == nil || view.Payment == nil {
+		return openapi.RentalResponse{}, false
+	}
+
+	var payment openapi.PaymentInfo
+	err := json.Unmarshal(view.Payment.Info, &payment)
+	if err != nil {
+		p.logger.Errorw("unmarshal projected payment", "payment", view.Payment.PaymentUUID, "error", err)
+		return openapi.RentalResponse{}, false
+	}
+
+	return openapi.RentalResponse{
+		Car: openapi.CarInfo{
+			Brand:              view.Car.Brand,
+			CarUid:             view.Car.CarUUID,
+			Model:              view.Car.Model,
+			RegistrationNumber: view.Car.RegistrationNumber,
+		},
+		DateFrom:  view.Rental.DateFrom.Format(time.DateOnly),
+		DateTo:    view.Rental.DateTo.Format(time.DateOnly),
+		Payment:   payment,
+		RentalUid: view.Rental.RentalUUID,
+		Status:    openapi.RentalResponseStatus(view.Rental.Status),
+	}, true
+}
+
+func (p *Projection) marker(ctx context.Context) (models.ProjectionMarker, bool) {
+	marker, err := p.documents.Marker(ctx)
+	if err != nil {
+		p.logger.Errorw("cannot read projection marker", "error", err)
+		return models.ProjectionMarker{}, false
+	}
+
+	return marker, true
+}
+
+func toProjectedPayment(info openapi.PaymentInfo, updatedAt time.Time) (models.ProjectedPayment, error) {
+	document, err := json.Marshal(info)
+	if err != nil {
+		return models.ProjectedPayment{}, fmt.Errorf("marshal payment %s: %w", info.PaymentUid, err)
+	}
+
+	return models.ProjectedPayment{
+		PaymentUUID: info.PaymentUid,
+		Info:        document,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
+
+//go:generate mockery --all --with-expecter --exported --output mocks/
+
+type documents interface {
+	PutCar(ctx context.Context, car models.ProjectedCar, marker models.ProjectionMarker) error
+	PutRental(ctx context.Context, rental models.ProjectedRental, marker models.ProjectionMarker) error
+	PutPayment(ctx context.Context, payment models.ProjectedPayment, marker models.ProjectionMarker) error
+	Seed(ctx context.Context, username string, views []models.ProjectedView, startedAt time.Time) error
+	Seeded(ctx context.Context, username string) (bool, error)
+	List(ctx context.Context, username string, filter models.ProjectionFilter) ([]models.ProjectedView, int, error)
+	Get(ctx context.Context, username string, rentalUUID uuid.UUID) (*models.ProjectedView, error)
+	Marker(ctx context.Context) (models.ProjectionMarker, error)
+}
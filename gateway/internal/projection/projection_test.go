@@ -0,0 +1,300 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/projection/mocks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestProjection_Apply(t *testing.T) {
+	rentalUID := uuid.MustParse("62ffa6a3-c09b-4c61-a70a-f4f1e5f5c8e4")
+	carUID := uuid.MustParse("1bda4472-e536-4d74-b1e0-8f027aebf972")
+	paymentUID := uuid.MustParse("3e1f0e47-52b2-4d0b-8fc1-9b0d4cbd0f2a")
+	occurredAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	event := func(eventType models.DomainEventType, data any) models.DomainEvent {
+		payload, err := json.Marshal(data)
+		require.NoError(t, err)
+
+		return models.DomainEvent{
+			ID:         uuid.New(),
+			Type:       eventType,
+			Version:    models.DomainEventVersion,
+			OccurredAt: occurredAt,
+			Data:       payload,
+		}
+	}
+
+	marker := func(event models.DomainEvent) models.ProjectionMarker {
+		return models.ProjectionMarker{EventID: event.ID, OccurredAt: event.OccurredAt}
+	}
+
+	t.Run("rental applied", func(t *testing.T) {
+		ctx := context.Background()
+
+		started := event(models.RentalStartedDomainEvent, models.RentalEventData{
+			RentalUID:  rentalUID,
+			Username:   "user",
+			CarUID:     carUID,
+			PaymentUID: paymentUID,
+			DateFrom:   time.Date(2024, 11, 21, 0, 0, 0, 0, time.UTC),
+			DateTo:     time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC),
+			Status:     "IN_PROGRESS",
+		})
+
+		documents := mocks.NewDocuments(t)
+		documents.EXPECT().PutRental(ctx, models.ProjectedRental{
+			RentalUUID:  rentalUID,
+			Username:    "user",
+			CarUUID:     carUID,
+			PaymentUUID: paymentUID,
+			DateFrom:    time.Date(2024, 11, 21, 0, 0, 0, 0, time.UTC),
+			DateTo:      time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC),
+			Status:      "IN_PROGRESS",
+			UpdatedAt:   occurredAt,
+		}, marker(started)).Return(nil)
+
+		p := New(documents, 0, zap.NewNop().Sugar())
+		require.NoError(t, p.Apply(ctx, started))
+	})
+
+	t.Run("payment applied", func(t *testing.T) {
+		ctx := context.Background()
+
+		authorized := event(models.PaymentAuthorizedDomainEvent, models.PaymentEventData{
+			PaymentUID: paymentUID,
+			Kind:       "RENTAL",
+			Status:     "AUTHORIZED",
+			Price:      4000,
+			Currency:   "RUB",
+		})
+
+		info, err := json.Marshal(openapi.PaymentInfo{
+			Currency:   lo.ToPtr("RUB"),
+			Kind:       lo.ToPtr(openapi.PaymentInfoKindRENTAL),
+			PaymentUid: paymentUID,
+			Price:      4000,
+			Status:     openapi.PaymentInfoStatusAUTHORIZED,
+		})
+		require.NoError(t, err)
+
+		documents := mocks.NewDocuments(t)
+		documents.EXPECT().PutPayment(ctx, models.ProjectedPayment{
+			PaymentUUID: paymentUID,
+			Info:        info,
+			UpdatedAt:   occurredAt,
+		}, marker(authorized)).Return(nil)
+
+		p := New(documents, 0, zap.NewNop().Sugar())
+		require.NoError(t, p.Apply(ctx, authorized))
+	})
+
+	t.Run("unknown version", func(t *testing.T) {
+		p := New(mocks.NewDocuments(t), 0, zap.NewNop().Sugar())
+
+		created := event(models.RentalCreatedDomainEvent, models.RentalEventData{RentalUID: rentalUID})
+		created.Version = 2
+
+		require.ErrorIs(t, p.Apply(context.Background(), created), ErrUnknownEventVersion)
+	})
+
+	t.Run("malformed event", func(t *testing.T) {
+		p := New(mocks.NewDocuments(t), 0, zap.NewNop().Sugar())
+
+		booked := event(models.CarBookedDomainEvent, nil)
+		booked.Data = json.RawMessage(`"car"`)
+
+		require.ErrorIs(t, p.Apply(context.Background(), booked), ErrMalformedEvent)
+	})
+}
+
+func TestProjection_Rentals(t *testing.T) {
+	rentalUID := uuid.MustParse("62ffa6a3-c09b-4c61-a70a-f4f1e5f5c8e4")
+	carUID := uuid.MustParse("1bda4472-e536-4d74-b1e0-8f027aebf972")
+	paymentUID := uuid.MustParse("3e1f0e47-52b2-4d0b-8fc1-9b0d4cbd0f2a")
+	occurredAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	info := openapi.PaymentInfo{
+		Currency:   lo.ToPtr("RUB"),
+		Kind:       lo.ToPtr(openapi.PaymentInfoKindRENTAL),
+		PaymentUid: paymentUID,
+		Price:      4000,
+		Status:     openapi.PaymentInfoStatusAUTHORIZED,
+	}
+
+	document, err := json.Marshal(info)
+	require.NoError(t, err)
+
+	view := models.ProjectedView{
+		Rental: models.ProjectedRental{
+			RentalUUID:  rentalUID,
+			Username:    "user",
+			CarUUID:     carUID,
+			PaymentUUID: paymentUID,
+			DateFrom:    time.Date(2024, 11, 21, 0, 0, 0, 0, time.UTC),
+			DateTo:      time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC),
+			Status:      "RESERVED",
+			UpdatedAt:   occurredAt,
+		},
+		Car: &models.ProjectedCar{
+			CarUUID:            carUID,
+			Brand:              "Mercedes Benz",
+			Model:              "GLA 250",
+			RegistrationNumber: "ЛО777Х799",
+			UpdatedAt:          occurredAt,
+		},
+		Payment: &models.ProjectedPayment{
+			PaymentUUID: paymentUID,
+			Info:        document,
+			UpdatedAt:   occurredAt,
+		},
+	}
+
+	want := openapi.RentalResponse{
+		Car: openapi.CarInfo{
+			Brand:              "Mercedes Benz",
+			CarUid:             carUID,
+			Model:              "GLA 250",
+			RegistrationNumber: "ЛО777Х799",
+		},
+		DateFrom:  "2024-11-21",
+		DateTo:    "2024-11-25",
+		Payment:   info,
+		RentalUid: rentalUID,
+		Status:    openapi.RentalResponseStatusRESERVED,
+	}
+
+	marker := models.ProjectionMarker{EventID: uuid.New(), OccurredAt: occurredAt}
+
+	t.Run("served from documents", func(t *testing.T) {
+		ctx := context.Background()
+
+		documents := mocks.NewDocuments(t)
+		documents.EXPECT().Seeded(ctx, "user").Return(true, nil)
+		documents.EXPECT().List(ctx, "user", models.ProjectionFilter{
+			Statuses: []string{"RESERVED"},
+			DateFrom: lo.ToPtr(time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)),
+			Size:     20,
+		}).Return([]models.ProjectedView{view}, 1, nil)
+		documents.EXPECT().Marker(ctx).Return(marker, nil)
+
+		p := New(documents, 0, zap.NewNop().Sugar())
+		p.Track(0)
+
+		got, total, gotMarker, ok := p.Rentals(ctx, "user", Filter{
+			Statuses: []openapi.RentalResponseStatus{openapi.RentalResponseStatusRESERVED},
+			DateFrom: "2024-11-01",
+			Size:     20,
+		})
+		require.True(t, ok)
+		assert.Equal(t, 1, total)
+		assert.Equal(t, []openapi.RentalResponse{want}, got)
+		assert.Equal(t, marker, gotMarker)
+	})
+
+	t.Run("not seeded", func(t *testing.T) {
+		ctx := context.Background()
+
+		documents := mocks.NewDocuments(t)
+		documents.EXPECT().Seeded(ctx, "user").Return(false, nil)
+
+		p := New(documents, 0, zap.NewNop().Sugar())
+		p.Track(0)
+
+		_, _, _, ok := p.Rentals(ctx, "user", Filter{Size: 20})
+		assert.Equal(t, false, ok)
+	})
+
+	t.Run("payment unknown", func(t *testing.T) {
+		ctx := context.Background()
+
+		unpaid := view
+		unpaid.Payment = nil
+
+		documents := mocks.NewDocuments(t)
+		documents.EXPECT().Seeded(ctx, "user").Return(true, nil)
+		documents.EXPECT().List(ctx, "user", mock.Anything).Return([]models.ProjectedView{unpaid}, 1, nil)
+
+		p := New(documents, 0, zap.NewNop().Sugar())
+		p.Track(0)
+
+		_, _, _, ok := p.Rentals(ctx, "user", Filter{Size: 20})
+		assert.Equal(t, false, ok)
+	})
+
+	t.Run("falls behind", func(t *testing.T) {
+		p := New(mocks.NewDocuments(t), 0, zap.NewNop().Sugar())
+		p.Track(10)
+
+		_, _, _, ok := p.Rentals(context.Background(), "user", Filter{Size: 20})
+		assert.Equal(t, false, ok)
+	})
+
+	t.Run("lag unknown", func(t *testing.T) {
+		p := New(mocks.NewDocuments(t), 0, zap.NewNop().Sugar())
+
+		_, _, _, ok := p.Rentals(context.Background(), "user", Filter{Size: 20})
+		assert.Equal(t, false, ok)
+	})
+
+	t.Run("rental of another user", func(t *testing.T) {
+		ctx := context.Background()
+
+		documents := mocks.NewDocuments(t)
+		documents.EXPECT().Seeded(ctx, "user").Return(true, nil)
+		documents.EXPECT().Get(ctx, "user", rentalUID).Return(nil, models.ErrProjectedRentalNotFound)
+
+		p := New(documents, 0, zap.NewNop().Sugar())
+		p.Track(0)
+
+		_, _, ok := p.Rental(ctx, "user", rentalUID)
+		assert.Equal(t, false, ok)
+	})
+}
+
+func TestProjection_Seed(t *testing.T) {
+	rentalUID := uuid.MustParse("62ffa6a3-c09b-4c61-a70a-f4f1e5f5c8e4")
+	carUID := uuid.MustParse("1bda4472-e536-4d74-b1e0-8f027aebf972")
+	paymentUID := uuid.MustParse("3e1f0e47-52b2-4d0b-8fc1-9b0d4cbd0f2a")
+	startedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	t.Run("unknown car and payment left out", func(t *testing.T) {
+		ctx := context.Background()
+
+		documents := mocks.NewDocuments(t)
+		documents.EXPECT().Seed(ctx, "user", []models.ProjectedView{{
+			Rental: models.ProjectedRental{
+				RentalUUID:  rentalUID,
+				Username:    "user",
+				CarUUID:     carUID,
+				PaymentUUID: paymentUID,
+				DateFrom:    time.Date(2024, 11, 21, 0, 0, 0, 0, time.UTC),
+				DateTo:      time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC),
+				Status:      "RESERVED",
+				UpdatedAt:   startedAt,
+			},
+		}}, startedAt).Return(nil)
+
+		p := New(documents, 0, zap.NewNop().Sugar())
+		err := p.Seed(ctx, "user", []openapi.RentalResponse{{
+			Car:       openapi.CarInfo{CarUid: carUID},
+			DateFrom:  "2024-11-21",
+			DateTo:    "2024-11-25",
+			Payment:   openapi.PaymentInfo{PaymentUid: paymentUID},
+			RentalUid: rentalUID,
+			Status:    openapi.RentalResponseStatusRESERVED,
+		}}, startedAt)
+		require.NoError(t, err)
+	})
+}
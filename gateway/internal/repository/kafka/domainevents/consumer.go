@@ -0,0 +1,232 @@
+package domainevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/projection"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// lagInterval is how often the lag of the group is tracked.
+const lagInterval = time.Second
+
+// Consumer feeds domain events of the services to the projection. The projection is shared by
+// the gateway replicas, so they join one group which starts from the oldest offsets and commits
+// applied events. Events aren't recorded in the inbox, applying a duplicate gives the same documents.
+type Consumer struct {
+	client   sarama.Client
+	admin    sarama.ClusterAdmin
+	consumer sarama.ConsumerGroup
+	logger   *zap.SugaredLogger
+
+	group  string
+	topics []string
+}
+
+func NewConsumer(
+	ctx context.Context,
+	projection *projection.Projection,
+	brokers []string,
+	topics []string,
+	logger *zap.SugaredLogger,
+) (*Consumer, error) {
+	sl, _ := zap.NewStdLogAt(logger.Desugar(), zapcore.WarnLevel)
+	sarama.Logger = sl
+
+	config := sarama.NewConfig()
+	config.ClientID = "car-rental-system"
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("create kafka client: %w", err)
+	}
+
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("create kafka cluster admin: %w", err)
+	}
+
+	group := config.ClientID + ".projection"
+
+	consumerGroup, err := sarama.NewConsumerGroupFromClient(group, client)
+	if err != nil {
+		admin.Close()
+		return nil, fmt.Errorf("create consumer group: %w", err)
+	}
+
+	consumer := &Consumer{
+		client:   client,
+		admin:    admin,
+		consumer: consumerGroup,
+		logger:   logger,
+		group:    group,
+		topics:   topics,
+	}
+
+	handler := &domainEventsHandler{
+		ready:      make(chan bool),
+		projection: projection,
+		logger:     logger,
+	}
+
+	go consumer.track(ctx, projection)
+
+	consumer.consume(ctx, handler)
+
+	return consumer, nil
+}
+
+func (q *Consumer) Stop() {
+	q.consumer.Close()
+	q.admin.Close()
+}
+
+func (q *Consumer) consume(ctx context.Context, handler *domainEventsHandler) {
+	go func() {
+		for {
+			if err := q.consumer.Consume(ctx, q.topics, handler); err != nil {
+				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+					return
+				}
+				continue
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			handler.ready = make(chan bool)
+		}
+	}()
+
+	q.logger.Info("waiting for domain events consumer")
+
+	<-handler.ready
+
+	q.logger.Info("domain events consumer ready")
+}
+
+// track saves the lag of the group to the projection, so every replica knows whether the projection
+// is caught up whichever partitions it consumes. The projection falls behind while the lag is unknown.
+func (q *Consumer) track(ctx context.Context, projection *projection.Projection) {
+	ticker := time.NewTicker(lagInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			lag, err := q.lag()
+			if err != nil {
+				q.logger.Warnw("cannot get lag of domain events consumer", "error", err)
+				projection.Revoke()
+				continue
+			}
+
+			projection.Track(lag)
+		}
+	}
+}
+
+// lag returns the largest number of messages of a partition not yet committed by the group. All retained
+// messages of the partition are counted if the group hasn't committed anything there.
+func (q *Consumer) lag() (int64, error) {
+	var lag int64
+	for _, topic := range q.topics {
+		partitions, err := q.client.Partitions(topic)
+		if err != nil {
+			return 0, fmt.Errorf("get partitions of %s: %w", topic, err)
+		}
+
+		offsets, err := q.admin.ListConsumerGroupOffsets(q.group, map[string][]int32{topic: partitions})
+		if err != nil {
+			return 0, fmt.Errorf("get committed offsets of %s: %w", topic, err)
+		}
+
+		for _, partition := range partitions {
+			newest, err := q.client.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return 0, fmt.Errorf("get newest offset of %s/%d: %w", topic, partition, err)
+			}
+
+			committed := int64(-1)
+			if block := offsets.GetBlock(topic, partition); block != nil {
+				committed = block.Offset
+			}
+
+			if committed < 0 {
+				committed, err = q.client.GetOffset(topic, partition, sarama.OffsetOldest)
+				if err != nil {
+					return 0, fmt.Errorf("get oldest offset of %s/%d: %w", topic, partition, err)
+				}
+			}
+
+			lag = max(lag, newest-committed)
+		}
+	}
+
+	return lag, nil
+}
+
+type domainEventsHandler struct {
+	ready      chan bool
+	projection *projection.Projection
+	logger     *zap.SugaredLogger
+}
+
+func (h *domainEventsHandler) Setup(sarama.ConsumerGroupSession) error {
+	close(h.ready)
+	return nil
+}
+
+func (h *domainEventsHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *domainEventsHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				h.logger.Warnw("message channel was closed")
+				return nil
+			}
+
+			h.logger.Debugw("message claimed", "timestamp", message.Timestamp, "topic", message.Topic, "offset", message.Offset)
+
+			var event models.DomainEvent
+			err := json.Unmarshal(message.Value, &event)
+			if err != nil {
+				session.MarkMessage(message, "event has invalid body")
+				h.logger.Errorw("unmarshal DomainEvent", "error", err)
+				continue
+			}
+
+			err = h.projection.Apply(session.Context(), event)
+			if err != nil {
+				if !errors.Is(err, projection.ErrUnknownEventVersion) && !errors.Is(err, projection.ErrMalformedEvent) {
+					// The event isn't marked, the session is restarted and redelivers it.
+					h.logger.Errorw("cannot apply domain event", "error", err, "event", event.ID)
+					return nil
+				}
+
+				h.logger.Errorw("skipped domain event", "error", err, "event", event.ID)
+			}
+
+			session.MarkMessage(message, "applied domain event")
+
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+)
+
+const (
+	// HeaderMessageID identifies the message, copies of it sent by producer retries keep the id.
+	HeaderMessageID = "message_id"
+	// HeaderEventID identifies domain events published by the services.
+	HeaderEventID = "event_id"
+)
+
+// MessageID returns the id of the message from its headers. Messages published before
+// the ids were added are identified by their offset, so only their redeliveries are recognized.
+func MessageID(message *sarama.ConsumerMessage) uuid.UUID {
+	for _, header := range message.Headers {
+		if header == nil {
+			continue
+		}
+
+		switch string(header.Key) {
+		case HeaderMessageID, HeaderEventID:
+			id, err := uuid.ParseBytes(header.Value)
+			if err == nil {
+				return id
+			}
+		}
+	}
+
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(fmt.Sprintf("%s/%d/%d", message.Topic, message.Partition, message.Offset)))
+}
+
+// MessageHeaders returns the headers identifying a new message.
+func MessageHeaders() []sarama.RecordHeader {
+	return []sarama.RecordHeader{
+		{Key: []byte(HeaderMessageID), Value: []byte(uuid.NewString())},
+	}
+}
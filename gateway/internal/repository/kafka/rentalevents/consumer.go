@@ -0,0 +1,223 @@
+package rentalevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka/retryqueue"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/postgres"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// inboxRetryInterval is the first pause before the session is restarted when the inbox is unavailable,
+	// it doubles up to inboxMaxRetryInterval.
+	inboxRetryInterval    = time.Second
+	inboxMaxRetryInterval = time.Minute
+)
+
+// Consumer reacts to rentals changed by rental service scheduler, other domain events of rental service are skipped.
+// Car and payment are released through the retry queue, so failures are retried there.
+// Handled events are recorded in the inbox, so redelivered events don't release them again.
+type Consumer struct {
+	consumer sarama.ConsumerGroup
+	logger   *zap.SugaredLogger
+
+	topic string
+}
+
+func NewConsumer(
+	ctx context.Context,
+	retryQueue *retryqueue.RetryQueueProducer,
+	brokers []string,
+	topic string,
+	inbox *postgres.Inbox,
+	logger *zap.SugaredLogger,
+) (*Consumer, error) {
+	sl, _ := zap.NewStdLogAt(logger.Desugar(), zapcore.WarnLevel)
+	sarama.Logger = sl
+
+	config := sarama.NewConfig()
+	config.ClientID = "car-rental-system"
+
+	consumerGroup, err := sarama.NewConsumerGroup(brokers, config.ClientID, config)
+	if err != nil {
+		return nil, fmt.Errorf("create consumer group: %w", err)
+	}
+
+	consumer := &Consumer{
+		consumer: consumerGroup,
+		logger:   logger,
+		topic:    topic,
+	}
+
+	handler := &rentalEventsHandler{
+		ready:         make(chan bool),
+		retryQueue:    retryQueue,
+		inbox:         inbox,
+		retryInterval: inboxRetryInterval,
+		logger:        logger,
+	}
+
+	consumer.consume(ctx, handler)
+
+	return consumer, nil
+}
+
+func (q *Consumer) Stop() {
+	q.consumer.Close()
+}
+
+func (q *Consumer) consume(ctx context.Context, handler *rentalEventsHandler) {
+	go func() {
+		for {
+			if err := q.consumer.Consume(ctx, []string{q.topic}, handler); err != nil {
+				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+					return
+				}
+				continue
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			handler.ready = make(chan bool)
+		}
+	}()
+
+	q.logger.Info("waiting for rental events consumer")
+
+	<-handler.ready
+
+	q.logger.Info("rental events consumer ready")
+}
+
+// retryQueue releases the car and settles the payment of a rental, it is implemented by retryqueue.RetryQueueProducer.
+type retryQueue interface {
+	RetryCarUnbook(carUid uuid.UUID)
+	RetryPaymentCancel(paymentUid uuid.UUID)
+	RetryPaymentCapture(paymentUid, rentalUid uuid.UUID)
+}
+
+type rentalEventsHandler struct {
+	ready      chan bool
+	retryQueue retryQueue
+	inbox      *postgres.Inbox
+	// retryInterval is the pause before the session is restarted after the inbox failed.
+	retryInterval time.Duration
+	logger        *zap.SugaredLogger
+}
+
+func (h *rentalEventsHandler) Setup(sarama.ConsumerGroupSession) error {
+	close(h.ready)
+	return nil
+}
+
+func (h *rentalEventsHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *rentalEventsHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				h.logger.Warnw("message channel was closed")
+				return nil
+			}
+
+			h.logger.Infow("message claimed", "value", string(message.Value), "timestamp", message.Timestamp, "topic", message.Topic)
+
+			var event models.DomainEvent
+			err := json.Unmarshal(message.Value, &event)
+			if err != nil {
+				session.MarkMessage(message, "msg has invalid body")
+				h.logger.Errorw("unmarshal DomainEvent", "error", err)
+				continue
+			}
+
+			if event.Type != models.RentalNoShowDomainEvent && event.Type != models.RentalOverdueDomainEvent {
+				session.MarkMessage(message, "skipped rental event")
+				continue
+			}
+
+			var data models.RentalEventData
+			if event.Version == models.DomainEventVersion {
+				err = json.Unmarshal(event.Data, &data)
+			} else {
+				err = fmt.Errorf("unknown version %d", event.Version)
+			}
+			if err != nil {
+				session.MarkMessage(message, "event has invalid data")
+				h.logger.Errorw("unmarshal RentalEventData", "event", event.ID, "type", event.Type, "error", err)
+				continue
+			}
+
+			handled, err := h.inbox.Process(session.Context(), models.RentalEventsConsumer, message.Topic, kafka.MessageID(message),
+				func(context.Context) error {
+					h.handle(event.Type, data)
+					return nil
+				})
+			if err != nil && handled {
+				// The event is handled already, a redelivered duplicate is handled again by the idempotent handler.
+				h.logger.Errorw("cannot record rental event in inbox", "rental", data.RentalUID, "error", err)
+			} else if err != nil {
+				// The event isn't marked, the session is restarted after the pause and redelivers it.
+				h.logger.Errorw("cannot check rental event in inbox", "rental", data.RentalUID, "error", err, "interval", h.retryInterval)
+
+				select {
+				case <-session.Context().Done():
+				case <-time.After(h.retryInterval):
+				}
+
+				h.retryInterval = min(h.retryInterval*2, inboxMaxRetryInterval)
+
+				return nil
+			}
+
+			h.retryInterval = inboxRetryInterval
+			if !handled {
+				h.logger.Infow("skipped duplicate rental event", "rental", data.RentalUID, "type", event.Type)
+			}
+
+			session.MarkMessage(message, "got rental event")
+
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+// handle releases the car of a canceled no-show rental. The payment is returned by the "cancel" policy
+// and captured by the "forfeit" one, the "keep" policy leaves the rental reserved.
+func (h *rentalEventsHandler) handle(eventType models.DomainEventType, data models.RentalEventData) {
+	switch eventType {
+	case models.RentalNoShowDomainEvent:
+		switch data.NoShowPolicy {
+		case models.NoShowCancelPolicy:
+			h.retryQueue.RetryCarUnbook(data.CarUID)
+			h.retryQueue.RetryPaymentCancel(data.PaymentUID)
+		case models.NoShowForfeitPolicy:
+			h.retryQueue.RetryCarUnbook(data.CarUID)
+			h.retryQueue.RetryPaymentCapture(data.PaymentUID, data.RentalUID)
+		default:
+			h.logger.Infow("kept no-show rental", "rental", data.RentalUID, "policy", data.NoShowPolicy)
+			return
+		}
+
+		h.logger.Infow("released no-show rental", "rental", data.RentalUID, "policy", data.NoShowPolicy)
+	case models.RentalOverdueDomainEvent:
+		h.logger.Infow("rental is overdue", "rental", data.RentalUID, "dateTo", data.DateTo)
+	default:
+		h.logger.Warnw("unknown rental event", "type", eventType)
+	}
+}
@@ -0,0 +1,108 @@
+package rentalevents
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type retryQueueStub struct {
+	unbooked []uuid.UUID
+	canceled []uuid.UUID
+	captured map[uuid.UUID]uuid.UUID
+}
+
+func (q *retryQueueStub) RetryCarUnbook(carUid uuid.UUID) {
+	q.unbooked = append(q.unbooked, carUid)
+}
+
+func (q *retryQueueStub) RetryPaymentCancel(paymentUid uuid.UUID) {
+	q.canceled = append(q.canceled, paymentUid)
+}
+
+func (q *retryQueueStub) RetryPaymentCapture(paymentUid, rentalUid uuid.UUID) {
+	if q.captured == nil {
+		q.captured = make(map[uuid.UUID]uuid.UUID)
+	}
+	q.captured[paymentUid] = rentalUid
+}
+
+func TestRentalEventsHandler_Handle(t *testing.T) {
+	rentalUID := uuid.MustParse("62ffa6a3-c09b-4c61-a70a-f4f1e5f5c8e4")
+	carUID := uuid.MustParse("1bda4472-e536-4d74-b1e0-8f027aebf972")
+	paymentUID := uuid.MustParse("3e1f0e47-52b2-4d0b-8fc1-9b0d4cbd0f2a")
+
+	tests := []struct {
+		name      string
+		eventType models.DomainEventType
+		data      models.RentalEventData
+		expected  retryQueueStub
+	}{
+		{
+			name:      "no-show keep",
+			eventType: models.RentalNoShowDomainEvent,
+			data: models.RentalEventData{
+				RentalUID:    rentalUID,
+				CarUID:       carUID,
+				PaymentUID:   paymentUID,
+				NoShowPolicy: models.NoShowKeepPolicy,
+			},
+			expected: retryQueueStub{},
+		},
+		{
+			name:      "no-show cancel",
+			eventType: models.RentalNoShowDomainEvent,
+			data: models.RentalEventData{
+				RentalUID:    rentalUID,
+				CarUID:       carUID,
+				PaymentUID:   paymentUID,
+				NoShowPolicy: models.NoShowCancelPolicy,
+			},
+			expected: retryQueueStub{
+				unbooked: []uuid.UUID{carUID},
+				canceled: []uuid.UUID{paymentUID},
+			},
+		},
+		{
+			name:      "no-show forfeit",
+			eventType: models.RentalNoShowDomainEvent,
+			data: models.RentalEventData{
+				RentalUID:    rentalUID,
+				CarUID:       carUID,
+				PaymentUID:   paymentUID,
+				NoShowPolicy: models.NoShowForfeitPolicy,
+			},
+			expected: retryQueueStub{
+				unbooked: []uuid.UUID{carUID},
+				captured: map[uuid.UUID]uuid.UUID{paymentUID: rentalUID},
+			},
+		},
+		{
+			name:      "overdue",
+			eventType: models.RentalOverdueDomainEvent,
+			data: models.RentalEventData{
+				RentalUID:  rentalUID,
+				CarUID:     carUID,
+				PaymentUID: paymentUID,
+			},
+			expected: retryQueueStub{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &retryQueueStub{}
+			h := &rentalEventsHandler{
+				retryQueue: queue,
+				logger:     zap.NewNop().Sugar(),
+			}
+
+			h.handle(tt.eventType, tt.data)
+
+			assert.Equal(t, tt.expected, *queue)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package retryqueue
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Backlog reports how far the retry consumers lag behind the retry topics.
+type Backlog struct {
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+	group  string
+
+	topics map[string][]models.RetryCommandType
+}
+
+func NewBacklog(
+	brokers []string,
+	carUnbookTopic string,
+	paymentTopic string,
+	logger *zap.SugaredLogger,
+) (*Backlog, error) {
+	sl, _ := zap.NewStdLogAt(logger.Desugar(), zapcore.WarnLevel)
+	sarama.Logger = sl
+
+	config := sarama.NewConfig()
+	config.ClientID = "car-rental-system"
+
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("create kafka client: %w", err)
+	}
+
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("create kafka cluster admin: %w", err)
+	}
+
+	return &Backlog{
+		client: client,
+		admin:  admin,
+		group:  config.ClientID,
+		topics: map[string][]models.RetryCommandType{
+			carUnbookTopic: {models.CarUnbookCommand},
+			paymentTopic:   {models.PaymentCancelCommand, models.PaymentCaptureCommand, models.PaymentChargeCommand},
+		},
+	}, nil
+}
+
+func (b *Backlog) Stop() {
+	b.admin.Close()
+}
+
+// Lags returns the messages of each retry topic not yet committed by the consumers. All retained messages
+// of the partition are counted if the consumers haven't committed anything there.
+func (b *Backlog) Lags() ([]models.RetryTopicLag, error) {
+	lags := make([]models.RetryTopicLag, 0, len(b.topics))
+	for topic, commandTypes := range b.topics {
+		partitions, err := b.client.Partitions(topic)
+		if err != nil {
+			return nil, fmt.Errorf("get partitions of %s: %w", topic, err)
+		}
+
+		offsets, err := b.admin.ListConsumerGroupOffsets(b.group, map[string][]int32{topic: partitions})
+		if err != nil {
+			return nil, fmt.Errorf("get committed offsets of %s: %w", topic, err)
+		}
+
+		lag := models.RetryTopicLag{
+			Topic:      topic,
+			Types:      commandTypes,
+			Partitions: len(partitions),
+		}
+
+		for _, partition := range partitions {
+			newest, err := b.client.GetOffset(topic, partition, sarama.OffsetNewest)
+			if err != nil {
+				return nil, fmt.Errorf("get newest offset of %s/%d: %w", topic, partition, err)
+			}
+
+			committed := int64(-1)
+			if block := offsets.GetBlock(topic, partition); block != nil {
+				committed = block.Offset
+			}
+
+			if committed < 0 {
+				committed, err = b.client.GetOffset(topic, partition, sarama.OffsetOldest)
+				if err != nil {
+					return nil, fmt.Errorf("get oldest offset of %s/%d: %w", topic, partition, err)
+				}
+			}
+
+			lag.Lag += max(newest-committed, 0)
+		}
+
+		lags = append(lags, lag)
+	}
+
+	slices.SortFunc(lags, func(a, b models.RetryTopicLag) int {
+		return strings.Compare(a.Topic, b.Topic)
+	})
+
+	return lags, nil
+}
@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/google/uuid"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/clients"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/postgres"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -27,6 +30,9 @@ func NewCarsRetryQueueConsumer(
 	brokers []string,
 	topic string,
 	cars *clients.CarsServiceClient,
+	inbox *postgres.Inbox,
+	commands *postgres.RetryCommands,
+	maxAttempts int,
 	logger *zap.SugaredLogger,
 ) (*CarsRetryQueueConsumer, error) {
 	sl, _ := zap.NewStdLogAt(logger.Desugar(), zapcore.WarnLevel)
@@ -47,10 +53,13 @@ func NewCarsRetryQueueConsumer(
 	}
 
 	carUnbookConsumer := &carUnbookConsumer{
-		ready:    make(chan bool),
-		cars:     cars,
-		producer: producer,
-		logger:   logger,
+		ready:       make(chan bool),
+		cars:        cars,
+		producer:    producer,
+		inbox:       inbox,
+		commands:    commands,
+		maxAttempts: maxAttempts,
+		logger:      logger,
 	}
 
 	consumer.retryCarUnbook(ctx, carUnbookConsumer)
@@ -62,11 +71,18 @@ func (q *CarsRetryQueueConsumer) Stop() {
 	q.consumer.Close()
 }
 
+// carUnbookConsumer retries releasing cars. Handled messages are recorded in the inbox,
+// so a message redelivered after a rebalance doesn't release the car again. Attempts are recorded
+// in the retry commands, a command is dead-lettered instead of requeued after maxAttempts.
 type carUnbookConsumer struct {
 	ready    chan bool
 	producer *RetryQueueProducer
 	cars     *clients.CarsServiceClient
+	inbox    *postgres.Inbox
+	commands *postgres.RetryCommands
 	logger   *zap.SugaredLogger
+
+	maxAttempts int
 }
 
 func (q *CarsRetryQueueConsumer) retryCarUnbook(ctx context.Context, consumer *carUnbookConsumer) {
@@ -122,18 +138,32 @@ func (c *carUnbookConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, cl
 				continue
 			}
 
+			if carUnbookRetryMsg.CommandUid == uuid.Nil {
+				carUnbookRetryMsg.CommandUid = kafka.MessageID(message)
+			}
+
 			for time.Now().Sub(message.Timestamp) < time.Second*10 {
 			}
 
-			err = c.cars.RetryUnbook(session.Context(), carUnbookRetryMsg.CarUid)
-			if err != nil {
-				c.logger.Warnw("cannot cancel car book", "car", carUnbookRetryMsg.CarUid, "error", err)
-				session.MarkMessage(message, "retry cancel car book")
-				c.producer.RetryCarUnbook(carUnbookRetryMsg.CarUid)
+			handled, err := c.inbox.Process(session.Context(), models.CarsRetryConsumer, message.Topic, kafka.MessageID(message),
+				func(ctx context.Context) error {
+					c.unbook(ctx, message.Topic, carUnbookRetryMsg)
+					return nil
+				})
+			if err != nil && handled {
+				// The message is handled already, a redelivered duplicate is handled again by the idempotent handler.
+				c.logger.Errorw("cannot record car unbook in inbox", "car", carUnbookRetryMsg.CarUid, "error", err)
+			} else if err != nil {
+				c.logger.Errorw("cannot check car unbook in inbox", "car", carUnbookRetryMsg.CarUid, "error", err)
+				session.MarkMessage(message, "retry inbox")
+				c.producer.retryCarUnbook(carUnbookRetryMsg)
 				continue
 			}
 
-			c.logger.Infow("cancelled car book", "car", carUnbookRetryMsg.CarUid)
+			if !handled {
+				c.logger.Infow("skipped duplicate car unbook", "car", carUnbookRetryMsg.CarUid)
+			}
+
 			session.MarkMessage(message, "got msg for car unbook")
 
 		case <-session.Context().Done():
@@ -141,3 +171,55 @@ func (c *carUnbookConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, cl
 		}
 	}
 }
+
+// unbook releases the car, it is retried through the queue if cars service fails
+// until the command runs out of attempts. Discarded and done commands are skipped.
+func (c *carUnbookConsumer) unbook(ctx context.Context, topic string, msg models.CarUnbookRetryMsg) {
+	payload, _ := json.Marshal(msg)
+
+	command, err := c.commands.Start(ctx, models.RetryCommand{
+		UUID:    msg.CommandUid,
+		Type:    models.CarUnbookCommand,
+		Topic:   topic,
+		Target:  msg.CarUid,
+		Payload: payload,
+	})
+	if err != nil {
+		c.logger.Errorw("cannot record car unbook command", "car", msg.CarUid, "command", msg.CommandUid, "error", err)
+		c.producer.retryCarUnbook(msg)
+		return
+	}
+
+	if command.Status != models.RetryCommandPending {
+		c.logger.Infow("skipped car unbook command", "car", msg.CarUid, "command", msg.CommandUid, "status", command.Status)
+		return
+	}
+
+	err = c.cars.RetryUnbook(ctx, msg.CarUid)
+	if err != nil {
+		c.logger.Warnw("cannot cancel car book", "car", msg.CarUid, "command", msg.CommandUid, "error", err)
+
+		status, failErr := c.commands.Fail(ctx, msg.CommandUid, err.Error(), c.maxAttempts)
+		if failErr != nil {
+			c.logger.Errorw("cannot record car unbook attempt", "car", msg.CarUid, "command", msg.CommandUid, "error", failErr)
+			c.producer.retryCarUnbook(msg)
+			return
+		}
+
+		switch status {
+		case models.RetryCommandPending:
+			c.producer.retryCarUnbook(msg)
+		case models.RetryCommandDead:
+			c.logger.Errorw("car unbook dead-lettered", "car", msg.CarUid, "command", msg.CommandUid, "attempts", c.maxAttempts)
+		}
+
+		return
+	}
+
+	err = c.commands.Succeed(ctx, msg.CommandUid)
+	if err != nil {
+		c.logger.Errorw("cannot record car unbook success", "car", msg.CarUid, "command", msg.CommandUid, "error", err)
+	}
+
+	c.logger.Infow("cancelled car book", "car", msg.CarUid, "command", msg.CommandUid)
+}
@@ -8,8 +8,13 @@ import (
 	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/google/uuid"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/clients"
+	payment_service "github.com/polnaya-katuxa/ds-lab-02/gateway/internal/generated/openapi/clients/payment-service"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/postgres"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -27,6 +32,9 @@ func NewPaymentRetryQueueConsumer(
 	brokers []string,
 	topic string,
 	payment *clients.PaymentServiceClient,
+	inbox *postgres.Inbox,
+	commands *postgres.RetryCommands,
+	maxAttempts int,
 	logger *zap.SugaredLogger,
 ) (*PaymentRetryQueueConsumer, error) {
 	sl, _ := zap.NewStdLogAt(logger.Desugar(), zapcore.WarnLevel)
@@ -46,14 +54,17 @@ func NewPaymentRetryQueueConsumer(
 		topic:    topic,
 	}
 
-	paymentCancelConsumer := &paymentCancelConsumer{
-		ready:    make(chan bool),
-		payment:  payment,
-		producer: producer,
-		logger:   logger,
+	paymentRetryConsumer := &paymentRetryConsumer{
+		ready:       make(chan bool),
+		payment:     payment,
+		producer:    producer,
+		inbox:       inbox,
+		commands:    commands,
+		maxAttempts: maxAttempts,
+		logger:      logger,
 	}
 
-	consumer.retryPaymentCancel(ctx, paymentCancelConsumer)
+	consumer.retryPayment(ctx, paymentRetryConsumer)
 
 	return consumer, nil
 }
@@ -62,14 +73,21 @@ func (q *PaymentRetryQueueConsumer) Stop() {
 	q.consumer.Close()
 }
 
-type paymentCancelConsumer struct {
+// paymentRetryConsumer retries canceling and capturing payments. Handled messages are recorded in the inbox,
+// so a message redelivered after a rebalance isn't applied to the payment again.
+// Attempts are recorded in the retry commands, a command is dead-lettered instead of requeued after maxAttempts.
+type paymentRetryConsumer struct {
 	ready    chan bool
 	producer *RetryQueueProducer
 	payment  *clients.PaymentServiceClient
+	inbox    *postgres.Inbox
+	commands *postgres.RetryCommands
 	logger   *zap.SugaredLogger
+
+	maxAttempts int
 }
 
-func (q *PaymentRetryQueueConsumer) retryPaymentCancel(ctx context.Context, consumer *paymentCancelConsumer) {
+func (q *PaymentRetryQueueConsumer) retryPayment(ctx context.Context, consumer *paymentRetryConsumer) {
 	go func() {
 		for {
 			if err := q.consumer.Consume(ctx, []string{q.topic}, consumer); err != nil {
@@ -94,16 +112,16 @@ func (q *PaymentRetryQueueConsumer) retryPaymentCancel(ctx context.Context, cons
 	q.logger.Info("payment retries consumer ready")
 }
 
-func (c *paymentCancelConsumer) Setup(sarama.ConsumerGroupSession) error {
+func (c *paymentRetryConsumer) Setup(sarama.ConsumerGroupSession) error {
 	close(c.ready)
 	return nil
 }
 
-func (c *paymentCancelConsumer) Cleanup(sarama.ConsumerGroupSession) error {
+func (c *paymentRetryConsumer) Cleanup(sarama.ConsumerGroupSession) error {
 	return nil
 }
 
-func (c *paymentCancelConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+func (c *paymentRetryConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for {
 		select {
 		case message, ok := <-claim.Messages():
@@ -114,30 +132,142 @@ func (c *paymentCancelConsumer) ConsumeClaim(session sarama.ConsumerGroupSession
 
 			c.logger.Infow("message claimed", "value", string(message.Value), "timestamp", message.Timestamp, "topic", message.Topic)
 
-			var paymentCancelRetryMsg models.PaymentCancelRetryMsg
-			err := json.Unmarshal(message.Value, &paymentCancelRetryMsg)
+			var paymentRetryMsg models.PaymentRetryMsg
+			err := json.Unmarshal(message.Value, &paymentRetryMsg)
 			if err != nil {
 				session.MarkMessage(message, "message has invalid body")
-				c.logger.Errorw("unmarshal PaymentCancelRetryMsg", "error", err)
+				c.logger.Errorw("unmarshal PaymentRetryMsg", "error", err)
 				continue
 			}
 
+			if paymentRetryMsg.CommandUid == uuid.Nil {
+				paymentRetryMsg.CommandUid = kafka.MessageID(message)
+			}
+
 			for time.Now().Sub(message.Timestamp) < time.Second*10 {
 			}
 
-			err = c.payment.RetryCancel(session.Context(), paymentCancelRetryMsg.PaymentUid)
-			if err != nil {
-				c.logger.Warnw("cannot cancel payment", "payment", paymentCancelRetryMsg.PaymentUid, "error", err)
-				session.MarkMessage(message, "retry cancel payment")
-				c.producer.RetryPaymentCancel(paymentCancelRetryMsg.PaymentUid)
+			handled, err := c.inbox.Process(session.Context(), models.PaymentRetryConsumer, message.Topic, kafka.MessageID(message),
+				func(ctx context.Context) error {
+					c.retry(ctx, message.Topic, paymentRetryMsg)
+					return nil
+				})
+			if err != nil && handled {
+				// The message is handled already, a redelivered duplicate is handled again by the idempotent handler.
+				c.logger.Errorw("cannot record payment retry in inbox", "payment", paymentRetryMsg.PaymentUid, "error", err)
+			} else if err != nil {
+				c.logger.Errorw("cannot check payment retry in inbox", "payment", paymentRetryMsg.PaymentUid, "error", err)
+				session.MarkMessage(message, "retry inbox")
+				c.producer.retryPayment(paymentRetryMsg)
 				continue
 			}
 
-			c.logger.Infow("cancelled payment", "payment", paymentCancelRetryMsg.PaymentUid)
-			session.MarkMessage(message, "got msg for cancel payment")
+			if !handled {
+				c.logger.Infow("skipped duplicate payment retry", "payment", paymentRetryMsg.PaymentUid)
+			}
+
+			session.MarkMessage(message, "got msg for payment retry")
 
 		case <-session.Context().Done():
 			return nil
 		}
 	}
 }
+
+// retry cancels, refunds, captures the payment or pays the extra charge, it is retried through the queue if payment service fails
+// until the command runs out of attempts. Discarded and done commands are skipped.
+func (c *paymentRetryConsumer) retry(ctx context.Context, topic string, msg models.PaymentRetryMsg) {
+	payload, _ := json.Marshal(msg)
+
+	commandType, target := models.PaymentCancelCommand, msg.PaymentUid
+	switch msg.Action {
+	case models.PaymentCaptureAction:
+		commandType = models.PaymentCaptureCommand
+	case models.PaymentChargeAction:
+		commandType, target = models.PaymentChargeCommand, lo.FromPtr(msg.RentalUid)
+	}
+
+	command, err := c.commands.Start(ctx, models.RetryCommand{
+		UUID:    msg.CommandUid,
+		Type:    commandType,
+		Topic:   topic,
+		Target:  target,
+		Payload: payload,
+	})
+	if err != nil {
+		c.logger.Errorw("cannot record payment command", "payment", msg.PaymentUid, "command", msg.CommandUid, "type", commandType, "error", err)
+		c.producer.retryPayment(msg)
+		return
+	}
+
+	if command.Status != models.RetryCommandPending {
+		c.logger.Infow("skipped payment command", "payment", msg.PaymentUid, "command", msg.CommandUid, "type", commandType, "status", command.Status)
+		return
+	}
+
+	switch msg.Action {
+	case models.PaymentCaptureAction:
+		err = c.payment.RetryCapture(ctx, msg.PaymentUid, msg.RentalUid)
+	case models.PaymentChargeAction:
+		err = c.charge(ctx, msg)
+	default:
+		err = c.payment.RetryCancel(ctx, msg.PaymentUid, &payment_service.CancelParams{
+			RentalStart: msg.RentalStart,
+			CanceledAt:  msg.CanceledAt,
+			RentalUid:   msg.RentalUid,
+		})
+	}
+	if err != nil {
+		c.logger.Warnw("cannot retry payment command", "payment", msg.PaymentUid, "command", msg.CommandUid, "type", commandType, "error", err)
+
+		status, failErr := c.commands.Fail(ctx, msg.CommandUid, err.Error(), c.maxAttempts)
+		if failErr != nil {
+			c.logger.Errorw("cannot record payment command attempt", "payment", msg.PaymentUid, "command", msg.CommandUid, "type", commandType, "error", failErr)
+			c.producer.retryPayment(msg)
+			return
+		}
+
+		switch status {
+		case models.RetryCommandPending:
+			c.producer.retryPayment(msg)
+		case models.RetryCommandDead:
+			c.logger.Errorw("payment command dead-lettered", "payment", msg.PaymentUid, "command", msg.CommandUid, "type", commandType, "attempts", c.maxAttempts)
+		}
+
+		return
+	}
+
+	err = c.commands.Succeed(ctx, msg.CommandUid)
+	if err != nil {
+		c.logger.Errorw("cannot record payment command success", "payment", msg.PaymentUid, "command", msg.CommandUid, "type", commandType, "error", err)
+	}
+
+	c.logger.Infow("retried payment command", "payment", msg.PaymentUid, "command", msg.CommandUid, "type", commandType)
+}
+
+// charge creates the payment of the extra charge and captures it. The payment isn't created again
+// if the capture fails, the capture is retried by its own command.
+func (c *paymentRetryConsumer) charge(ctx context.Context, msg models.PaymentRetryMsg) error {
+	if msg.Charge == nil || msg.RentalUid == nil {
+		return fmt.Errorf("charge of rental is missing: %w", models.ErrInvalidRetryMsg)
+	}
+
+	payment, err := c.payment.RetryCreate(ctx, payment_service.CreatePaymentRequest{
+		Price:     msg.Charge.Price,
+		Currency:  msg.Charge.Currency,
+		RentalUid: msg.RentalUid,
+		Kind:      lo.ToPtr(payment_service.CreatePaymentRequestKind(msg.Charge.Kind)),
+		Username:  &msg.Charge.Username,
+	})
+	if err != nil {
+		return fmt.Errorf("create charge payment: %w", err)
+	}
+
+	err = c.payment.RetryCapture(ctx, payment.PaymentUid, msg.RentalUid)
+	if err != nil {
+		c.logger.Warnw("cannot capture charge payment, retrying", "rental", *msg.RentalUid, "payment", payment.PaymentUid, "error", err)
+		c.producer.RetryPaymentCapture(payment.PaymentUid, *msg.RentalUid)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/google/uuid"
 	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/repository/kafka"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -54,13 +55,13 @@ func (q *RetryQueueProducer) Stop() {
 	q.producer.Close()
 }
 
-func (q *RetryQueueProducer) prepareCarUnbookMsg(carUid uuid.UUID) *sarama.ProducerMessage {
-	msg := models.CarUnbookRetryMsg{CarUid: carUid}
+func (q *RetryQueueProducer) prepareCarUnbookMsg(msg models.CarUnbookRetryMsg) *sarama.ProducerMessage {
 	marshalledMsg, _ := json.Marshal(msg)
 	encoder := sarama.ByteEncoder(marshalledMsg)
 	producerMsg := &sarama.ProducerMessage{
 		Topic:     q.carUnbookTopic,
 		Value:     encoder,
+		Headers:   kafka.MessageHeaders(),
 		Timestamp: time.Now(),
 	}
 
@@ -68,22 +69,72 @@ func (q *RetryQueueProducer) prepareCarUnbookMsg(carUid uuid.UUID) *sarama.Produ
 }
 
 func (q *RetryQueueProducer) RetryCarUnbook(carUid uuid.UUID) {
-	q.producer.Input() <- q.prepareCarUnbookMsg(carUid)
+	q.retryCarUnbook(models.CarUnbookRetryMsg{CommandUid: uuid.New(), CarUid: carUid})
 }
 
-func (q *RetryQueueProducer) preparePaymentCancelMsg(paymentUid uuid.UUID) *sarama.ProducerMessage {
-	msg := models.PaymentCancelRetryMsg{PaymentUid: paymentUid}
+func (q *RetryQueueProducer) retryCarUnbook(msg models.CarUnbookRetryMsg) {
+	q.producer.Input() <- q.prepareCarUnbookMsg(msg)
+}
+
+func (q *RetryQueueProducer) preparePaymentMsg(msg models.PaymentRetryMsg) *sarama.ProducerMessage {
 	marshalledMsg, _ := json.Marshal(msg)
 
 	encoder := sarama.ByteEncoder(marshalledMsg)
 	producerMsg := &sarama.ProducerMessage{
-		Topic: q.paymentCancelTopic,
-		Value: encoder,
+		Topic:   q.paymentCancelTopic,
+		Value:   encoder,
+		Headers: kafka.MessageHeaders(),
 	}
 
 	return producerMsg
 }
 
 func (q *RetryQueueProducer) RetryPaymentCancel(paymentUid uuid.UUID) {
-	q.producer.Input() <- q.preparePaymentCancelMsg(paymentUid)
+	q.retryPayment(models.PaymentRetryMsg{CommandUid: uuid.New(), Action: models.PaymentCancelAction, PaymentUid: paymentUid})
+}
+
+// RetryPaymentRefund keeps the cancellation time, so the refund doesn't shrink while the payment service is unavailable.
+func (q *RetryQueueProducer) RetryPaymentRefund(paymentUid, rentalUid uuid.UUID, rentalStart, canceledAt time.Time) {
+	q.retryPayment(models.PaymentRetryMsg{
+		CommandUid:  uuid.New(),
+		Action:      models.PaymentCancelAction,
+		PaymentUid:  paymentUid,
+		RentalUid:   &rentalUid,
+		RentalStart: &rentalStart,
+		CanceledAt:  &canceledAt,
+	})
+}
+
+// RetryPaymentCapture charges the amount authorized at booking and links the payment to the rental.
+func (q *RetryQueueProducer) RetryPaymentCapture(paymentUid, rentalUid uuid.UUID) {
+	q.retryPayment(models.PaymentRetryMsg{
+		CommandUid: uuid.New(),
+		Action:     models.PaymentCaptureAction,
+		PaymentUid: paymentUid,
+		RentalUid:  &rentalUid,
+	})
+}
+
+// RetryPaymentCharge pays the extra charge of the rental, the payment is created for the owner by the consumer.
+func (q *RetryQueueProducer) RetryPaymentCharge(rentalUid uuid.UUID, charge models.PaymentCharge) {
+	q.retryPayment(models.PaymentRetryMsg{
+		CommandUid: uuid.New(),
+		Action:     models.PaymentChargeAction,
+		RentalUid:  &rentalUid,
+		Charge:     &charge,
+	})
+}
+
+func (q *RetryQueueProducer) retryPayment(msg models.PaymentRetryMsg) {
+	q.producer.Input() <- q.preparePaymentMsg(msg)
+}
+
+// Replay publishes the recorded command again as a new message.
+func (q *RetryQueueProducer) Replay(command models.RetryCommand) {
+	q.producer.Input() <- &sarama.ProducerMessage{
+		Topic:     command.Topic,
+		Value:     sarama.ByteEncoder(command.Payload),
+		Headers:   kafka.MessageHeaders(),
+		Timestamp: time.Now(),
+	}
 }
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Inbox struct {
+	db *gorm.DB
+}
+
+func NewInbox(db *gorm.DB) *Inbox {
+	return &Inbox{
+		db: db,
+	}
+}
+
+// Process calls handle unless the consumer has already handled the message and records the message after it.
+// No transaction is held while it is handled, so a duplicate delivered to another replica at the same time
+// may be handled twice, handlers are idempotent. The message isn't recorded if handle fails.
+// Returns whether handle was called, it is true with the error if the message is handled but not recorded.
+func (i *Inbox) Process(ctx context.Context, consumer, topic string, messageUUID uuid.UUID, handle func(ctx context.Context) error) (bool, error) {
+	var count int64
+	err := i.db.Table("inbox").WithContext(ctx).
+		Where("consumer = ? AND message_id = ?", consumer, messageUUID).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("find inbox message in db: %w", err)
+	}
+
+	if count > 0 {
+		return false, nil
+	}
+
+	err = handle(ctx)
+	if err != nil {
+		return false, fmt.Errorf("handle message: %w", err)
+	}
+
+	res := i.db.Table("inbox").WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&models.InboxMessage{
+		Consumer:    consumer,
+		MessageUUID: messageUUID,
+		Topic:       topic,
+		ProcessedAt: time.Now().UTC(),
+	})
+	if res.Error != nil {
+		return true, fmt.Errorf("create inbox message in db: %w", res.Error)
+	}
+
+	return true, nil
+}
+
+// Purge deletes messages handled before the time, kafka doesn't redeliver them after the retention.
+func (i *Inbox) Purge(ctx context.Context, before time.Time) (int, error) {
+	res := i.db.Table("inbox").WithContext(ctx).Where("processed_at < ?", before).Delete(&models.InboxMessage{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("delete inbox messages from db: %w", res.Error)
+	}
+
+	return int(res.RowsAffected), nil
+}
@@ -0,0 +1,280 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const projectionMarkerID = 1
+
+// Projection keeps documents of the user rentals view shared by all gateway replicas.
+// A document is replaced only by a newer one, so events applied out of order don't roll it back.
+type Projection struct {
+	db *gorm.DB
+}
+
+func NewProjection(db *gorm.DB) *Projection {
+	return &Projection{
+		db: db,
+	}
+}
+
+// PutRental saves the rental document and moves the marker to the event.
+func (p *Projection) PutRental(ctx context.Context, rental models.ProjectedRental, marker models.ProjectionMarker) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := putRental(tx, rental)
+		if err != nil {
+			return err
+		}
+
+		return putMarker(tx, marker)
+	})
+}
+
+// PutCar saves the car document and moves the marker to the event.
+func (p *Projection) PutCar(ctx context.Context, car models.ProjectedCar, marker models.ProjectionMarker) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := putCar(tx, car)
+		if err != nil {
+			return err
+		}
+
+		return putMarker(tx, marker)
+	})
+}
+
+// PutPayment saves the payment document and moves the marker to the event.
+func (p *Projection) PutPayment(ctx context.Context, payment models.ProjectedPayment, marker models.ProjectionMarker) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := putPayment(tx, payment)
+		if err != nil {
+			return err
+		}
+
+		return putMarker(tx, marker)
+	})
+}
+
+// Seed saves the documents of the user loaded from the services and marks the user as seeded.
+func (p *Projection) Seed(ctx context.Context, username string, views []models.ProjectedView, startedAt time.Time) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, view := range views {
+			err := putRental(tx, view.Rental)
+			if err != nil {
+				return err
+			}
+
+			if view.Car != nil {
+				if err := putCar(tx, *view.Car); err != nil {
+					return err
+				}
+			}
+
+			if view.Payment != nil {
+				if err := putPayment(tx, *view.Payment); err != nil {
+					return err
+				}
+			}
+		}
+
+		err := tx.Exec(`INSERT INTO projection_users (username, seeded_at) VALUES (?, ?)
+ON CONFLICT (username) DO UPDATE SET seeded_at = excluded.seeded_at`, username, startedAt).Error
+		if err != nil {
+			return fmt.Errorf("upsert projection user in db: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// Seeded reports whether the documents of the user were loaded.
+func (p *Projection) Seeded(ctx context.Context, username string) (bool, error) {
+	var seeded int64
+	err := p.db.Table("projection_users").WithContext(ctx).
+		Where("username = ? AND seeded_at IS NOT NULL", username).
+		Count(&seeded).Error
+	if err != nil {
+		return false, fmt.Errorf("count projection users in db: %w", err)
+	}
+
+	return seeded > 0, nil
+}
+
+// List returns the page of the user rentals ordered by the start date and the rental uid,
+// and the number of rentals matching the filter.
+func (p *Projection) List(ctx context.Context, username string, filter models.ProjectionFilter) ([]models.ProjectedView, int, error) {
+	query := p.views(ctx).Where("r.username = ?", username)
+	if len(filter.Statuses) > 0 {
+		query = query.Where("r.status IN ?", filter.Statuses)
+	}
+	if filter.DateFrom != nil {
+		query = query.Where("r.date_to >= ?", *filter.DateFrom)
+	}
+	if filter.DateTo != nil {
+		query = query.Where("r.date_from <= ?", *filter.DateTo)
+	}
+
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("count projected rentals in db: %w", err)
+	}
+
+	order := "r.date_from DESC, r.rental_uid DESC"
+	if filter.Asc {
+		order = "r.date_from ASC, r.rental_uid ASC"
+	}
+
+	var rows []projectedViewRow
+	err = query.Select(projectedViewColumns).Order(order).Offset(filter.Page * filter.Size).Limit(filter.Size).Scan(&rows).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("get projected rentals from db: %w", err)
+	}
+
+	views := make([]models.ProjectedView, 0, len(rows))
+	for _, row := range rows {
+		views = append(views, row.toView())
+	}
+
+	return views, int(total), nil
+}
+
+// Get returns the rental of the user.
+func (p *Projection) Get(ctx context.Context, username string, rentalUUID uuid.UUID) (*models.ProjectedView, error) {
+	var row projectedViewRow
+	err := p.views(ctx).Select(projectedViewColumns).
+		Where("r.rental_uid = ? AND r.username = ?", rentalUUID, username).Take(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get projected rental from db: %w", models.ErrProjectedRentalNotFound)
+		}
+
+		return nil, fmt.Errorf("get projected rental from db: %w", err)
+	}
+
+	view := row.toView()
+
+	return &view, nil
+}
+
+// Marker returns the newest applied event, it is empty until any event is applied.
+func (p *Projection) Marker(ctx context.Context) (models.ProjectionMarker, error) {
+	var markers []models.ProjectionMarker
+	err := p.db.Table("projection_marker").WithContext(ctx).Where("id = ?", projectionMarkerID).Find(&markers).Error
+	if err != nil {
+		return models.ProjectionMarker{}, fmt.Errorf("get projection marker from db: %w", err)
+	}
+
+	if len(markers) == 0 {
+		return models.ProjectionMarker{}, nil
+	}
+
+	return markers[0], nil
+}
+
+func (p *Projection) views(ctx context.Context) *gorm.DB {
+	return p.db.Table("projection_rentals AS r").WithContext(ctx).
+		Joins("LEFT JOIN projection_cars AS c ON c.car_uid = r.car_uid").
+		Joins("LEFT JOIN projection_payments AS p ON p.payment_uid = r.payment_uid")
+}
+
+const projectedViewColumns = `r.*,
+c.brand AS car_brand, c.model AS car_model, c.registration_number AS car_registration_number, c.updated_at AS car_updated_at,
+p.info AS payment_info, p.updated_at AS payment_updated_at`
+
+// projectedViewRow is the rental joined with its car and payment, their columns are null while unknown.
+type projectedViewRow struct {
+	models.ProjectedRental
+	CarBrand              *string    `gorm:"column:car_brand"`
+	CarModel              *string    `gorm:"column:car_model"`
+	CarRegistrationNumber *string    `gorm:"column:car_registration_number"`
+	CarUpdatedAt          *time.Time `gorm:"column:car_updated_at"`
+	PaymentInfo           []byte     `gorm:"column:payment_info"`
+	PaymentUpdatedAt      *time.Time `gorm:"column:payment_updated_at"`
+}
+
+func (r projectedViewRow) toView() models.ProjectedView {
+	view := models.ProjectedView{
+		Rental: r.ProjectedRental,
+	}
+
+	if r.CarUpdatedAt != nil {
+		view.Car = &models.ProjectedCar{
+			CarUUID:            r.CarUUID,
+			Brand:              *r.CarBrand,
+			Model:              *r.CarModel,
+			RegistrationNumber: *r.CarRegistrationNumber,
+			UpdatedAt:          *r.CarUpdatedAt,
+		}
+	}
+
+	if r.PaymentUpdatedAt != nil {
+		view.Payment = &models.ProjectedPayment{
+			PaymentUUID: r.PaymentUUID,
+			Info:        r.PaymentInfo,
+			UpdatedAt:   *r.PaymentUpdatedAt,
+		}
+	}
+
+	return view
+}
+
+func putRental(tx *gorm.DB, rental models.ProjectedRental) error {
+	err := tx.Table("projection_rentals").Clauses(newerDocument("projection_rentals", "rental_uid",
+		"username", "car_uid", "payment_uid", "date_from", "date_to", "status", "updated_at")).Create(&rental).Error
+	if err != nil {
+		return fmt.Errorf("upsert projected rental in db: %w", err)
+	}
+
+	return nil
+}
+
+func putCar(tx *gorm.DB, car models.ProjectedCar) error {
+	err := tx.Table("projection_cars").Clauses(newerDocument("projection_cars", "car_uid",
+		"brand", "model", "registration_number", "updated_at")).Create(&car).Error
+	if err != nil {
+		return fmt.Errorf("upsert projected car in db: %w", err)
+	}
+
+	return nil
+}
+
+func putPayment(tx *gorm.DB, payment models.ProjectedPayment) error {
+	err := tx.Table("projection_payments").Clauses(newerDocument("projection_payments", "payment_uid",
+		"info", "updated_at")).Create(&payment).Error
+	if err != nil {
+		return fmt.Errorf("upsert projected payment in db: %w", err)
+	}
+
+	return nil
+}
+
+// putMarker moves the marker to the event unless a newer one was applied.
+func putMarker(tx *gorm.DB, marker models.ProjectionMarker) error {
+	err := tx.Exec(`INSERT INTO projection_marker (id, event_id, occurred_at) VALUES (?, ?, ?)
+ON CONFLICT (id) DO UPDATE SET event_id = excluded.event_id, occurred_at = excluded.occurred_at
+WHERE projection_marker.occurred_at <= excluded.occurred_at`, projectionMarkerID, marker.EventID, marker.OccurredAt).Error
+	if err != nil {
+		return fmt.Errorf("upsert projection marker in db: %w", err)
+	}
+
+	return nil
+}
+
+// newerDocument replaces the stored document only if it isn't newer.
+func newerDocument(table, key string, columns ...string) clause.OnConflict {
+	return clause.OnConflict{
+		Columns:   []clause.Column{{Name: key}},
+		DoUpdates: clause.AssignmentColumns(columns),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: table + ".updated_at <= excluded.updated_at"},
+		}},
+	}
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/gateway/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RetryCommands struct {
+	db *gorm.DB
+}
+
+func NewRetryCommands(db *gorm.DB) *RetryCommands {
+	return &RetryCommands{
+		db: db,
+	}
+}
+
+// Start records the command on its first attempt and returns its current state,
+// the command must be attempted only while it is pending.
+func (r *RetryCommands) Start(ctx context.Context, command models.RetryCommand) (models.RetryCommand, error) {
+	now := time.Now().UTC()
+	command.Status = models.RetryCommandPending
+	command.CreatedAt = now
+	command.UpdatedAt = now
+
+	err := r.db.Table("retry_commands").WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&command).Error
+	if err != nil {
+		return models.RetryCommand{}, fmt.Errorf("create retry command in db: %w", err)
+	}
+
+	var current models.RetryCommand
+	err = r.db.Table("retry_commands").WithContext(ctx).Where("id = ?", command.UUID).Take(&current).Error
+	if err != nil {
+		return models.RetryCommand{}, fmt.Errorf("get retry command from db: %w", err)
+	}
+
+	return current, nil
+}
+
+// Fail records the failed attempt. The pending command is dead-lettered when it runs out of attempts,
+// maxAttempts of zero retries it forever. Returns the status of the command.
+func (r *RetryCommands) Fail(ctx context.Context, commandUUID uuid.UUID, reason string, maxAttempts int) (models.RetryCommandStatus, error) {
+	var status models.RetryCommandStatus
+
+	err := r.db.WithContext(ctx).Raw(`UPDATE retry_commands
+SET attempts = attempts + 1,
+    last_error = ?,
+    status = CASE WHEN status = ? AND ? > 0 AND attempts + 1 >= ? THEN ? ELSE status END,
+    updated_at = ?
+WHERE id = ?
+RETURNING status`, reason, models.RetryCommandPending, maxAttempts, maxAttempts, models.RetryCommandDead,
+		time.Now().UTC(), commandUUID).Scan(&status).Error
+	if err != nil {
+		return "", fmt.Errorf("update retry command in db: %w", err)
+	}
+
+	return status, nil
+}
+
+// Succeed records the successful attempt, the command is done even if it was discarded meanwhile.
+func (r *RetryCommands) Succeed(ctx context.Context, commandUUID uuid.UUID) error {
+	err := r.db.Table("retry_commands").WithContext(ctx).Where("id = ?", commandUUID).Updates(map[string]any{
+		"attempts":   gorm.Expr("attempts + 1"),
+		"status":     models.RetryCommandDone,
+		"updated_at": time.Now().UTC(),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("update retry command in db: %w", err)
+	}
+
+	return nil
+}
+
+// List returns the page of commands, the most recently updated first, and the number of commands matching the filter.
+func (r *RetryCommands) List(ctx context.Context, filter models.RetryCommandFilter) ([]models.RetryCommand, int, error) {
+	query := r.db.Table("retry_commands").WithContext(ctx)
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if filter.Type != nil {
+		query = query.Where("type = ?", *filter.Type)
+	}
+
+	var total int64
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("count retry commands in db: %w", err)
+	}
+
+	var commands []models.RetryCommand
+	err = query.Order("updated_at DESC").Offset(filter.Page * filter.Size).Limit(filter.Size).Find(&commands).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("get retry commands from db: %w", err)
+	}
+
+	return commands, int(total), nil
+}
+
+// Replay makes the selected dead or discarded commands pending again with their attempts reset,
+// all dead commands of the type are replayed if no commands are selected. Returns the commands to publish.
+func (r *RetryCommands) Replay(ctx context.Context, commandUUIDs []uuid.UUID, commandType *models.RetryCommandType) ([]models.RetryCommand, error) {
+	query := r.db.Table("retry_commands").WithContext(ctx)
+	if len(commandUUIDs) > 0 {
+		query = query.Where("id IN ? AND status IN ?", commandUUIDs,
+			[]models.RetryCommandStatus{models.RetryCommandDead, models.RetryCommandDiscarded})
+	} else {
+		query = query.Where("status = ?", models.RetryCommandDead)
+	}
+	if commandType != nil {
+		query = query.Where("type = ?", *commandType)
+	}
+
+	var commands []models.RetryCommand
+	err := query.Model(&commands).Clauses(clause.Returning{}).Updates(map[string]any{
+		"status":         models.RetryCommandPending,
+		"attempts":       0,
+		"replays":        gorm.Expr("replays + 1"),
+		"discard_reason": nil,
+		"discarded_by":   nil,
+		"updated_at":     time.Now().UTC(),
+	}).Error
+	if err != nil {
+		return nil, fmt.Errorf("update retry commands in db: %w", err)
+	}
+
+	return commands, nil
+}
+
+// Discard stops retrying the selected pending or dead commands. Returns the discarded commands.
+func (r *RetryCommands) Discard(ctx context.Context, commandUUIDs []uuid.UUID, reason, discardedBy string) ([]models.RetryCommand, error) {
+	var commands []models.RetryCommand
+	err := r.db.Table("retry_commands").WithContext(ctx).Model(&commands).Clauses(clause.Returning{}).
+		Where("id IN ? AND status IN ?", commandUUIDs,
+			[]models.RetryCommandStatus{models.RetryCommandPending, models.RetryCommandDead}).
+		Updates(map[string]any{
+			"status":         models.RetryCommandDiscarded,
+			"discard_reason": reason,
+			"discarded_by":   discardedBy,
+			"updated_at":     time.Now().UTC(),
+		}).Error
+	if err != nil {
+		return nil, fmt.Errorf("update retry commands in db: %w", err)
+	}
+
+	return commands, nil
+}
+
+// Count returns the number of commands of each type in each status.
+func (r *RetryCommands) Count(ctx context.Context) ([]models.RetryCommandCounts, error) {
+	var counts []models.RetryCommandCounts
+	err := r.db.Table("retry_commands").WithContext(ctx).
+		Select("type, status, COUNT(*) AS count").Group("type, status").Scan(&counts).Error
+	if err != nil {
+		return nil, fmt.Errorf("count retry commands in db: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,54 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const (
+	requestIDKey   = "request_id"
+	traceparentKey = "traceparent"
+
+	// HeaderTraceparent is the W3C trace context header, services put it into their domain events.
+	HeaderTraceparent = "Traceparent"
+)
+
+// CreateMiddleware takes the request id from the X-Request-Id header or generates a new one
+// and puts it into the request context together with the traceparent header.
+func CreateMiddleware() echo.MiddlewareFunc {
+	return middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			ctx := context.WithValue(c.Request().Context(), requestIDKey, id)
+			if traceparent := c.Request().Header.Get(HeaderTraceparent); traceparent != "" {
+				ctx = context.WithValue(ctx, traceparentKey, traceparent)
+			}
+			c.SetRequest(c.Request().WithContext(ctx))
+		},
+	})
+}
+
+func Get(ctx context.Context) string {
+	value, _ := ctx.Value(requestIDKey).(string)
+	return value
+}
+
+func Traceparent(ctx context.Context) string {
+	value, _ := ctx.Value(traceparentKey).(string)
+	return value
+}
+
+// Propagate passes the request id and the trace context from the context to the services.
+func Propagate(ctx context.Context, req *http.Request) error {
+	if id := Get(ctx); id != "" {
+		req.Header.Set(echo.HeaderXRequestID, id)
+	}
+
+	if traceparent := Traceparent(ctx); traceparent != "" {
+		req.Header.Set(HeaderTraceparent, traceparent)
+	}
+
+	return nil
+}
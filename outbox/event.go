@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType is the name of the domain event, consumers dispatch events by it.
+type EventType string
+
+// TraceContext links the event to the request which caused it. It is empty for events of background jobs.
+type TraceContext struct {
+	RequestID   string `json:"request_id,omitempty"`
+	Traceparent string `json:"traceparent,omitempty"`
+}
+
+// DomainEvent is the envelope shared by domain events of all services, its schema is api/asyncapi/domain-events.yml.
+// Consumers dispatch events by the type and the version of the payload, events of one aggregate are published
+// in order to one partition.
+type DomainEvent struct {
+	ID          uuid.UUID    `json:"id"`
+	Type        EventType    `json:"type"`
+	Version     int          `json:"version"`
+	Source      string       `json:"source"`
+	AggregateID uuid.UUID    `json:"aggregate_id"`
+	OccurredAt  time.Time    `json:"occurred_at"`
+	Trace       TraceContext `json:"trace"`
+	Data        any          `json:"data"`
+}
+
+// Message is the domain event saved in the transaction of the state change until it is published.
+type Message struct {
+	ID          int        `gorm:"column:id;primaryKey"`
+	EventUUID   uuid.UUID  `gorm:"column:event_uid;type:uuid"`
+	EventType   EventType  `gorm:"column:event_type"`
+	Key         string     `gorm:"column:key"`
+	Payload     []byte     `gorm:"column:payload;type:jsonb"`
+	CreatedAt   time.Time  `gorm:"column:created_at;type:timestamptz"`
+	PublishedAt *time.Time `gorm:"column:published_at;type:timestamptz"`
+}
@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	headerEventID   = "event_id"
+	headerEventType = "event_type"
+)
+
+// Producer publishes outbox messages to the domain events topic. Messages are sent synchronously,
+// so they are marked published only after kafka has acknowledged them.
+type Producer struct {
+	producer sarama.SyncProducer
+
+	topic string
+}
+
+func NewProducer(brokers []string, topic string, logger *zap.SugaredLogger) (*Producer, error) {
+	sl, _ := zap.NewStdLogAt(logger.Desugar(), zapcore.WarnLevel)
+	sarama.Logger = sl
+
+	config := sarama.NewConfig()
+	config.ClientID = "car-rental-system"
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+
+	producer, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("start kafka sync producer: %w", err)
+	}
+
+	return &Producer{
+		producer: producer,
+		topic:    topic,
+	}, nil
+}
+
+func (p *Producer) Stop() {
+	p.producer.Close()
+}
+
+// Publish sends the messages keyed by the aggregate, so events of one aggregate keep their order.
+func (p *Producer) Publish(messages []Message) error {
+	producerMsgs := make([]*sarama.ProducerMessage, 0, len(messages))
+	for _, message := range messages {
+		producerMsgs = append(producerMsgs, &sarama.ProducerMessage{
+			Topic: p.topic,
+			Key:   sarama.StringEncoder(message.Key),
+			Value: sarama.ByteEncoder(message.Payload),
+			Headers: []sarama.RecordHeader{
+				{Key: []byte(headerEventID), Value: []byte(message.EventUUID.String())},
+				{Key: []byte(headerEventType), Value: []byte(message.EventType)},
+			},
+			Timestamp: message.CreatedAt,
+		})
+	}
+
+	err := p.producer.SendMessages(producerMsgs)
+	if err != nil {
+		return fmt.Errorf("send messages: %w", err)
+	}
+
+	return nil
+}
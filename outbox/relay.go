@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Relay publishes domain events saved to the outbox together with the state changes.
+// Events are published at least once, consumers deduplicate them by the event id.
+type Relay struct {
+	store     Store
+	publisher Publisher
+	batchSize int
+	logger    *zap.SugaredLogger
+}
+
+func NewRelay(store Store, publisher Publisher, batchSize int, logger *zap.SugaredLogger) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run publishes the outbox every interval until the context is done. Full batches are followed
+// by the next one at once, so the backlog is drained without waiting for the ticker.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				published, err := r.Process(ctx)
+				if err != nil {
+					r.logger.Errorw("cannot publish outbox", "error", err)
+				}
+
+				if err != nil || published < r.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// Process publishes one batch of the outbox and returns the number of published events.
+func (r *Relay) Process(ctx context.Context) (int, error) {
+	published, err := r.store.PublishOutbox(ctx, r.batchSize, r.publisher.Publish)
+	if err != nil {
+		return 0, fmt.Errorf("publish outbox in store: %w", err)
+	}
+
+	return published, nil
+}
+
+//go:generate mockery --all --with-expecter --exported --output mocks/
+
+type Store interface {
+	PublishOutbox(ctx context.Context, limit int, publish func(messages []Message) error) (int, error)
+}
+
+type Publisher interface {
+	Publish(messages []Message) error
+}
@@ -0,0 +1,57 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestRelay_Process(t *testing.T) {
+	messages := []outbox.Message{{ID: 1, EventType: "CarBooked"}, {ID: 2, EventType: "CarUnbooked"}}
+
+	t.Run("published", func(t *testing.T) {
+		ctx := context.Background()
+
+		publisher := mocks.NewPublisher(t)
+		publisher.EXPECT().Publish(messages).Return(nil)
+
+		store := mocks.NewStore(t)
+		store.EXPECT().PublishOutbox(ctx, 10, mock.Anything).RunAndReturn(
+			func(ctx context.Context, limit int, publish func([]outbox.Message) error) (int, error) {
+				return len(messages), publish(messages)
+			})
+
+		got, err := outbox.NewRelay(store, publisher, 10, zap.NewNop().Sugar()).Process(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, got)
+	})
+
+	t.Run("kafka error", func(t *testing.T) {
+		ctx := context.Background()
+
+		publisher := mocks.NewPublisher(t)
+		publisher.EXPECT().Publish(messages).Return(errors.New("error"))
+
+		store := mocks.NewStore(t)
+		store.EXPECT().PublishOutbox(ctx, 10, mock.Anything).RunAndReturn(
+			func(ctx context.Context, limit int, publish func([]outbox.Message) error) (int, error) {
+				err := publish(messages)
+				if err != nil {
+					return 0, err
+				}
+
+				return len(messages), nil
+			})
+
+		got, err := outbox.NewRelay(store, publisher, 10, zap.NewNop().Sugar()).Process(ctx)
+		require.Error(t, err)
+		assert.Equal(t, 0, got)
+	})
+}
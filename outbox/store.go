@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PostgresStore keeps the outbox in the "outbox" table of the service database.
+type PostgresStore struct {
+	db      *gorm.DB
+	lockKey int64
+}
+
+// NewPostgresStore creates the store. lockKey is the key of the postgres advisory lock which lets one replica
+// publish the outbox at a time, so events are published in the order they were saved. Services sharing
+// a database must use different keys.
+func NewPostgresStore(db *gorm.DB, lockKey int64) *PostgresStore {
+	return &PostgresStore{
+		db:      db,
+		lockKey: lockKey,
+	}
+}
+
+// Save adds the events to the outbox in the transaction of the state change.
+func Save(tx *gorm.DB, events []DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	messages := make([]Message, 0, len(events))
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("marshal event %s: %w", event.Type, err)
+		}
+
+		messages = append(messages, Message{
+			EventUUID: event.ID,
+			EventType: event.Type,
+			Key:       event.AggregateID.String(),
+			Payload:   payload,
+			CreatedAt: event.OccurredAt,
+		})
+	}
+
+	err := tx.Table("outbox").Create(&messages).Error
+	if err != nil {
+		return fmt.Errorf("create outbox messages in db: %w", err)
+	}
+
+	return nil
+}
+
+// PublishOutbox passes the oldest unpublished messages to publish and marks them published if it succeeds.
+// Returns the number of published messages, nothing is published while another replica holds the outbox lock.
+func (s *PostgresStore) PublishOutbox(ctx context.Context, limit int, publish func(messages []Message) error) (int, error) {
+	var messages []Message
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", s.lockKey).Scan(&locked).Error
+		if err != nil {
+			return fmt.Errorf("try advisory lock: %w", err)
+		}
+
+		if !locked {
+			return nil
+		}
+
+		err = tx.Table("outbox").Where("published_at IS NULL").Order("id").Limit(limit).Find(&messages).Error
+		if err != nil {
+			return fmt.Errorf("find outbox messages in db: %w", err)
+		}
+
+		if len(messages) == 0 {
+			return nil
+		}
+
+		err = publish(messages)
+		if err != nil {
+			return fmt.Errorf("publish outbox messages: %w", err)
+		}
+
+		ids := make([]int, 0, len(messages))
+		for _, message := range messages {
+			ids = append(ids, message.ID)
+		}
+
+		err = tx.Table("outbox").Where("id IN ?", ids).Update("published_at", time.Now().UTC()).Error
+		if err != nil {
+			return fmt.Errorf("mark outbox messages published in db: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("transaction: %w", err)
+	}
+
+	return len(messages), nil
+}
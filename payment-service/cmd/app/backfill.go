@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	rental_service "github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/generated/openapi/clients/rental-service"
+	"go.uber.org/zap"
+)
+
+const (
+	backfillBatchSize = 500
+	// backfillRetryInterval is the first pause before the backfill is retried, it doubles up to backfillMaxRetryInterval.
+	backfillRetryInterval    = time.Second
+	backfillMaxRetryInterval = time.Minute
+)
+
+// runBackfillOwners fills owners of payments created before usernames were stored. It runs in the background,
+// so the service starts while rental service is unavailable, and is retried until a pass over the payments succeeds.
+// Owners are looked up in the rental service by the rental payment or the rental of extra charges.
+// Payments of unknown rentals keep the empty owner, so they are accessible to services and admins only.
+// Ledger entries are append-only and keep the unknown customer account.
+func runBackfillOwners(ctx context.Context, db *sql.DB, rentalServiceURL, servicePassword string, logger *zap.SugaredLogger) error {
+	client, err := rental_service.NewClientWithResponses(rentalServiceURL)
+	if err != nil {
+		return fmt.Errorf("create rental service client: %w", err)
+	}
+
+	interval := backfillRetryInterval
+	for {
+		err := backfillOwners(ctx, db, client, servicePassword)
+		if err == nil {
+			logger.Info("payment owners backfilled")
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		logger.Warnw("cannot backfill payment owners, retrying", "error", err, "interval", interval)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+
+		interval = min(interval*2, backfillMaxRetryInterval)
+	}
+}
+
+// backfillOwners updates payments batch by batch, the update is idempotent, so a failed pass is started over.
+func backfillOwners(ctx context.Context, db *sql.DB, client rental_service.ClientWithResponsesInterface, servicePassword string) error {
+	lastID := 0
+
+	for {
+		rows, err := db.QueryContext(ctx, `
+			SELECT id, payment_uid, rental_uid
+			FROM payment
+			WHERE username = '' AND id > $1
+			ORDER BY id
+			LIMIT $2`, lastID, backfillBatchSize)
+		if err != nil {
+			return fmt.Errorf("select payments without owner: %w", err)
+		}
+
+		var paymentUUIDs, rentalUUIDs []uuid.UUID
+		for rows.Next() {
+			var paymentUUID uuid.UUID
+			var rentalUUID *uuid.UUID
+
+			err = rows.Scan(&lastID, &paymentUUID, &rentalUUID)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("scan payment: %w", err)
+			}
+
+			paymentUUIDs = append(paymentUUIDs, paymentUUID)
+			if rentalUUID != nil {
+				rentalUUIDs = append(rentalUUIDs, *rentalUUID)
+			}
+		}
+		rows.Close()
+
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("iterate payments: %w", err)
+		}
+
+		if len(paymentUUIDs) == 0 {
+			return nil
+		}
+
+		resp, err := client.FindOwnersWithResponse(ctx, rental_service.OwnersRequest{
+			PaymentUids: &paymentUUIDs,
+			RentalUids:  &rentalUUIDs,
+		}, func(_ context.Context, req *http.Request) error {
+			req.Header.Add("Service-Password", servicePassword)
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("find owners in rental service: %w", err)
+		}
+
+		if resp.JSON200 == nil {
+			return fmt.Errorf("find owners in rental service: unexpected response %d", resp.StatusCode())
+		}
+
+		for _, owner := range *resp.JSON200 {
+			_, err = db.ExecContext(ctx, `
+				UPDATE payment
+				SET username = $1
+				WHERE username = '' AND (payment_uid = $2 OR rental_uid = $3)`,
+				owner.Username, owner.PaymentUid, owner.RentalUid)
+			if err != nil {
+				return fmt.Errorf("update owner of rental %s payments: %w", owner.RentalUid, err)
+			}
+		}
+	}
+}
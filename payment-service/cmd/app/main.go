@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"flag"
@@ -10,13 +11,19 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/auth"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/document/pdf"
 	openapiGenerated "github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/generated/openapi"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/logic"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/openapi"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/provider/fake"
 	repositoryPostgres "github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/repository/postgres"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/requestid"
 	"github.com/pressly/goose/v3"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -48,7 +55,7 @@ func run() error {
 		return fmt.Errorf("init logger: %w", err)
 	}
 
-	db, err := gorm.Open(postgres.Open(cfg.Postgres.toDSN()))
+	db, err := gorm.Open(postgres.Open(cfg.Postgres.toDSN()), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return fmt.Errorf("open postgres connection: %w", err)
 	}
@@ -68,14 +75,39 @@ func run() error {
 		return fmt.Errorf("up migrations: %w", err)
 	}
 
+	provider, err := newProvider(cfg.Provider)
+	if err != nil {
+		return fmt.Errorf("init payment provider: %w", err)
+	}
+
 	repo := repositoryPostgres.New(db)
-	logic := logic.New(repo)
+	paymentLogic := logic.New(repo, provider, models.CancellationPolicy{
+		Refunds: cfg.Cancellation.Refunds,
+	}, models.TaxPolicy(cfg.Taxes))
+	promoLogic := logic.NewPromo(repo)
+	exchangeLogic := logic.NewExchange(repo)
+	invoiceLogic := logic.NewInvoice(repo, pdf.New())
+
+	var relay *outbox.Relay
+	if cfg.Outbox.Interval > 0 {
+		eventsProducer, err := outbox.NewProducer(cfg.Kafka.Brokers, cfg.Kafka.DomainEventsTopic, logger)
+		if err != nil {
+			return fmt.Errorf("init domain events producer: %w", err)
+		}
+		defer eventsProducer.Stop()
+
+		relay = outbox.NewRelay(outbox.NewPostgresStore(db, outboxLockKey), eventsProducer, cfg.Outbox.BatchSize, logger)
+	}
 
 	e := echo.New()
-	e.Use(auth.CreateMiddleware(cfg.JWKsURL, cfg.ServicePassword))
-	server := openapi.New(logic)
+	e.Use(requestid.CreateMiddleware())
+	e.Use(auth.CreateMiddleware(cfg.JWKsURL, cfg.ServicePassword, cfg.AdminRole))
+	server := openapi.New(paymentLogic, promoLogic, exchangeLogic, invoiceLogic)
 	openapiGenerated.RegisterHandlers(e, server)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
@@ -83,6 +115,8 @@ func run() error {
 
 		logger.Info("shutting down")
 
+		cancel()
+
 		rawDB, err := db.DB()
 		if err == nil {
 			rawDB.Close()
@@ -101,6 +135,19 @@ func run() error {
 		return nil
 	})
 
+	g.Go(func() error {
+		return runBackfillOwners(ctx, sqlDB, cfg.Services.Rental, cfg.ServicePassword, logger)
+	})
+
+	if relay != nil {
+		g.Go(func() error {
+			relay.Run(ctx, cfg.Outbox.Interval)
+			return nil
+		})
+	} else {
+		logger.Warn("outbox relay is disabled")
+	}
+
 	if err := g.Wait(); err != nil {
 		return fmt.Errorf("errgroup: %w", err)
 	}
@@ -108,6 +155,15 @@ func run() error {
 	return nil
 }
 
+func newProvider(cfg provider) (*fake.Provider, error) {
+	switch cfg.Name {
+	case "fake":
+		return fake.New(), nil
+	default:
+		return nil, fmt.Errorf("unknown provider %q", cfg.Name)
+	}
+}
+
 func readConfig() (*config, error) {
 	cfgFile := flag.String("config", "/config.yaml", "path to config")
 	flag.Parse()
@@ -178,4 +234,48 @@ type config struct {
 	LogLevel        string
 	JWKsURL         string
 	ServicePassword string
+	AdminRole       string
+	Services        services
+	Cancellation    cancellation
+	Taxes           taxes
+	Provider        provider
+	Kafka           kafka
+	Outbox          outboxRelay
+}
+
+// services are called by the owners backfill, the rental service has owners of payments made before they were stored.
+type services struct {
+	Rental string
+}
+
+type cancellation struct {
+	Refunds []models.RefundRule
+}
+
+// taxes are applied to payments in Jurisdiction unless another one is requested,
+// rates of the country apply to its regions.
+type taxes struct {
+	Mode         models.TaxMode
+	Jurisdiction string
+	Rates        []models.TaxRate
+}
+
+type kafka struct {
+	Brokers           []string
+	DomainEventsTopic string
+}
+
+// outboxLockKey is the key of the advisory lock which lets one replica publish the outbox at a time,
+// keys of the services differ in case they share a database.
+const outboxLockKey int64 = 7_265_301
+
+// outboxRelay publishes domain events, the relay is disabled when the interval is zero.
+type outboxRelay struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// provider selects the payment processor, only the in-process fake is supported yet.
+type provider struct {
+	Name string
 }
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE promo_codes
+(
+    id                SERIAL PRIMARY KEY,
+    code              VARCHAR(40) UNIQUE       NOT NULL,
+    kind              VARCHAR(20)              NOT NULL
+        CHECK (kind IN ('PERCENT', 'FIXED')),
+    value             INT                      NOT NULL,
+    valid_from        TIMESTAMP WITH TIME ZONE NOT NULL,
+    valid_to          TIMESTAMP WITH TIME ZONE,
+    max_uses          INT                      NOT NULL DEFAULT 0,
+    max_uses_per_user INT                      NOT NULL DEFAULT 0,
+    car_types         jsonb                    NOT NULL DEFAULT '[]',
+    min_days          INT                      NOT NULL DEFAULT 0,
+    created_at        TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE TABLE promo_redemptions
+(
+    id            SERIAL PRIMARY KEY,
+    promo_code_id INT                      NOT NULL REFERENCES promo_codes (id),
+    payment_uid   uuid                     NOT NULL,
+    username      VARCHAR(80)              NOT NULL,
+    discount      INT                      NOT NULL,
+    redeemed_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    canceled_at   TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX promo_redemptions_promo_code_idx ON promo_redemptions (promo_code_id, username) WHERE canceled_at IS NULL;
+CREATE INDEX promo_redemptions_payment_uid_idx ON promo_redemptions (payment_uid);
+
+ALTER TABLE payment
+    ADD COLUMN discount   INT         NOT NULL DEFAULT 0,
+    ADD COLUMN promo_code VARCHAR(40) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment
+    DROP COLUMN discount,
+    DROP COLUMN promo_code;
+
+DROP TABLE IF EXISTS promo_redemptions;
+DROP TABLE IF EXISTS promo_codes;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE refunds
+(
+    id          SERIAL PRIMARY KEY,
+    refund_uid  uuid UNIQUE              NOT NULL,
+    payment_uid uuid                     NOT NULL,
+    amount      INT                      NOT NULL
+        CHECK (amount > 0),
+    percent     INT                      NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX refunds_payment_uid_idx ON refunds (payment_uid);
+
+ALTER TABLE payment
+    ADD COLUMN refunded INT NOT NULL DEFAULT 0,
+    DROP CONSTRAINT payment_status_check,
+    ADD CONSTRAINT payment_status_check
+        CHECK (status IN ('PAID', 'CANCELED', 'REFUNDED', 'PARTIALLY_REFUNDED'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE payment
+SET status = 'CANCELED'
+WHERE status IN ('REFUNDED', 'PARTIALLY_REFUNDED');
+
+ALTER TABLE payment
+    DROP COLUMN refunded,
+    DROP CONSTRAINT payment_status_check,
+    ADD CONSTRAINT payment_status_check
+        CHECK (status IN ('PAID', 'CANCELED'));
+
+DROP TABLE IF EXISTS refunds;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE payment
+    ADD COLUMN rental_uid uuid,
+    ADD COLUMN kind       VARCHAR(20) NOT NULL DEFAULT 'RENTAL'
+        CHECK (kind IN ('RENTAL', 'LATE_RETURN', 'MILEAGE', 'REFUEL'));
+
+CREATE INDEX payment_rental_uid_idx ON payment (rental_uid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment
+    DROP COLUMN rental_uid,
+    DROP COLUMN kind;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE payment
+    ADD COLUMN provider_ref   VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN captured       INT          NOT NULL DEFAULT 0,
+    ADD COLUMN failure_reason VARCHAR(255) NOT NULL DEFAULT '',
+    DROP CONSTRAINT payment_status_check,
+    ADD CONSTRAINT payment_status_check
+        CHECK (status IN ('PENDING', 'AUTHORIZED', 'CAPTURED', 'FAILED', 'PAID', 'CANCELED', 'REFUNDED',
+                          'PARTIALLY_REFUNDED'));
+
+UPDATE payment
+SET captured = price
+WHERE status IN ('PAID', 'REFUNDED', 'PARTIALLY_REFUNDED');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE payment
+SET status = 'PAID'
+WHERE status IN ('AUTHORIZED', 'CAPTURED');
+
+UPDATE payment
+SET status = 'CANCELED'
+WHERE status IN ('PENDING', 'FAILED');
+
+ALTER TABLE payment
+    DROP COLUMN provider_ref,
+    DROP COLUMN captured,
+    DROP COLUMN failure_reason,
+    DROP CONSTRAINT payment_status_check,
+    ADD CONSTRAINT payment_status_check
+        CHECK (status IN ('PAID', 'CANCELED', 'REFUNDED', 'PARTIALLY_REFUNDED'));
+-- +goose StatementEnd
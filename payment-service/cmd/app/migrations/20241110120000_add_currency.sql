@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Amounts are stored in minor units of the currency, e.g. kopecks.
+UPDATE payment
+SET price    = price * 100,
+    discount = discount * 100,
+    refunded = refunded * 100,
+    captured = captured * 100;
+
+ALTER TABLE payment
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+UPDATE refunds
+SET amount = amount * 100;
+
+ALTER TABLE refunds
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+UPDATE promo_redemptions
+SET discount = discount * 100;
+
+ALTER TABLE promo_codes
+    ADD COLUMN currency VARCHAR(3) NOT NULL DEFAULT '';
+
+UPDATE promo_codes
+SET value    = value * 100,
+    currency = 'RUB'
+WHERE kind = 'FIXED';
+
+CREATE TABLE exchange_rates
+(
+    currency   CHAR(3) PRIMARY KEY,
+    exponent   INT                      NOT NULL
+        CHECK (exponent >= 0),
+    rate       NUMERIC(20, 10)          NOT NULL
+        CHECK (rate > 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- Rates are relative to RUB and are kept up to date by administrators.
+INSERT INTO exchange_rates (currency, exponent, rate)
+VALUES ('RUB', 2, 1),
+       ('USD', 2, 0.0102),
+       ('EUR', 2, 0.0094),
+       ('KZT', 2, 5.02);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS exchange_rates;
+
+UPDATE promo_codes
+SET value = value / 100
+WHERE kind = 'FIXED';
+
+ALTER TABLE promo_codes
+    DROP COLUMN currency;
+
+UPDATE promo_redemptions
+SET discount = discount / 100;
+
+ALTER TABLE refunds
+    DROP COLUMN currency;
+
+UPDATE refunds
+SET amount = amount / 100;
+
+ALTER TABLE payment
+    DROP COLUMN currency;
+
+UPDATE payment
+SET price    = price / 100,
+    discount = discount / 100,
+    refunded = refunded / 100,
+    captured = captured / 100;
+-- +goose StatementEnd
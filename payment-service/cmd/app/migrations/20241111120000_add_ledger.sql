@@ -0,0 +1,143 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE payment
+    ADD COLUMN username VARCHAR(80) NOT NULL DEFAULT '';
+
+UPDATE payment
+SET username = promo_redemptions.username
+FROM promo_redemptions
+WHERE promo_redemptions.payment_uid = payment.payment_uid;
+
+CREATE TABLE journal_entries
+(
+    id          SERIAL PRIMARY KEY,
+    entry_uid   uuid UNIQUE              NOT NULL,
+    payment_uid uuid                     NOT NULL,
+    rental_uid  uuid,
+    kind        VARCHAR(20)              NOT NULL
+        CHECK (kind IN ('CHARGE', 'FEE', 'DISCOUNT', 'REFUND')),
+    currency    CHAR(3)                  NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX journal_entries_payment_uid_idx ON journal_entries (payment_uid);
+CREATE INDEX journal_entries_rental_uid_idx ON journal_entries (rental_uid);
+
+CREATE TABLE ledger_postings
+(
+    id        SERIAL PRIMARY KEY,
+    entry_uid uuid         NOT NULL REFERENCES journal_entries (entry_uid),
+    account   VARCHAR(120) NOT NULL,
+    debit     INT          NOT NULL DEFAULT 0
+        CHECK (debit >= 0),
+    credit    INT          NOT NULL DEFAULT 0
+        CHECK (credit >= 0),
+    CHECK ((debit > 0) <> (credit > 0))
+);
+
+CREATE INDEX ledger_postings_entry_uid_idx ON ledger_postings (entry_uid);
+CREATE INDEX ledger_postings_account_idx ON ledger_postings (account);
+
+-- The ledger is append-only: corrections are made with new entries.
+CREATE FUNCTION forbid_ledger_change() RETURNS trigger AS
+$$
+BEGIN
+    RAISE EXCEPTION 'ledger is append-only';
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER journal_entries_append_only
+    BEFORE UPDATE OR DELETE
+    ON journal_entries
+    FOR EACH ROW
+EXECUTE FUNCTION forbid_ledger_change();
+
+CREATE TRIGGER ledger_postings_append_only
+    BEFORE UPDATE OR DELETE
+    ON ledger_postings
+    FOR EACH ROW
+EXECUTE FUNCTION forbid_ledger_change();
+
+-- Paid and captured payments are charged the whole price, refunds are taken from the refunds history.
+CREATE TEMPORARY TABLE ledger_backfill AS
+SELECT gen_random_uuid()                                               AS entry_uid,
+       payment_uid,
+       rental_uid,
+       CASE WHEN kind = 'RENTAL' THEN 'CHARGE' ELSE 'FEE' END          AS kind,
+       currency,
+       now()                                                           AS created_at,
+       'customer:' || COALESCE(NULLIF(username, ''), 'unknown')        AS debit_account,
+       'rental:' || COALESCE(rental_uid::TEXT, 'unassigned')           AS credit_account,
+       price + discount                                                AS amount
+FROM payment
+WHERE status IN ('PAID', 'CAPTURED', 'REFUNDED', 'PARTIALLY_REFUNDED')
+  AND price + discount > 0
+UNION ALL
+SELECT gen_random_uuid(),
+       payment_uid,
+       rental_uid,
+       'DISCOUNT',
+       currency,
+       now(),
+       'discounts',
+       'customer:' || COALESCE(NULLIF(username, ''), 'unknown'),
+       discount
+FROM payment
+WHERE status IN ('PAID', 'CAPTURED', 'REFUNDED', 'PARTIALLY_REFUNDED')
+  AND discount > 0
+UNION ALL
+SELECT gen_random_uuid(),
+       payment.payment_uid,
+       payment.rental_uid,
+       'REFUND',
+       refunds.currency,
+       refunds.created_at,
+       'rental:' || COALESCE(payment.rental_uid::TEXT, 'unassigned'),
+       'customer:' || COALESCE(NULLIF(payment.username, ''), 'unknown'),
+       refunds.amount
+FROM refunds
+         JOIN payment ON payment.payment_uid = refunds.payment_uid;
+
+INSERT INTO journal_entries (entry_uid, payment_uid, rental_uid, kind, currency, created_at)
+SELECT entry_uid, payment_uid, rental_uid, kind, currency, created_at
+FROM ledger_backfill
+ORDER BY created_at;
+
+INSERT INTO ledger_postings (entry_uid, account, debit, credit)
+SELECT entry_uid, debit_account, amount, 0
+FROM ledger_backfill
+UNION ALL
+SELECT entry_uid, credit_account, 0, amount
+FROM ledger_backfill;
+
+DROP TABLE ledger_backfill;
+
+ALTER TABLE payment
+    DROP COLUMN captured,
+    DROP COLUMN refunded;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment
+    ADD COLUMN captured INT NOT NULL DEFAULT 0,
+    ADD COLUMN refunded INT NOT NULL DEFAULT 0;
+
+UPDATE payment
+SET refunded = totals.refunded,
+    captured = totals.charged - totals.refunded
+FROM (SELECT journal_entries.payment_uid,
+             SUM(CASE WHEN kind IN ('CHARGE', 'FEE') THEN debit WHEN kind = 'DISCOUNT' THEN -debit ELSE 0 END) AS charged,
+             SUM(CASE WHEN kind = 'REFUND' THEN debit ELSE 0 END)                                               AS refunded
+      FROM journal_entries
+               JOIN ledger_postings ON ledger_postings.entry_uid = journal_entries.entry_uid
+      GROUP BY journal_entries.payment_uid) totals
+WHERE totals.payment_uid = payment.payment_uid;
+
+DROP TABLE IF EXISTS ledger_postings;
+DROP TABLE IF EXISTS journal_entries;
+DROP FUNCTION IF EXISTS forbid_ledger_change();
+
+ALTER TABLE payment
+    DROP COLUMN username;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE SEQUENCE invoice_number_seq;
+
+CREATE TABLE invoices
+(
+    id          SERIAL PRIMARY KEY,
+    invoice_uid uuid UNIQUE              NOT NULL,
+    number      VARCHAR(20) UNIQUE       NOT NULL,
+    rental_uid  uuid UNIQUE              NOT NULL,
+    username    VARCHAR(80)              NOT NULL,
+    currency    CHAR(3)                  NOT NULL,
+    total       INT                      NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    document    BYTEA                    NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS invoices;
+DROP SEQUENCE IF EXISTS invoice_number_seq;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE payment_taxes
+(
+    id           SERIAL PRIMARY KEY,
+    payment_uid  uuid          NOT NULL,
+    jurisdiction VARCHAR(10)   NOT NULL,
+    name         VARCHAR(80)   NOT NULL,
+    percent      NUMERIC(5, 2) NOT NULL,
+    amount       INT           NOT NULL
+        CHECK (amount >= 0),
+    mode         VARCHAR(20)   NOT NULL
+        CHECK (mode IN ('INCLUSIVE', 'EXCLUSIVE'))
+);
+
+CREATE INDEX payment_taxes_payment_uid_idx ON payment_taxes (payment_uid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS payment_taxes;
+-- +goose StatementEnd
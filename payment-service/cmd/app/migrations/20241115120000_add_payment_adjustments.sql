@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Payments were partially refunded only by cancellation before, so refunded payments are canceled.
+ALTER TABLE payment
+    ADD COLUMN canceled_at TIMESTAMP WITH TIME ZONE,
+    DROP CONSTRAINT payment_kind_check,
+    ADD CONSTRAINT payment_kind_check
+        CHECK (kind IN ('RENTAL', 'LATE_RETURN', 'MILEAGE', 'REFUEL', 'EXTENSION'));
+
+UPDATE payment
+SET canceled_at = COALESCE((SELECT MAX(created_at) FROM refunds WHERE refunds.payment_uid = payment.payment_uid), NOW())
+WHERE status IN ('CANCELED', 'REFUNDED', 'PARTIALLY_REFUNDED');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment
+    DROP COLUMN canceled_at,
+    DROP CONSTRAINT payment_kind_check,
+    ADD CONSTRAINT payment_kind_check
+        CHECK (kind IN ('RENTAL', 'LATE_RETURN', 'MILEAGE', 'REFUEL'));
+-- +goose StatementEnd
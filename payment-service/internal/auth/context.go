@@ -11,3 +11,20 @@ func GetUsername(ctx context.Context) string {
 	value, _ := ctx.Value(usernameKey).(string)
 	return value
 }
+
+func GetRoles(ctx context.Context) []string {
+	value, _ := ctx.Value(rolesKey).([]string)
+	return value
+}
+
+// IsPrivileged tells whether the request is made by another service with the service password or by an admin.
+func IsPrivileged(ctx context.Context) bool {
+	value, _ := ctx.Value(privilegedKey).(bool)
+	return value
+}
+
+// WithUser returns the context of the request made by the user, as the middleware sets it.
+func WithUser(ctx context.Context, username string, privileged bool) context.Context {
+	ctx = context.WithValue(ctx, usernameKey, username)
+	return context.WithValue(ctx, privilegedKey, privileged)
+}
@@ -0,0 +1,122 @@
+// Package pdf renders invoices as PDF documents with the core PDF fonts, no font files or external services are needed.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+)
+
+const (
+	font       = "Helvetica"
+	lineHeight = 7
+	// Widths of the description, quantity and amount columns on the A4 page with 15mm margins.
+	descriptionWidth = 120
+	quantityWidth    = 20
+	amountWidth      = 40
+)
+
+type Renderer struct{}
+
+func New() *Renderer {
+	return &Renderer{}
+}
+
+// Render returns the same document for the same invoice, dates in the document metadata are the invoice creation time.
+func (r *Renderer) Render(invoice models.Invoice) ([]byte, error) {
+	doc := fpdf.New("P", "mm", "A4", "")
+	doc.SetMargins(15, 15, 15)
+	doc.SetCatalogSort(true)
+	doc.SetCreationDate(invoice.CreatedAt)
+	doc.SetModificationDate(invoice.CreatedAt)
+	doc.SetTitle("Invoice "+invoice.Number, true)
+	doc.SetAuthor("Car rental", true)
+	doc.AddPage()
+
+	// Core fonts are in cp1252, characters which are not in it are replaced.
+	tr := doc.UnicodeTranslatorFromDescriptor("")
+	money := func(amount int) string {
+		return formatAmount(amount, invoice.Exponent) + " " + invoice.Currency
+	}
+
+	doc.SetFont(font, "B", 18)
+	doc.CellFormat(0, 12, tr("Invoice "+invoice.Number), "", 1, "L", false, 0, "")
+
+	doc.SetFont(font, "", 10)
+	details := invoice.Details
+	for _, row := range [][2]string{
+		{"Date", invoice.CreatedAt.Format(time.DateOnly)},
+		{"Customer", details.Username},
+		{"Rental", invoice.RentalUUID.String()},
+		{"Car", fmt.Sprintf("%s %s (%s)", details.Car.Brand, details.Car.Model, details.Car.Type)},
+		{"Registration number", details.Car.RegistrationNumber},
+		{"Period", details.DateFrom.Format(time.DateOnly) + " - " + details.DateTo.Format(time.DateOnly)},
+	} {
+		doc.CellFormat(45, lineHeight, tr(row[0]), "", 0, "L", false, 0, "")
+		doc.CellFormat(0, lineHeight, tr(row[1]), "", 1, "L", false, 0, "")
+	}
+
+	doc.Ln(lineHeight)
+
+	doc.SetFont(font, "B", 10)
+	doc.SetFillColor(230, 230, 230)
+	doc.CellFormat(descriptionWidth, lineHeight, "Description", "1", 0, "L", true, 0, "")
+	doc.CellFormat(quantityWidth, lineHeight, "Qty", "1", 0, "R", true, 0, "")
+	doc.CellFormat(amountWidth, lineHeight, "Amount", "1", 1, "R", true, 0, "")
+
+	doc.SetFont(font, "", 10)
+	for _, line := range details.Lines {
+		doc.CellFormat(descriptionWidth, lineHeight, tr(line.Description), "1", 0, "L", false, 0, "")
+		doc.CellFormat(quantityWidth, lineHeight, strconv.Itoa(line.Quantity), "1", 0, "R", false, 0, "")
+		doc.CellFormat(amountWidth, lineHeight, money(line.Amount), "1", 1, "R", false, 0, "")
+	}
+
+	totals := [][2]string{{"Subtotal", money(invoice.Subtotal)}}
+	if invoice.Discount > 0 {
+		totals = append(totals, [2]string{"Discount", money(-invoice.Discount)})
+	}
+	for _, tax := range invoice.Taxes {
+		if !tax.Included {
+			totals = append(totals, [2]string{tax.Name, money(tax.Amount)})
+		}
+	}
+	if invoice.Refunded > 0 {
+		totals = append(totals, [2]string{"Refunded", money(-invoice.Refunded)})
+	}
+
+	for _, row := range totals {
+		doc.CellFormat(descriptionWidth+quantityWidth, lineHeight, tr(row[0]), "", 0, "R", false, 0, "")
+		doc.CellFormat(amountWidth, lineHeight, row[1], "", 1, "R", false, 0, "")
+	}
+
+	doc.SetFont(font, "B", 11)
+	doc.CellFormat(descriptionWidth+quantityWidth, lineHeight, "Total paid", "", 0, "R", false, 0, "")
+	doc.CellFormat(amountWidth, lineHeight, money(invoice.Total), "", 1, "R", false, 0, "")
+
+	doc.SetFont(font, "", 10)
+	for _, tax := range invoice.Taxes {
+		if tax.Included {
+			doc.CellFormat(descriptionWidth+quantityWidth, lineHeight, tr("including "+tax.Name), "", 0, "R", false, 0, "")
+			doc.CellFormat(amountWidth, lineHeight, money(tax.Amount), "", 1, "R", false, 0, "")
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err := doc.Output(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("output pdf: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// formatAmount formats the amount in minor units as a decimal number, e.g. 1050000 with exponent 2 as 10500.00.
+func formatAmount(amount, exponent int) string {
+	return strconv.FormatFloat(float64(amount)/math.Pow10(exponent), 'f', exponent, 64)
+}
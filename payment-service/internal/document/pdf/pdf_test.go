@@ -0,0 +1,47 @@
+package pdf
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	invoice := models.Invoice{
+		Number:     "INV-2021-000001",
+		RentalUUID: uuid.New(),
+		Currency:   "RUB",
+		CreatedAt:  time.Date(2021, 10, 11, 12, 0, 0, 0, time.UTC),
+		Details: models.InvoiceRequest{
+			Username: "Test Max",
+			Car:      models.InvoiceCar{Brand: "Mercedes Benz", Model: "GLA 250", RegistrationNumber: "ЛО777Х799", Type: "SEDAN"},
+			DateFrom: time.Date(2021, 10, 8, 0, 0, 0, 0, time.UTC),
+			DateTo:   time.Date(2021, 10, 11, 0, 0, 0, 0, time.UTC),
+			Lines:    []models.InvoiceLine{{Description: "Rental", Quantity: 3, Amount: 1050000}},
+		},
+		Exponent: 2,
+		Subtotal: 1050000,
+		Discount: 105000,
+		Total:    945000,
+		Taxes:    []models.TaxLine{{Name: "VAT 20% (RU)", Amount: 157500, Included: true}},
+	}
+
+	got, err := New().Render(invoice)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(got, []byte("%PDF-")))
+
+	again, err := New().Render(invoice)
+	require.NoError(t, err)
+	assert.Equal(t, got, again)
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "10500.00", formatAmount(1050000, 2))
+	assert.Equal(t, "-1.05", formatAmount(-105, 2))
+	assert.Equal(t, "150", formatAmount(150, 0))
+}
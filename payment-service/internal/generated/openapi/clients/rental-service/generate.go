@@ -0,0 +1,3 @@
+package rental_service
+
+//go:generate oapi-codegen --config=config.yaml ../../../../../../rental-service/api/openapi/rental-service.yaml
@@ -6,24 +6,123 @@ package openapi
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CreatePaymentRequestKind.
+const (
+	CreatePaymentRequestKindEXTENSION  CreatePaymentRequestKind = "EXTENSION"
+	CreatePaymentRequestKindLATERETURN CreatePaymentRequestKind = "LATE_RETURN"
+	CreatePaymentRequestKindMILEAGE    CreatePaymentRequestKind = "MILEAGE"
+	CreatePaymentRequestKindREFUEL     CreatePaymentRequestKind = "REFUEL"
+	CreatePaymentRequestKindRENTAL     CreatePaymentRequestKind = "RENTAL"
+)
+
+// Defines values for InvoiceRequestRentalStatus.
+const (
+	InvoiceRequestRentalStatusCANCELED   InvoiceRequestRentalStatus = "CANCELED"
+	InvoiceRequestRentalStatusFINISHED   InvoiceRequestRentalStatus = "FINISHED"
+	InvoiceRequestRentalStatusINPROGRESS InvoiceRequestRentalStatus = "IN_PROGRESS"
+	InvoiceRequestRentalStatusOVERDUE    InvoiceRequestRentalStatus = "OVERDUE"
+	InvoiceRequestRentalStatusRESERVED   InvoiceRequestRentalStatus = "RESERVED"
+)
+
+// Defines values for JournalEntryKind.
+const (
+	CHARGE   JournalEntryKind = "CHARGE"
+	DISCOUNT JournalEntryKind = "DISCOUNT"
+	FEE      JournalEntryKind = "FEE"
+	REFUND   JournalEntryKind = "REFUND"
+)
+
+// Defines values for PaymentInfoKind.
+const (
+	PaymentInfoKindEXTENSION  PaymentInfoKind = "EXTENSION"
+	PaymentInfoKindLATERETURN PaymentInfoKind = "LATE_RETURN"
+	PaymentInfoKindMILEAGE    PaymentInfoKind = "MILEAGE"
+	PaymentInfoKindREFUEL     PaymentInfoKind = "REFUEL"
+	PaymentInfoKindRENTAL     PaymentInfoKind = "RENTAL"
+)
+
 // Defines values for PaymentInfoStatus.
 const (
-	CANCELED PaymentInfoStatus = "CANCELED"
-	PAID     PaymentInfoStatus = "PAID"
+	PaymentInfoStatusAUTHORIZED        PaymentInfoStatus = "AUTHORIZED"
+	PaymentInfoStatusCANCELED          PaymentInfoStatus = "CANCELED"
+	PaymentInfoStatusCAPTURED          PaymentInfoStatus = "CAPTURED"
+	PaymentInfoStatusFAILED            PaymentInfoStatus = "FAILED"
+	PaymentInfoStatusPAID              PaymentInfoStatus = "PAID"
+	PaymentInfoStatusPARTIALLYREFUNDED PaymentInfoStatus = "PARTIALLY_REFUNDED"
+	PaymentInfoStatusPENDING           PaymentInfoStatus = "PENDING"
+	PaymentInfoStatusREFUNDED          PaymentInfoStatus = "REFUNDED"
+)
+
+// Defines values for PromoCodeRequestKind.
+const (
+	PromoCodeRequestKindFIXED   PromoCodeRequestKind = "FIXED"
+	PromoCodeRequestKindPERCENT PromoCodeRequestKind = "PERCENT"
+)
+
+// Defines values for PromoCodeResponseKind.
+const (
+	PromoCodeResponseKindFIXED   PromoCodeResponseKind = "FIXED"
+	PromoCodeResponseKindPERCENT PromoCodeResponseKind = "PERCENT"
+)
+
+// Defines values for TaxMode.
+const (
+	EXCLUSIVE TaxMode = "EXCLUSIVE"
+	INCLUSIVE TaxMode = "INCLUSIVE"
 )
 
+// ConvertRequest defines model for ConvertRequest.
+type ConvertRequest struct {
+	// Amounts Суммы для пересчета
+	Amounts []Money `json:"amounts"`
+
+	// Currency Код валюты ISO 4217, в которую пересчитываются суммы
+	Currency string `json:"currency"`
+}
+
 // CreatePaymentRequest defines model for CreatePaymentRequest.
 type CreatePaymentRequest struct {
-	// Price Сумма платежа
+	// CarType Тип автомобиля, нужен для проверки ограничений промокода
+	CarType *string `json:"carType,omitempty"`
+
+	// Currency Код валюты платежа ISO 4217
+	Currency string `json:"currency"`
+
+	// Jurisdiction Страна или регион налогообложения, по умолчанию - из настроек сервиса
+	Jurisdiction *string `json:"jurisdiction,omitempty"`
+
+	// Kind Назначение платежа, по умолчанию - оплата аренды
+	Kind *CreatePaymentRequestKind `json:"kind,omitempty"`
+
+	// PaymentMethod Токен способа оплаты для платежного провайдера
+	PaymentMethod *string `json:"paymentMethod,omitempty"`
+
+	// Price Сумма платежа до применения промокода в минимальных единицах валюты. Налоги, не включенные в цену, добавляются к сумме после применения промокода
 	Price int `json:"price"`
+
+	// PromoCode Промокод
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// RentalDays Количество дней аренды, нужно для проверки ограничений промокода
+	RentalDays *int `json:"rentalDays,omitempty"`
+
+	// RentalUid UUID аренды, к которой относится платеж
+	RentalUid *openapi_types.UUID `json:"rentalUid,omitempty"`
+
+	// Username Владелец платежа, если платеж создает администратор или сервис от имени пользователя. Для остальных владельцем становится автор запроса.
+	Username *string `json:"username,omitempty"`
 }
 
+// CreatePaymentRequestKind Назначение платежа, по умолчанию - оплата аренды
+type CreatePaymentRequestKind string
+
 // ErrorDescription defines model for ErrorDescription.
 type ErrorDescription struct {
 	Error string `json:"error"`
@@ -36,21 +135,295 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ExchangeRate defines model for ExchangeRate.
+type ExchangeRate struct {
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// Exponent Количество знаков минимальных единиц валюты
+	Exponent int `json:"exponent"`
+
+	// Rate Стоимость единицы базовой валюты в этой валюте
+	Rate float64 `json:"rate"`
+
+	// UpdatedAt Время обновления курса
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ExchangeRateRequest defines model for ExchangeRateRequest.
+type ExchangeRateRequest struct {
+	// Exponent Количество знаков минимальных единиц валюты
+	Exponent int `json:"exponent"`
+
+	// Rate Стоимость единицы базовой валюты в этой валюте
+	Rate float64 `json:"rate"`
+}
+
+// InvoiceCar defines model for InvoiceCar.
+type InvoiceCar struct {
+	Brand              string `json:"brand"`
+	Model              string `json:"model"`
+	RegistrationNumber string `json:"registrationNumber"`
+	Type               string `json:"type"`
+}
+
+// InvoiceLine defines model for InvoiceLine.
+type InvoiceLine struct {
+	// Amount Сумма в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Description Описание строки
+	Description string `json:"description"`
+
+	// Quantity Количество
+	Quantity int `json:"quantity"`
+}
+
+// InvoiceRequest defines model for InvoiceRequest.
+type InvoiceRequest struct {
+	Car InvoiceCar `json:"car"`
+
+	// Currency Код валюты аренды ISO 4217
+	Currency string `json:"currency"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+
+	// Lines Строки стоимости аренды и дополнительные начисления
+	Lines []InvoiceLine `json:"lines"`
+
+	// RentalStatus Статус аренды, счет формируется только по завершенной или отмененной аренде
+	RentalStatus InvoiceRequestRentalStatus `json:"rentalStatus"`
+}
+
+// InvoiceRequestRentalStatus Статус аренды, счет формируется только по завершенной или отмененной аренде
+type InvoiceRequestRentalStatus string
+
+// JournalEntry defines model for JournalEntry.
+type JournalEntry struct {
+	// Amount Сумма проводки в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// CreatedAt Время проводки
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// EntryUid UUID проводки
+	EntryUid openapi_types.UUID `json:"entryUid"`
+
+	// Kind Вид проводки
+	Kind JournalEntryKind `json:"kind"`
+
+	// PaymentUid UUID платежа
+	PaymentUid openapi_types.UUID `json:"paymentUid"`
+
+	// Postings Дебетуемые и кредитуемые счета
+	Postings []Posting `json:"postings"`
+}
+
+// JournalEntryKind Вид проводки
+type JournalEntryKind string
+
+// LedgerBalance defines model for LedgerBalance.
+type LedgerBalance struct {
+	// Account Счет
+	Account string `json:"account"`
+
+	// Amount Дебет за вычетом кредита в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+}
+
+// Money defines model for Money.
+type Money struct {
+	// Amount Сумма в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+}
+
 // PaymentInfo defines model for PaymentInfo.
 type PaymentInfo struct {
+	// Captured Списанная и не возвращенная сумма по журналу проводок
+	Captured *int `json:"captured,omitempty"`
+
+	// Currency Код валюты платежа ISO 4217
+	Currency string `json:"currency"`
+
+	// Discount Скидка по промокоду, уже вычтенная из суммы платежа
+	Discount *int `json:"discount,omitempty"`
+
+	// FailureReason Причина отказа провайдера
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// Kind Назначение платежа
+	Kind *PaymentInfoKind `json:"kind,omitempty"`
+
 	// PaymentUid UUID платежа
 	PaymentUid openapi_types.UUID `json:"paymentUid"`
 
-	// Price Сумма платежа
+	// Price Сумма платежа в минимальных единицах валюты
 	Price int `json:"price"`
 
+	// PromoCode Примененный промокод
+	PromoCode *string `json:"promoCode,omitempty"`
+
+	// Refunded Возвращенная сумма по журналу проводок
+	Refunded *int `json:"refunded,omitempty"`
+
+	// RentalUid UUID аренды, к которой относится платеж
+	RentalUid *openapi_types.UUID `json:"rentalUid,omitempty"`
+
 	// Status Статус платежа
 	Status PaymentInfoStatus `json:"status"`
+
+	// Taxes Налоги, входящие в сумму платежа
+	Taxes *[]Tax `json:"taxes,omitempty"`
 }
 
+// PaymentInfoKind Назначение платежа
+type PaymentInfoKind string
+
 // PaymentInfoStatus Статус платежа
 type PaymentInfoStatus string
 
+// Posting defines model for Posting.
+type Posting struct {
+	// Account Счет
+	Account string `json:"account"`
+
+	// Credit Сумма по кредиту
+	Credit int `json:"credit"`
+
+	// Debit Сумма по дебету
+	Debit int `json:"debit"`
+}
+
+// PromoCodeRequest defines model for PromoCodeRequest.
+type PromoCodeRequest struct {
+	// CarTypes Типы автомобилей, для которых действует промокод; пустой - для всех
+	CarTypes *[]string `json:"carTypes,omitempty"`
+
+	// Code Промокод
+	Code string `json:"code"`
+
+	// Currency Код валюты ISO 4217, обязателен для фиксированной скидки
+	Currency *string `json:"currency,omitempty"`
+
+	// Kind Тип скидки
+	Kind PromoCodeRequestKind `json:"kind"`
+
+	// MaxUses Общее ограничение использований, 0 - без ограничений
+	MaxUses *int `json:"maxUses,omitempty"`
+
+	// MaxUsesPerUser Ограничение использований одним пользователем, 0 - без ограничений
+	MaxUsesPerUser *int `json:"maxUsesPerUser,omitempty"`
+
+	// MinDays Минимальная длительность аренды в днях
+	MinDays *int `json:"minDays,omitempty"`
+
+	// ValidFrom Начало действия промокода
+	ValidFrom time.Time `json:"validFrom"`
+
+	// ValidTo Окончание действия промокода
+	ValidTo *time.Time `json:"validTo,omitempty"`
+
+	// Value Процент или фиксированная сумма скидки в минимальных единицах валюты
+	Value int `json:"value"`
+}
+
+// PromoCodeRequestKind Тип скидки
+type PromoCodeRequestKind string
+
+// PromoCodeResponse defines model for PromoCodeResponse.
+type PromoCodeResponse struct {
+	// CarTypes Типы автомобилей, для которых действует промокод; пустой - для всех
+	CarTypes *[]string `json:"carTypes,omitempty"`
+
+	// Code Промокод
+	Code string `json:"code"`
+
+	// Currency Код валюты ISO 4217, обязателен для фиксированной скидки
+	Currency *string `json:"currency,omitempty"`
+
+	// Kind Тип скидки
+	Kind PromoCodeResponseKind `json:"kind"`
+
+	// MaxUses Общее ограничение использований, 0 - без ограничений
+	MaxUses *int `json:"maxUses,omitempty"`
+
+	// MaxUsesPerUser Ограничение использований одним пользователем, 0 - без ограничений
+	MaxUsesPerUser *int `json:"maxUsesPerUser,omitempty"`
+
+	// MinDays Минимальная длительность аренды в днях
+	MinDays *int `json:"minDays,omitempty"`
+
+	// Used Количество действующих использований
+	Used int `json:"used"`
+
+	// ValidFrom Начало действия промокода
+	ValidFrom time.Time `json:"validFrom"`
+
+	// ValidTo Окончание действия промокода
+	ValidTo *time.Time `json:"validTo,omitempty"`
+
+	// Value Процент или фиксированная сумма скидки в минимальных единицах валюты
+	Value int `json:"value"`
+}
+
+// PromoCodeResponseKind Тип скидки
+type PromoCodeResponseKind string
+
+// RefundRequest defines model for RefundRequest.
+type RefundRequest struct {
+	// Amount Возвращаемая сумма в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// RentalUid UUID аренды, к которой привязывается платеж в журнале проводок
+	RentalUid *openapi_types.UUID `json:"rentalUid,omitempty"`
+}
+
+// RentalLedger defines model for RentalLedger.
+type RentalLedger struct {
+	// Balances Остатки по счетам
+	Balances []LedgerBalance `json:"balances"`
+
+	// Entries Проводки в порядке создания
+	Entries []JournalEntry `json:"entries"`
+
+	// RentalUid UUID аренды
+	RentalUid openapi_types.UUID `json:"rentalUid"`
+}
+
+// Tax defines model for Tax.
+type Tax struct {
+	// Amount Сумма налога в минимальных единицах валюты
+	Amount int `json:"amount"`
+
+	// Jurisdiction Страна или регион, например RU или RU-MOW
+	Jurisdiction string `json:"jurisdiction"`
+
+	// Mode INCLUSIVE - налог включен в цену, EXCLUSIVE - налог добавлен к цене
+	Mode TaxMode `json:"mode"`
+
+	// Name Название налога
+	Name string `json:"name"`
+
+	// Percent Ставка налога в процентах
+	Percent float32 `json:"percent"`
+}
+
+// TaxMode INCLUSIVE - налог включен в цену, EXCLUSIVE - налог добавлен к цене
+type TaxMode string
+
 // ValidationErrorResponse defines model for ValidationErrorResponse.
 type ValidationErrorResponse struct {
 	// Errors Массив полей с описанием ошибки
@@ -60,20 +433,86 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// CancelParams defines parameters for Cancel.
+type CancelParams struct {
+	// RentalStart Начало аренды
+	RentalStart *time.Time `form:"rentalStart,omitempty" json:"rentalStart,omitempty"`
+
+	// CanceledAt Момент отмены аренды, по умолчанию - текущий
+	CanceledAt *time.Time `form:"canceledAt,omitempty" json:"canceledAt,omitempty"`
+
+	// RentalUid UUID аренды, к которой привязывается платеж в журнале проводок
+	RentalUid *openapi_types.UUID `form:"rentalUid,omitempty" json:"rentalUid,omitempty"`
+}
+
+// CaptureParams defines parameters for Capture.
+type CaptureParams struct {
+	// RentalUid UUID аренды, к которой привязывается платеж в журнале проводок
+	RentalUid *openapi_types.UUID `form:"rentalUid,omitempty" json:"rentalUid,omitempty"`
+}
+
+// SetExchangeRateJSONRequestBody defines body for SetExchangeRate for application/json ContentType.
+type SetExchangeRateJSONRequestBody = ExchangeRateRequest
+
+// CreatePromoCodeJSONRequestBody defines body for CreatePromoCode for application/json ContentType.
+type CreatePromoCodeJSONRequestBody = PromoCodeRequest
+
+// ConvertJSONRequestBody defines body for Convert for application/json ContentType.
+type ConvertJSONRequestBody = ConvertRequest
+
 // CreateJSONRequestBody defines body for Create for application/json ContentType.
 type CreateJSONRequestBody = CreatePaymentRequest
 
+// RefundJSONRequestBody defines body for Refund for application/json ContentType.
+type RefundJSONRequestBody = RefundRequest
+
+// GenerateInvoiceJSONRequestBody defines body for GenerateInvoice for application/json ContentType.
+type GenerateInvoiceJSONRequestBody = InvoiceRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Установить курс валюты
+	// (PUT /api/v1/admin/exchange-rates/{currency})
+	SetExchangeRate(ctx echo.Context, currency string) error
+	// Список промокодов
+	// (GET /api/v1/admin/promo-codes)
+	ListPromoCodes(ctx echo.Context) error
+	// Создать промокод
+	// (POST /api/v1/admin/promo-codes)
+	CreatePromoCode(ctx echo.Context) error
+	// Курсы валют
+	// (GET /api/v1/exchange-rates)
+	ListExchangeRates(ctx echo.Context) error
+	// Пересчитать суммы в другую валюту
+	// (POST /api/v1/exchange-rates/convert)
+	Convert(ctx echo.Context) error
 	// Создать платеж
 	// (POST /api/v1/payment)
 	Create(ctx echo.Context) error
 	// Отмена платежа
 	// (DELETE /api/v1/payment/{paymentUid})
-	Cancel(ctx echo.Context, paymentUid openapi_types.UUID) error
+	Cancel(ctx echo.Context, paymentUid openapi_types.UUID, params CancelParams) error
 	// Информация по платежу
 	// (GET /api/v1/payment/{paymentUid})
 	Get(ctx echo.Context, paymentUid openapi_types.UUID) error
+	// Списать заблокированную сумму
+	// (POST /api/v1/payment/{paymentUid}/capture)
+	Capture(ctx echo.Context, paymentUid openapi_types.UUID, params CaptureParams) error
+	// Подтвердить платеж
+	// (POST /api/v1/payment/{paymentUid}/confirm)
+	Confirm(ctx echo.Context, paymentUid openapi_types.UUID) error
+	// Вернуть часть платежа без отмены
+	// (POST /api/v1/payment/{paymentUid}/refund)
+	Refund(ctx echo.Context, paymentUid openapi_types.UUID) error
+	// Счет по аренде
+	// (GET /api/v1/rentals/{rentalUid}/invoice)
+	GetInvoice(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Сформировать счет по аренде
+	// (POST /api/v1/rentals/{rentalUid}/invoice)
+	GenerateInvoice(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Журнал проводок аренды
+	// (GET /api/v1/rentals/{rentalUid}/ledger)
+	GetRentalLedger(ctx echo.Context, rentalUid openapi_types.UUID) error
 	// Liveness probe
 	// (GET /manage/health)
 	Live(ctx echo.Context) error
@@ -84,6 +523,58 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// SetExchangeRate converts echo context to params.
+func (w *ServerInterfaceWrapper) SetExchangeRate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "currency" -------------
+	var currency string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "currency", ctx.Param("currency"), &currency, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetExchangeRate(ctx, currency)
+	return err
+}
+
+// ListPromoCodes converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPromoCodes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPromoCodes(ctx)
+	return err
+}
+
+// CreatePromoCode converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePromoCode(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePromoCode(ctx)
+	return err
+}
+
+// ListExchangeRates converts echo context to params.
+func (w *ServerInterfaceWrapper) ListExchangeRates(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListExchangeRates(ctx)
+	return err
+}
+
+// Convert converts echo context to params.
+func (w *ServerInterfaceWrapper) Convert(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Convert(ctx)
+	return err
+}
+
 // Create converts echo context to params.
 func (w *ServerInterfaceWrapper) Create(ctx echo.Context) error {
 	var err error
@@ -104,8 +595,31 @@ func (w *ServerInterfaceWrapper) Cancel(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter paymentUid: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelParams
+	// ------------- Optional query parameter "rentalStart" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rentalStart", ctx.QueryParams(), &params.RentalStart)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalStart: %s", err))
+	}
+
+	// ------------- Optional query parameter "canceledAt" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "canceledAt", ctx.QueryParams(), &params.CanceledAt)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter canceledAt: %s", err))
+	}
+
+	// ------------- Optional query parameter "rentalUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rentalUid", ctx.QueryParams(), &params.RentalUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Cancel(ctx, paymentUid)
+	err = w.Handler.Cancel(ctx, paymentUid, params)
 	return err
 }
 
@@ -125,6 +639,111 @@ func (w *ServerInterfaceWrapper) Get(ctx echo.Context) error {
 	return err
 }
 
+// Capture converts echo context to params.
+func (w *ServerInterfaceWrapper) Capture(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "paymentUid" -------------
+	var paymentUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "paymentUid", ctx.Param("paymentUid"), &paymentUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter paymentUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CaptureParams
+	// ------------- Optional query parameter "rentalUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rentalUid", ctx.QueryParams(), &params.RentalUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Capture(ctx, paymentUid, params)
+	return err
+}
+
+// Confirm converts echo context to params.
+func (w *ServerInterfaceWrapper) Confirm(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "paymentUid" -------------
+	var paymentUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "paymentUid", ctx.Param("paymentUid"), &paymentUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter paymentUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Confirm(ctx, paymentUid)
+	return err
+}
+
+// Refund converts echo context to params.
+func (w *ServerInterfaceWrapper) Refund(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "paymentUid" -------------
+	var paymentUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "paymentUid", ctx.Param("paymentUid"), &paymentUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter paymentUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Refund(ctx, paymentUid)
+	return err
+}
+
+// GetInvoice converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInvoice(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInvoice(ctx, rentalUid)
+	return err
+}
+
+// GenerateInvoice converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateInvoice(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GenerateInvoice(ctx, rentalUid)
+	return err
+}
+
+// GetRentalLedger converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRentalLedger(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRentalLedger(ctx, rentalUid)
+	return err
+}
+
 // Live converts echo context to params.
 func (w *ServerInterfaceWrapper) Live(ctx echo.Context) error {
 	var err error
@@ -162,9 +781,20 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.PUT(baseURL+"/api/v1/admin/exchange-rates/:currency", wrapper.SetExchangeRate)
+	router.GET(baseURL+"/api/v1/admin/promo-codes", wrapper.ListPromoCodes)
+	router.POST(baseURL+"/api/v1/admin/promo-codes", wrapper.CreatePromoCode)
+	router.GET(baseURL+"/api/v1/exchange-rates", wrapper.ListExchangeRates)
+	router.POST(baseURL+"/api/v1/exchange-rates/convert", wrapper.Convert)
 	router.POST(baseURL+"/api/v1/payment", wrapper.Create)
 	router.DELETE(baseURL+"/api/v1/payment/:paymentUid", wrapper.Cancel)
 	router.GET(baseURL+"/api/v1/payment/:paymentUid", wrapper.Get)
+	router.POST(baseURL+"/api/v1/payment/:paymentUid/capture", wrapper.Capture)
+	router.POST(baseURL+"/api/v1/payment/:paymentUid/confirm", wrapper.Confirm)
+	router.POST(baseURL+"/api/v1/payment/:paymentUid/refund", wrapper.Refund)
+	router.GET(baseURL+"/api/v1/rentals/:rentalUid/invoice", wrapper.GetInvoice)
+	router.POST(baseURL+"/api/v1/rentals/:rentalUid/invoice", wrapper.GenerateInvoice)
+	router.GET(baseURL+"/api/v1/rentals/:rentalUid/ledger", wrapper.GetRentalLedger)
 	router.GET(baseURL+"/manage/health", wrapper.Live)
 
 }
@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+)
+
+// Exchange converts amounts by the locally maintained exchange rates to display prices in the preferred currency.
+type Exchange struct {
+	repo exchangeRepo
+}
+
+func NewExchange(repo exchangeRepo) *Exchange {
+	return &Exchange{
+		repo: repo,
+	}
+}
+
+func (e *Exchange) List(ctx context.Context) ([]models.ExchangeRate, error) {
+	rates, err := e.repo.ListExchangeRates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list exchange rates in repo: %w", err)
+	}
+
+	return rates, nil
+}
+
+func (e *Exchange) Set(ctx context.Context, rate models.ExchangeRate) (*models.ExchangeRate, error) {
+	err := rate.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate exchange rate: %w", err)
+	}
+
+	rate.UpdatedAt = time.Now().UTC()
+
+	saved, err := e.repo.SaveExchangeRate(ctx, rate)
+	if err != nil {
+		return nil, fmt.Errorf("save exchange rate in repo: %w", err)
+	}
+
+	return saved, nil
+}
+
+func (e *Exchange) Convert(ctx context.Context, amounts []models.Money, currency string) ([]models.Money, error) {
+	list, err := e.repo.ListExchangeRates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list exchange rates in repo: %w", err)
+	}
+
+	rates := make(models.ExchangeRates, len(list))
+	for _, rate := range list {
+		rates[rate.Currency] = rate
+	}
+
+	converted := make([]models.Money, 0, len(amounts))
+	for _, amount := range amounts {
+		money, err := rates.Convert(amount, currency)
+		if err != nil {
+			return nil, fmt.Errorf("convert amount: %w", err)
+		}
+
+		converted = append(converted, money)
+	}
+
+	return converted, nil
+}
+
+type exchangeRepo interface {
+	ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error)
+	SaveExchangeRate(ctx context.Context, rate models.ExchangeRate) (*models.ExchangeRate, error)
+}
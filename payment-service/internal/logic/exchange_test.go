@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/logic/mocks"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestExchangeLogic_Convert(t *testing.T) {
+	rates := []models.ExchangeRate{
+		{Currency: "RUB", Exponent: 2, Rate: 1},
+		{Currency: "USD", Exponent: 2, Rate: 0.01},
+		{Currency: "JPY", Exponent: 0, Rate: 1.5},
+	}
+
+	t.Run("converted", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewExchangeRepo(t)
+		repository.EXPECT().ListExchangeRates(ctx).Return(rates, nil)
+
+		e := NewExchange(repository)
+		got, err := e.Convert(ctx, []models.Money{
+			{Amount: 350000, Currency: "RUB"},
+			{Amount: 1050, Currency: "USD"},
+			{Amount: 10, Currency: "JPY"},
+		}, "USD")
+		require.NoError(t, err)
+		assert.Equal(t, []models.Money{
+			{Amount: 3500, Currency: "USD"},
+			{Amount: 1050, Currency: "USD"},
+			{Amount: 7, Currency: "USD"},
+		}, got)
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewExchangeRepo(t)
+		repository.EXPECT().ListExchangeRates(ctx).Return(rates, nil)
+
+		e := NewExchange(repository)
+		got, err := e.Convert(ctx, []models.Money{{Amount: 100, Currency: "RUB"}}, "EUR")
+		require.ErrorIs(t, err, models.ErrRateNotFound)
+		require.Nil(t, got)
+	})
+}
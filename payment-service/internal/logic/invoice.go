@@ -0,0 +1,169 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+)
+
+// defaultExponent is used for currencies without exchange rate.
+const defaultExponent = 2
+
+// Invoice generates PDF invoices for rentals from the rental ledger.
+type Invoice struct {
+	repo     invoiceRepo
+	renderer invoiceRenderer
+}
+
+func NewInvoice(repo invoiceRepo, renderer invoiceRenderer) *Invoice {
+	return &Invoice{
+		repo:     repo,
+		renderer: renderer,
+	}
+}
+
+// Get returns the invoice generated before.
+func (i *Invoice) Get(ctx context.Context, rentalUUID uuid.UUID, caller models.Caller) (*models.Invoice, error) {
+	err := i.checkOwner(ctx, rentalUUID, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := i.repo.GetInvoice(ctx, rentalUUID)
+	if err != nil {
+		return nil, fmt.Errorf("get invoice from repo: %w", err)
+	}
+
+	return invoice, nil
+}
+
+// Generate creates the rental invoice with the next number of the sequence. The invoice is generated only once
+// the rental is finished or canceled, so it isn't stored while charges and refunds may still change the ledger.
+// If it exists, the stored one is returned and the request is ignored.
+func (i *Invoice) Generate(ctx context.Context, req models.InvoiceRequest, caller models.Caller) (*models.Invoice, error) {
+	err := i.checkOwner(ctx, req.RentalUUID, caller)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := i.repo.GetInvoice(ctx, req.RentalUUID)
+	if err == nil {
+		return invoice, nil
+	}
+	if !errors.Is(err, models.ErrInvoiceNotFound) {
+		return nil, fmt.Errorf("get invoice from repo: %w", err)
+	}
+
+	err = req.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	if !req.Closed() {
+		return nil, fmt.Errorf("rental is %s: %w", req.RentalStatus, models.ErrRentalNotClosed)
+	}
+
+	entries, err := i.repo.ListRentalEntries(ctx, req.RentalUUID)
+	if err != nil {
+		return nil, fmt.Errorf("list rental entries in repo: %w", err)
+	}
+
+	exponent, err := i.exponent(ctx, req.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("get currency exponent: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	invoice = &models.Invoice{
+		UUID:       uuid.New(),
+		RentalUUID: req.RentalUUID,
+		Username:   req.Username,
+		Currency:   req.Currency,
+		CreatedAt:  now,
+		Details:    req,
+		Exponent:   exponent,
+	}
+
+	err = invoice.Settle(entries)
+	if err != nil {
+		return nil, fmt.Errorf("settle invoice: %w", err)
+	}
+
+	taxes, err := i.repo.ListRentalTaxes(ctx, req.RentalUUID)
+	if err != nil {
+		return nil, fmt.Errorf("list rental taxes in repo: %w", err)
+	}
+
+	invoice.AddTaxes(taxes)
+
+	seq, err := i.repo.NextInvoiceNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get next invoice number from repo: %w", err)
+	}
+
+	invoice.Number = models.InvoiceNumber(seq, now)
+
+	invoice.Document, err = i.renderer.Render(*invoice)
+	if err != nil {
+		return nil, fmt.Errorf("render invoice: %w", err)
+	}
+
+	created, err := i.repo.CreateInvoice(ctx, *invoice)
+	if err != nil {
+		if errors.Is(err, models.ErrInvoiceExists) {
+			return i.Get(ctx, req.RentalUUID, caller)
+		}
+
+		return nil, fmt.Errorf("create invoice in repo: %w", err)
+	}
+
+	return created, nil
+}
+
+// checkOwner lets only the users who paid for the rental and privileged callers access its invoice.
+func (i *Invoice) checkOwner(ctx context.Context, rentalUUID uuid.UUID, caller models.Caller) error {
+	owners, err := i.repo.ListRentalOwners(ctx, rentalUUID)
+	if err != nil {
+		return fmt.Errorf("list rental owners from repo: %w", err)
+	}
+
+	if !caller.CanAccessRental(owners) {
+		return fmt.Errorf("check owner: %w", models.ErrForbidden)
+	}
+
+	return nil
+}
+
+func (i *Invoice) exponent(ctx context.Context, currency string) (int, error) {
+	rates, err := i.repo.ListExchangeRates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list exchange rates in repo: %w", err)
+	}
+
+	for _, rate := range rates {
+		if rate.Currency == currency {
+			return rate.Exponent, nil
+		}
+	}
+
+	return defaultExponent, nil
+}
+
+type invoiceRepo interface {
+	GetInvoice(ctx context.Context, rentalUUID uuid.UUID) (*models.Invoice, error)
+	CreateInvoice(ctx context.Context, invoice models.Invoice) (*models.Invoice, error)
+	NextInvoiceNumber(ctx context.Context) (int, error)
+	ListRentalEntries(ctx context.Context, rentalUUID uuid.UUID) ([]models.JournalEntry, error)
+	ListRentalTaxes(ctx context.Context, rentalUUID uuid.UUID) ([]models.Tax, error)
+	ListRentalOwners(ctx context.Context, rentalUUID uuid.UUID) ([]string, error)
+	ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error)
+}
+
+type invoiceRenderer interface {
+	Render(invoice models.Invoice) ([]byte, error)
+}
@@ -0,0 +1,165 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/logic/mocks"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestInvoiceLogic_Generate(t *testing.T) {
+	rentalUUID := uuid.New()
+	owner := models.Caller{Username: "Test Max"}
+
+	req := models.InvoiceRequest{
+		RentalUUID:   rentalUUID,
+		Username:     "Test Max",
+		Car:          models.InvoiceCar{Brand: "Mercedes Benz", Model: "GLA 250", RegistrationNumber: "ЛО777Х799", Type: "SEDAN"},
+		DateFrom:     time.Date(2021, 10, 8, 0, 0, 0, 0, time.UTC),
+		DateTo:       time.Date(2021, 10, 11, 0, 0, 0, 0, time.UTC),
+		Currency:     "RUB",
+		RentalStatus: models.RentalFinished,
+		Lines:        []models.InvoiceLine{{Description: "Rental", Quantity: 3, Amount: 1050000}},
+	}
+
+	payment := models.Payment{
+		UUID:       uuid.New(),
+		Price:      945000,
+		Discount:   105000,
+		Currency:   "RUB",
+		Username:   "Test Max",
+		RentalUUID: &rentalUUID,
+	}
+	entries := append(payment.ChargeEntries(time.Now()), payment.RefundEntry(100000, time.Now()))
+
+	t.Run("generated", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewInvoiceRepo(t)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+		repository.EXPECT().GetInvoice(ctx, rentalUUID).Return(nil, models.ErrInvoiceNotFound)
+		repository.EXPECT().ListRentalEntries(ctx, rentalUUID).Return(entries, nil)
+		repository.EXPECT().ListRentalTaxes(ctx, rentalUUID).Return([]models.Tax{
+			{Jurisdiction: "RU", Name: "VAT", Percent: 20, Amount: 100000, Mode: models.TaxInclusive},
+			{Jurisdiction: "RU", Name: "VAT", Percent: 20, Amount: 57500, Mode: models.TaxInclusive},
+		}, nil)
+		repository.EXPECT().ListExchangeRates(ctx).Return([]models.ExchangeRate{{Currency: "RUB", Exponent: 2, Rate: 1}}, nil)
+		repository.EXPECT().NextInvoiceNumber(ctx).Return(42, nil)
+		repository.EXPECT().CreateInvoice(ctx, mock.Anything).RunAndReturn(func(_ context.Context, invoice models.Invoice) (*models.Invoice, error) {
+			return &invoice, nil
+		})
+
+		renderer := mocks.NewInvoiceRenderer(t)
+		renderer.EXPECT().Render(mock.Anything).Return([]byte("%PDF"), nil)
+
+		got, err := NewInvoice(repository, renderer).Generate(ctx, req, owner)
+		require.NoError(t, err)
+		require.True(t, strings.HasSuffix(got.Number, "-000042"))
+		assert.Equal(t, 1050000, got.Subtotal)
+		assert.Equal(t, 105000, got.Discount)
+		assert.Equal(t, 100000, got.Refunded)
+		assert.Equal(t, 845000, got.Total)
+		assert.Equal(t, []models.TaxLine{{Name: "VAT 20% (RU)", Amount: 157500, Included: true}}, got.Taxes)
+		assert.Equal(t, []byte("%PDF"), got.Document)
+	})
+
+	t.Run("already generated", func(t *testing.T) {
+		ctx := context.Background()
+
+		existing := &models.Invoice{RentalUUID: rentalUUID, Number: "INV-2021-000001"}
+
+		repository := mocks.NewInvoiceRepo(t)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+		repository.EXPECT().GetInvoice(ctx, rentalUUID).Return(existing, nil)
+
+		got, err := NewInvoice(repository, mocks.NewInvoiceRenderer(t)).Generate(ctx, req, owner)
+		require.NoError(t, err)
+		assert.Equal(t, existing, got)
+	})
+
+	t.Run("nothing charged", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewInvoiceRepo(t)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+		repository.EXPECT().GetInvoice(ctx, rentalUUID).Return(nil, models.ErrInvoiceNotFound)
+		repository.EXPECT().ListRentalEntries(ctx, rentalUUID).Return(nil, nil)
+		repository.EXPECT().ListExchangeRates(ctx).Return(nil, nil)
+
+		got, err := NewInvoice(repository, mocks.NewInvoiceRenderer(t)).Generate(ctx, req, owner)
+		require.ErrorIs(t, err, models.ErrNothingToInvoice)
+		require.Nil(t, got)
+	})
+
+	t.Run("generated concurrently", func(t *testing.T) {
+		ctx := context.Background()
+
+		existing := &models.Invoice{RentalUUID: rentalUUID, Number: "INV-2021-000001"}
+
+		repository := mocks.NewInvoiceRepo(t)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+		repository.EXPECT().GetInvoice(ctx, rentalUUID).Return(nil, models.ErrInvoiceNotFound).Once()
+		repository.EXPECT().ListRentalEntries(ctx, rentalUUID).Return(entries, nil)
+		repository.EXPECT().ListRentalTaxes(ctx, rentalUUID).Return(nil, nil)
+		repository.EXPECT().ListExchangeRates(ctx).Return(nil, nil)
+		repository.EXPECT().NextInvoiceNumber(ctx).Return(43, nil)
+		repository.EXPECT().CreateInvoice(ctx, mock.Anything).Return(nil, models.ErrInvoiceExists)
+		repository.EXPECT().GetInvoice(ctx, rentalUUID).Return(existing, nil).Once()
+
+		renderer := mocks.NewInvoiceRenderer(t)
+		renderer.EXPECT().Render(mock.Anything).Return([]byte("%PDF"), nil)
+
+		got, err := NewInvoice(repository, renderer).Generate(ctx, req, owner)
+		require.NoError(t, err)
+		assert.Equal(t, existing, got)
+	})
+
+	t.Run("rental of another user", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewInvoiceRepo(t)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+
+		got, err := NewInvoice(repository, mocks.NewInvoiceRenderer(t)).Generate(ctx, req, models.Caller{Username: "other"})
+		require.ErrorIs(t, err, models.ErrForbidden)
+		require.Nil(t, got)
+	})
+
+	t.Run("rental in progress", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewInvoiceRepo(t)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+		repository.EXPECT().GetInvoice(ctx, rentalUUID).Return(nil, models.ErrInvoiceNotFound)
+
+		active := req
+		active.RentalStatus = "IN_PROGRESS"
+
+		got, err := NewInvoice(repository, mocks.NewInvoiceRenderer(t)).Generate(ctx, active, owner)
+		require.ErrorIs(t, err, models.ErrRentalNotClosed)
+		require.Nil(t, got)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewInvoiceRepo(t)
+		repository.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"Test Max"}, nil)
+		repository.EXPECT().GetInvoice(ctx, rentalUUID).Return(nil, models.ErrInvoiceNotFound)
+
+		invalid := req
+		invalid.Lines = nil
+
+		got, err := NewInvoice(repository, mocks.NewInvoiceRenderer(t)).Generate(ctx, invalid, owner)
+		require.ErrorIs(t, err, models.ErrInvalidInvoice)
+		require.Nil(t, got)
+	})
+}
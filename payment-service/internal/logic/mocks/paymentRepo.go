@@ -9,6 +9,8 @@ import (
 
 	models "github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
 
+	outbox "github.com/polnaya-katuxa/ds-lab-02/outbox"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -25,17 +27,24 @@ func (_m *PaymentRepo) EXPECT() *PaymentRepo_Expecter {
 	return &PaymentRepo_Expecter{mock: &_m.Mock}
 }
 
-// ChangeStatus provides a mock function with given fields: ctx, uid, status
-func (_m *PaymentRepo) ChangeStatus(ctx context.Context, uid uuid.UUID, status models.PaymentStatus) error {
-	ret := _m.Called(ctx, uid, status)
+// Cancel provides a mock function with given fields: ctx, payment, from, refund, events
+func (_m *PaymentRepo) Cancel(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund *models.Refund, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, payment, from, refund)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ChangeStatus")
+		panic("no return value specified for Cancel")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.PaymentStatus) error); ok {
-		r0 = rf(ctx, uid, status)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Payment, models.PaymentStatus, *models.Refund, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, payment, from, refund, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -43,39 +52,55 @@ func (_m *PaymentRepo) ChangeStatus(ctx context.Context, uid uuid.UUID, status m
 	return r0
 }
 
-// PaymentRepo_ChangeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeStatus'
-type PaymentRepo_ChangeStatus_Call struct {
+// PaymentRepo_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type PaymentRepo_Cancel_Call struct {
 	*mock.Call
 }
 
-// ChangeStatus is a helper method to define mock.On call
+// Cancel is a helper method to define mock.On call
 //   - ctx context.Context
-//   - uid uuid.UUID
-//   - status models.PaymentStatus
-func (_e *PaymentRepo_Expecter) ChangeStatus(ctx interface{}, uid interface{}, status interface{}) *PaymentRepo_ChangeStatus_Call {
-	return &PaymentRepo_ChangeStatus_Call{Call: _e.mock.On("ChangeStatus", ctx, uid, status)}
+//   - payment models.Payment
+//   - from models.PaymentStatus
+//   - refund *models.Refund
+//   - events ...outbox.DomainEvent
+func (_e *PaymentRepo_Expecter) Cancel(ctx interface{}, payment interface{}, from interface{}, refund interface{}, events ...interface{}) *PaymentRepo_Cancel_Call {
+	return &PaymentRepo_Cancel_Call{Call: _e.mock.On("Cancel",
+		append([]interface{}{ctx, payment, from, refund}, events...)...)}
 }
 
-func (_c *PaymentRepo_ChangeStatus_Call) Run(run func(ctx context.Context, uid uuid.UUID, status models.PaymentStatus)) *PaymentRepo_ChangeStatus_Call {
+func (_c *PaymentRepo_Cancel_Call) Run(run func(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund *models.Refund, events ...outbox.DomainEvent)) *PaymentRepo_Cancel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.PaymentStatus))
+		variadicArgs := make([]outbox.DomainEvent, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Payment), args[2].(models.PaymentStatus), args[3].(*models.Refund), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *PaymentRepo_ChangeStatus_Call) Return(_a0 error) *PaymentRepo_ChangeStatus_Call {
+func (_c *PaymentRepo_Cancel_Call) Return(_a0 error) *PaymentRepo_Cancel_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *PaymentRepo_ChangeStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.PaymentStatus) error) *PaymentRepo_ChangeStatus_Call {
+func (_c *PaymentRepo_Cancel_Call) RunAndReturn(run func(context.Context, models.Payment, models.PaymentStatus, *models.Refund, ...outbox.DomainEvent) error) *PaymentRepo_Cancel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function with given fields: ctx, payment
-func (_m *PaymentRepo) Create(ctx context.Context, payment models.Payment) (*models.Payment, error) {
-	ret := _m.Called(ctx, payment)
+// Create provides a mock function with given fields: ctx, payment, events
+func (_m *PaymentRepo) Create(ctx context.Context, payment models.Payment, events ...outbox.DomainEvent) (*models.Payment, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, payment)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -83,19 +108,19 @@ func (_m *PaymentRepo) Create(ctx context.Context, payment models.Payment) (*mod
 
 	var r0 *models.Payment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Payment) (*models.Payment, error)); ok {
-		return rf(ctx, payment)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Payment, ...outbox.DomainEvent) (*models.Payment, error)); ok {
+		return rf(ctx, payment, events...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.Payment) *models.Payment); ok {
-		r0 = rf(ctx, payment)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Payment, ...outbox.DomainEvent) *models.Payment); ok {
+		r0 = rf(ctx, payment, events...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Payment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.Payment) error); ok {
-		r1 = rf(ctx, payment)
+	if rf, ok := ret.Get(1).(func(context.Context, models.Payment, ...outbox.DomainEvent) error); ok {
+		r1 = rf(ctx, payment, events...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,13 +136,21 @@ type PaymentRepo_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - payment models.Payment
-func (_e *PaymentRepo_Expecter) Create(ctx interface{}, payment interface{}) *PaymentRepo_Create_Call {
-	return &PaymentRepo_Create_Call{Call: _e.mock.On("Create", ctx, payment)}
+//   - events ...outbox.DomainEvent
+func (_e *PaymentRepo_Expecter) Create(ctx interface{}, payment interface{}, events ...interface{}) *PaymentRepo_Create_Call {
+	return &PaymentRepo_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, payment}, events...)...)}
 }
 
-func (_c *PaymentRepo_Create_Call) Run(run func(ctx context.Context, payment models.Payment)) *PaymentRepo_Create_Call {
+func (_c *PaymentRepo_Create_Call) Run(run func(ctx context.Context, payment models.Payment, events ...outbox.DomainEvent)) *PaymentRepo_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Payment))
+		variadicArgs := make([]outbox.DomainEvent, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Payment), variadicArgs...)
 	})
 	return _c
 }
@@ -127,7 +160,82 @@ func (_c *PaymentRepo_Create_Call) Return(_a0 *models.Payment, _a1 error) *Payme
 	return _c
 }
 
-func (_c *PaymentRepo_Create_Call) RunAndReturn(run func(context.Context, models.Payment) (*models.Payment, error)) *PaymentRepo_Create_Call {
+func (_c *PaymentRepo_Create_Call) RunAndReturn(run func(context.Context, models.Payment, ...outbox.DomainEvent) (*models.Payment, error)) *PaymentRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWithRedemption provides a mock function with given fields: ctx, payment, redemption, events
+func (_m *PaymentRepo) CreateWithRedemption(ctx context.Context, payment models.Payment, redemption models.PromoRedemption, events ...outbox.DomainEvent) (*models.Payment, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, payment, redemption)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithRedemption")
+	}
+
+	var r0 *models.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Payment, models.PromoRedemption, ...outbox.DomainEvent) (*models.Payment, error)); ok {
+		return rf(ctx, payment, redemption, events...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Payment, models.PromoRedemption, ...outbox.DomainEvent) *models.Payment); ok {
+		r0 = rf(ctx, payment, redemption, events...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Payment, models.PromoRedemption, ...outbox.DomainEvent) error); ok {
+		r1 = rf(ctx, payment, redemption, events...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepo_CreateWithRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithRedemption'
+type PaymentRepo_CreateWithRedemption_Call struct {
+	*mock.Call
+}
+
+// CreateWithRedemption is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment models.Payment
+//   - redemption models.PromoRedemption
+//   - events ...outbox.DomainEvent
+func (_e *PaymentRepo_Expecter) CreateWithRedemption(ctx interface{}, payment interface{}, redemption interface{}, events ...interface{}) *PaymentRepo_CreateWithRedemption_Call {
+	return &PaymentRepo_CreateWithRedemption_Call{Call: _e.mock.On("CreateWithRedemption",
+		append([]interface{}{ctx, payment, redemption}, events...)...)}
+}
+
+func (_c *PaymentRepo_CreateWithRedemption_Call) Run(run func(ctx context.Context, payment models.Payment, redemption models.PromoRedemption, events ...outbox.DomainEvent)) *PaymentRepo_CreateWithRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Payment), args[2].(models.PromoRedemption), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PaymentRepo_CreateWithRedemption_Call) Return(_a0 *models.Payment, _a1 error) *PaymentRepo_CreateWithRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepo_CreateWithRedemption_Call) RunAndReturn(run func(context.Context, models.Payment, models.PromoRedemption, ...outbox.DomainEvent) (*models.Payment, error)) *PaymentRepo_CreateWithRedemption_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -191,6 +299,310 @@ func (_c *PaymentRepo_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID
 	return _c
 }
 
+// GetPromoCode provides a mock function with given fields: ctx, code
+func (_m *PaymentRepo) GetPromoCode(ctx context.Context, code string) (*models.PromoCode, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPromoCode")
+	}
+
+	var r0 *models.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.PromoCode, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.PromoCode); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PromoCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepo_GetPromoCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPromoCode'
+type PaymentRepo_GetPromoCode_Call struct {
+	*mock.Call
+}
+
+// GetPromoCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+func (_e *PaymentRepo_Expecter) GetPromoCode(ctx interface{}, code interface{}) *PaymentRepo_GetPromoCode_Call {
+	return &PaymentRepo_GetPromoCode_Call{Call: _e.mock.On("GetPromoCode", ctx, code)}
+}
+
+func (_c *PaymentRepo_GetPromoCode_Call) Run(run func(ctx context.Context, code string)) *PaymentRepo_GetPromoCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentRepo_GetPromoCode_Call) Return(_a0 *models.PromoCode, _a1 error) *PaymentRepo_GetPromoCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepo_GetPromoCode_Call) RunAndReturn(run func(context.Context, string) (*models.PromoCode, error)) *PaymentRepo_GetPromoCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRentalEntries provides a mock function with given fields: ctx, rentalUUID
+func (_m *PaymentRepo) ListRentalEntries(ctx context.Context, rentalUUID uuid.UUID) ([]models.JournalEntry, error) {
+	ret := _m.Called(ctx, rentalUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRentalEntries")
+	}
+
+	var r0 []models.JournalEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.JournalEntry, error)); ok {
+		return rf(ctx, rentalUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.JournalEntry); ok {
+		r0 = rf(ctx, rentalUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.JournalEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, rentalUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepo_ListRentalEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRentalEntries'
+type PaymentRepo_ListRentalEntries_Call struct {
+	*mock.Call
+}
+
+// ListRentalEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rentalUUID uuid.UUID
+func (_e *PaymentRepo_Expecter) ListRentalEntries(ctx interface{}, rentalUUID interface{}) *PaymentRepo_ListRentalEntries_Call {
+	return &PaymentRepo_ListRentalEntries_Call{Call: _e.mock.On("ListRentalEntries", ctx, rentalUUID)}
+}
+
+func (_c *PaymentRepo_ListRentalEntries_Call) Run(run func(ctx context.Context, rentalUUID uuid.UUID)) *PaymentRepo_ListRentalEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PaymentRepo_ListRentalEntries_Call) Return(_a0 []models.JournalEntry, _a1 error) *PaymentRepo_ListRentalEntries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepo_ListRentalEntries_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.JournalEntry, error)) *PaymentRepo_ListRentalEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRentalOwners provides a mock function with given fields: ctx, rentalUUID
+func (_m *PaymentRepo) ListRentalOwners(ctx context.Context, rentalUUID uuid.UUID) ([]string, error) {
+	ret := _m.Called(ctx, rentalUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRentalOwners")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]string, error)); ok {
+		return rf(ctx, rentalUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
+		r0 = rf(ctx, rentalUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, rentalUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepo_ListRentalOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRentalOwners'
+type PaymentRepo_ListRentalOwners_Call struct {
+	*mock.Call
+}
+
+// ListRentalOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rentalUUID uuid.UUID
+func (_e *PaymentRepo_Expecter) ListRentalOwners(ctx interface{}, rentalUUID interface{}) *PaymentRepo_ListRentalOwners_Call {
+	return &PaymentRepo_ListRentalOwners_Call{Call: _e.mock.On("ListRentalOwners", ctx, rentalUUID)}
+}
+
+func (_c *PaymentRepo_ListRentalOwners_Call) Run(run func(ctx context.Context, rentalUUID uuid.UUID)) *PaymentRepo_ListRentalOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PaymentRepo_ListRentalOwners_Call) Return(_a0 []string, _a1 error) *PaymentRepo_ListRentalOwners_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepo_ListRentalOwners_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]string, error)) *PaymentRepo_ListRentalOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refund provides a mock function with given fields: ctx, payment, from, refund, events
+func (_m *PaymentRepo) Refund(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund models.Refund, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, payment, from, refund)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refund")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Payment, models.PaymentStatus, models.Refund, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, payment, from, refund, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentRepo_Refund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refund'
+type PaymentRepo_Refund_Call struct {
+	*mock.Call
+}
+
+// Refund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment models.Payment
+//   - from models.PaymentStatus
+//   - refund models.Refund
+//   - events ...outbox.DomainEvent
+func (_e *PaymentRepo_Expecter) Refund(ctx interface{}, payment interface{}, from interface{}, refund interface{}, events ...interface{}) *PaymentRepo_Refund_Call {
+	return &PaymentRepo_Refund_Call{Call: _e.mock.On("Refund",
+		append([]interface{}{ctx, payment, from, refund}, events...)...)}
+}
+
+func (_c *PaymentRepo_Refund_Call) Run(run func(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund models.Refund, events ...outbox.DomainEvent)) *PaymentRepo_Refund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Payment), args[2].(models.PaymentStatus), args[3].(models.Refund), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PaymentRepo_Refund_Call) Return(_a0 error) *PaymentRepo_Refund_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentRepo_Refund_Call) RunAndReturn(run func(context.Context, models.Payment, models.PaymentStatus, models.Refund, ...outbox.DomainEvent) error) *PaymentRepo_Refund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, payment, from, events
+func (_m *PaymentRepo) Update(ctx context.Context, payment models.Payment, from models.PaymentStatus, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, payment, from)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Payment, models.PaymentStatus, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, payment, from, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentRepo_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type PaymentRepo_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payment models.Payment
+//   - from models.PaymentStatus
+//   - events ...outbox.DomainEvent
+func (_e *PaymentRepo_Expecter) Update(ctx interface{}, payment interface{}, from interface{}, events ...interface{}) *PaymentRepo_Update_Call {
+	return &PaymentRepo_Update_Call{Call: _e.mock.On("Update",
+		append([]interface{}{ctx, payment, from}, events...)...)}
+}
+
+func (_c *PaymentRepo_Update_Call) Run(run func(ctx context.Context, payment models.Payment, from models.PaymentStatus, events ...outbox.DomainEvent)) *PaymentRepo_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Payment), args[2].(models.PaymentStatus), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *PaymentRepo_Update_Call) Return(_a0 error) *PaymentRepo_Update_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentRepo_Update_Call) RunAndReturn(run func(context.Context, models.Payment, models.PaymentStatus, ...outbox.DomainEvent) error) *PaymentRepo_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPaymentRepo creates a new instance of PaymentRepo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentRepo(t interface {
@@ -2,19 +2,35 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
 )
 
+// statusEvents are the events of payments moved to the status by the provider operations.
+var statusEvents = map[models.PaymentStatus]outbox.EventType{
+	models.Authorized: models.PaymentAuthorizedEvent,
+	models.Captured:   models.PaymentCapturedEvent,
+}
+
 type Payment struct {
-	repo paymentRepo
+	repo     paymentRepo
+	provider paymentProvider
+	policy   models.CancellationPolicy
+	taxes    models.TaxPolicy
 }
 
-func New(repo paymentRepo) *Payment {
+func New(repo paymentRepo, provider paymentProvider, policy models.CancellationPolicy, taxes models.TaxPolicy) *Payment {
 	return &Payment{
-		repo: repo,
+		repo:     repo,
+		provider: provider,
+		policy:   policy,
+		taxes:    taxes,
 	}
 }
 
@@ -25,41 +41,547 @@ func (p *Payment) Create(ctx context.Context, req models.CreatePaymentRequest) (
 	}
 
 	paymentToCreate := models.Payment{
-		UUID:   uuid.New(),
-		Price:  req.Price,
-		Status: models.Paid,
+		UUID:       uuid.New(),
+		Price:      req.Price,
+		Currency:   req.Currency,
+		Status:     models.Pending,
+		Username:   req.Username,
+		RentalUUID: req.RentalUUID,
+		Kind:       models.KindRental,
+	}
+
+	if req.Kind != "" {
+		paymentToCreate.Kind = req.Kind
 	}
 
-	payment, err := p.repo.Create(ctx, paymentToCreate)
+	var payment *models.Payment
+	if req.PromoCode != "" {
+		payment, err = p.createWithPromo(ctx, paymentToCreate, req)
+	} else {
+		p.tax(&paymentToCreate, req.Jurisdiction)
+		payment, err = p.repo.Create(ctx, paymentToCreate,
+			models.NewPaymentEvent(models.PaymentCreatedEvent, paymentToCreate, 0, traceContext(ctx)))
+	}
 	if err != nil {
 		return nil, fmt.Errorf("create payment in repo: %w", err)
 	}
 
+	return p.authorize(ctx, payment, req.Method)
+}
+
+// authorize holds the payment amount at the provider. The payment is saved as pending before that,
+// so a declined authorization stays in the history and releases the promo code.
+func (p *Payment) authorize(ctx context.Context, payment *models.Payment, method string) (*models.Payment, error) {
+	if payment.Price == 0 {
+		payment.Status = models.Authorized
+		return p.update(ctx, payment, models.Pending)
+	}
+
+	res, err := p.provider.Authorize(ctx, models.AuthorizeRequest{
+		PaymentUUID: payment.UUID,
+		Amount:      payment.Price,
+		Method:      method,
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("authorize payment: %w", err), p.fail(ctx, payment, err.Error()))
+	}
+
+	payment.ProviderRef = res.Reference
+
+	return p.applyAuthorization(ctx, payment, res)
+}
+
+func (p *Payment) applyAuthorization(ctx context.Context, payment *models.Payment, res *models.ProviderResult) (*models.Payment, error) {
+	switch res.Status {
+	case models.ProviderApproved:
+		payment.Status = models.Authorized
+	case models.ProviderActionRequired:
+		payment.Status = models.Pending
+	default:
+		err := fmt.Errorf("authorize payment: %s (%w)", res.Reason, models.ErrPaymentDeclined)
+		return nil, errors.Join(err, p.fail(ctx, payment, res.Reason))
+	}
+
+	return p.update(ctx, payment, models.Pending)
+}
+
+func (p *Payment) fail(ctx context.Context, payment *models.Payment, reason string) error {
+	payment.Status = models.Failed
+	payment.FailureReason = reason
+
+	err := p.repo.Cancel(ctx, *payment, models.Pending, nil,
+		models.NewPaymentEvent(models.PaymentFailedEvent, *payment, 0, traceContext(ctx)))
+	if err != nil {
+		return fmt.Errorf("mark payment failed in repo: %w", err)
+	}
+
+	return nil
+}
+
+// update saves the payment, the event is published only if the payment is moved to another status.
+func (p *Payment) update(ctx context.Context, payment *models.Payment, from models.PaymentStatus) (*models.Payment, error) {
+	var events []outbox.DomainEvent
+	if eventType, ok := statusEvents[payment.Status]; ok && payment.Status != from {
+		events = append(events, models.NewPaymentEvent(eventType, *payment, 0, traceContext(ctx)))
+	}
+
+	err := p.repo.Update(ctx, *payment, from, events...)
+	if err != nil {
+		return nil, fmt.Errorf("update payment in repo: %w", err)
+	}
+
+	return payment, nil
+}
+
+// Confirm completes the authorization which is pending the customer action, e.g. 3-D Secure.
+func (p *Payment) Confirm(ctx context.Context, uid uuid.UUID, caller models.Caller) (*models.Payment, error) {
+	payment, err := p.Get(ctx, uid, caller)
+	if err != nil {
+		return nil, fmt.Errorf("get payment: %w", err)
+	}
+
+	switch {
+	case payment.Status == models.Authorized:
+		return payment, nil
+	case payment.Status != models.Pending || payment.ProviderRef == "":
+		return nil, fmt.Errorf("confirm %s payment: %w", payment.Status, models.ErrPaymentState)
+	}
+
+	res, err := p.provider.Confirm(ctx, payment.ProviderRef)
+	if err != nil {
+		return nil, fmt.Errorf("confirm payment: %w", err)
+	}
+
+	if res.Status == models.ProviderActionRequired {
+		return payment, nil
+	}
+
+	return p.applyAuthorization(ctx, payment, res)
+}
+
+// Capture charges the authorized amount and posts the charge to the ledger. Repeated captures return the captured payment,
+// as well as captures of payments partially refunded while the rental was active.
+func (p *Payment) Capture(ctx context.Context, uid uuid.UUID, caller models.Caller, rentalUUID *uuid.UUID) (*models.Payment, error) {
+	payment, err := p.Get(ctx, uid, caller)
+	if err != nil {
+		return nil, fmt.Errorf("get payment: %w", err)
+	}
+
+	switch payment.Status {
+	case models.Captured, models.Paid, models.PartiallyRefunded:
+		return payment, nil
+	case models.Authorized:
+	default:
+		return nil, fmt.Errorf("capture %s payment: %w", payment.Status, models.ErrPaymentState)
+	}
+
+	err = p.call(payment, func(reference string) (*models.ProviderResult, error) {
+		return p.provider.Capture(ctx, reference, payment.Price)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("capture payment: %w", err)
+	}
+
+	linkRental(payment, rentalUUID)
+	payment.Status = models.Captured
+	payment.Post(payment.ChargeEntries(time.Now().UTC())...)
+
+	return p.update(ctx, payment, models.Authorized)
+}
+
+// linkRental links the payment to the rental, so its entries are posted to the rental account.
+// Booking payments are made before the rental is created and are linked when they are captured or canceled.
+func linkRental(payment *models.Payment, rentalUUID *uuid.UUID) {
+	if payment.RentalUUID == nil {
+		payment.RentalUUID = rentalUUID
+	}
+}
+
+// call runs the provider operation for the payment. Payments without reference were not made through the provider:
+// they were paid before it was introduced or cost nothing.
+func (p *Payment) call(payment *models.Payment, operation func(reference string) (*models.ProviderResult, error)) error {
+	if payment.ProviderRef == "" {
+		return nil
+	}
+
+	res, err := operation(payment.ProviderRef)
+	if err != nil {
+		return err
+	}
+
+	if res.Status != models.ProviderApproved {
+		return fmt.Errorf("%s (%w)", res.Reason, models.ErrPaymentDeclined)
+	}
+
+	return nil
+}
+
+// createWithPromo applies the promo code discount and records the redemption together with the payment.
+func (p *Payment) createWithPromo(ctx context.Context, payment models.Payment, req models.CreatePaymentRequest) (*models.Payment, error) {
+	promo, err := p.repo.GetPromoCode(ctx, normalizePromoCode(req.PromoCode))
+	if err != nil {
+		if errors.Is(err, models.ErrPromoNotFound) {
+			return nil, fmt.Errorf("get promo code: %w (%w)", promoError(err), models.ErrInvalidPayment)
+		}
+
+		return nil, fmt.Errorf("get promo code: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	fieldErrors := promo.Check(req, now)
+	if len(fieldErrors) > 0 {
+		return nil, fmt.Errorf("check promo code: %w (%w)", fieldErrors, models.ErrInvalidPayment)
+	}
+
+	price := payment.Money(payment.Price)
+
+	discount, err := promo.Discount(price)
+	if err != nil {
+		return nil, fmt.Errorf("apply promo code: %w (%w)", promoError(err), models.ErrInvalidPayment)
+	}
+
+	total, err := price.Sub(discount)
+	if err != nil {
+		return nil, fmt.Errorf("apply promo code: %w", err)
+	}
+
+	payment.Price = total.Amount
+	payment.Discount = discount.Amount
+	payment.PromoCode = promo.Code
+	p.tax(&payment, req.Jurisdiction)
+
+	created, err := p.repo.CreateWithRedemption(ctx, payment, models.PromoRedemption{
+		PromoCodeID: promo.ID,
+		PaymentUUID: payment.UUID,
+		Username:    req.Username,
+		Discount:    discount.Amount,
+		RedeemedAt:  now,
+	}, models.NewPaymentEvent(models.PaymentCreatedEvent, payment, 0, traceContext(ctx)))
+	if err != nil {
+		if errors.Is(err, models.ErrPromoUsedUp) {
+			return nil, fmt.Errorf("redeem promo code: %w (%w)", promoError(err), models.ErrInvalidPayment)
+		}
+
+		return nil, fmt.Errorf("redeem promo code: %w", err)
+	}
+
+	return created, nil
+}
+
+// tax applies the tax policy to the price after the discount. Exclusive taxes increase the authorized amount.
+func (p *Payment) tax(payment *models.Payment, jurisdiction string) {
+	price, taxes := p.taxes.Calculate(payment.Money(payment.Price), jurisdiction)
+	for i := range taxes {
+		taxes[i].PaymentUUID = payment.UUID
+	}
+
+	payment.Price = price.Amount
+	payment.Taxes = taxes
+}
+
+func promoError(err error) models.ValidationErrors {
+	return models.ValidationErrors{{
+		Field: "PromoCode",
+		Error: err.Error(),
+	}}
+}
+
+// Cancel refunds the payment according to the cancellation policy, or voids it if the rental start is unknown.
+// The authorized amount is released instead of refunding, and the part kept by the policy is captured.
+// Repeated cancellations, e.g. from the retry queue, return the result of the first one.
+func (p *Payment) Cancel(ctx context.Context, uid uuid.UUID, caller models.Caller, req models.CancelPaymentRequest) (*models.Payment, error) {
+	payment, err := p.Get(ctx, uid, caller)
+	if err != nil {
+		return nil, fmt.Errorf("get payment: %w", err)
+	}
+
+	if payment.CanceledAt != nil {
+		return payment, nil
+	}
+
+	from := payment.Status
+
+	switch from {
+	case models.Pending, models.Authorized, models.Captured, models.Paid, models.PartiallyRefunded:
+	default:
+		return payment, nil
+	}
+
+	linkRental(payment, req.RentalUUID)
+
+	if req.CanceledAt.IsZero() {
+		req.CanceledAt = time.Now().UTC()
+	}
+
+	percent := 100
+	if req.RentalStart != nil {
+		percent = p.policy.RefundPercent(*req.RentalStart, req.CanceledAt)
+	}
+
+	var refund *models.Refund
+
+	captured := from == models.Captured || from == models.PartiallyRefunded
+
+	switch {
+	case from == models.Pending || (!captured && req.RentalStart == nil):
+		err = p.void(ctx, payment)
+	case from == models.Authorized:
+		refund, err = p.release(ctx, payment, percent)
+	default:
+		refund, err = p.refund(ctx, payment, percent)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cancel payment: %w", err)
+	}
+
+	if refund != nil {
+		refund.UUID = uuid.New()
+		refund.PaymentUUID = payment.UUID
+		refund.Currency = payment.Currency
+		refund.Percent = percent
+		refund.CreatedAt = req.CanceledAt
+	}
+
+	payment.CanceledAt = &req.CanceledAt
+
+	var refunded int
+	if refund != nil {
+		refunded = refund.Amount
+	}
+
+	err = p.repo.Cancel(ctx, *payment, from, refund,
+		models.NewPaymentEvent(models.PaymentCanceledEvent, *payment, refunded, traceContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("cancel payment in repo: %w", err)
+	}
+
 	return payment, nil
 }
 
-func (p *Payment) Cancel(ctx context.Context, uid uuid.UUID) error {
-	err := p.repo.ChangeStatus(ctx, uid, models.Canceled)
+// void cancels the payment without charging or refunding anything.
+func (p *Payment) void(ctx context.Context, payment *models.Payment) error {
+	err := p.call(payment, func(reference string) (*models.ProviderResult, error) {
+		return p.provider.Void(ctx, reference)
+	})
 	if err != nil {
-		return fmt.Errorf("change payment status: %w", err)
+		return fmt.Errorf("void payment: %w", err)
 	}
 
+	payment.Status = models.Canceled
+
 	return nil
 }
 
-func (p *Payment) Get(ctx context.Context, uid uuid.UUID) (*models.Payment, error) {
+// release returns the refundable part of the authorized amount to the customer and captures the rest.
+// In the ledger it is the charge of the whole amount and the refund, like for captured payments.
+func (p *Payment) release(ctx context.Context, payment *models.Payment, percent int) (*models.Refund, error) {
+	price := payment.Money(payment.Price)
+	refund := price.Percent(percent)
+
+	kept, err := price.Sub(refund)
+	if err != nil {
+		return nil, fmt.Errorf("calculate kept amount: %w", err)
+	}
+
+	if kept.Amount == 0 {
+		err = p.call(payment, func(reference string) (*models.ProviderResult, error) {
+			return p.provider.Void(ctx, reference)
+		})
+	} else {
+		err = p.call(payment, func(reference string) (*models.ProviderResult, error) {
+			return p.provider.Capture(ctx, reference, kept.Amount)
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("release payment: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	payment.Status = refundedStatus(percent, refund.Amount, models.Captured)
+	payment.Post(payment.ChargeEntries(now)...)
+
+	if refund.Amount == 0 {
+		return nil, nil
+	}
+
+	payment.Post(payment.RefundEntry(refund.Amount, now))
+
+	return &models.Refund{Amount: refund.Amount}, nil
+}
+
+// refund returns the refundable part of the captured amount to the customer.
+// Payments partially refunded before are refunded from the amount left.
+func (p *Payment) refund(ctx context.Context, payment *models.Payment, percent int) (*models.Refund, error) {
+	base := payment.Price
+	if payment.Status == models.PartiallyRefunded {
+		base = payment.Captured
+	}
+
+	amount := payment.Money(base).Percent(percent).Amount
+	if amount == 0 {
+		return nil, nil
+	}
+
+	err := p.call(payment, func(reference string) (*models.ProviderResult, error) {
+		return p.provider.Refund(ctx, reference, amount)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("refund payment: %w", err)
+	}
+
+	payment.Status = refundedStatus(percent, amount, payment.Status)
+	payment.Post(payment.RefundEntry(amount, time.Now().UTC()))
+
+	return &models.Refund{Amount: amount}, nil
+}
+
+// Refund returns the amount to the customer without canceling the payment, e.g. when the rental is shortened.
+// The authorized amount is captured without the refunded part, like on cancellation.
+func (p *Payment) Refund(ctx context.Context, uid uuid.UUID, caller models.Caller, req models.RefundPaymentRequest) (*models.Payment, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	payment, err := p.Get(ctx, uid, caller)
+	if err != nil {
+		return nil, fmt.Errorf("get payment: %w", err)
+	}
+
+	if payment.CanceledAt != nil {
+		return nil, fmt.Errorf("refund canceled payment: %w", models.ErrPaymentState)
+	}
+
+	from := payment.Status
+	linkRental(payment, req.RentalUUID)
+
+	var refundable int
+	switch from {
+	case models.Authorized:
+		refundable = payment.Price
+	case models.Captured, models.Paid, models.PartiallyRefunded:
+		refundable = payment.Captured
+	default:
+		return nil, fmt.Errorf("refund %s payment: %w", from, models.ErrPaymentState)
+	}
+
+	if req.Amount > refundable {
+		return nil, fmt.Errorf("check amount: %w (%w)", models.ValidationErrors{{
+			Field: "Amount",
+			Error: fmt.Sprintf("amount must not exceed %d left to refund", refundable),
+		}}, models.ErrInvalidPayment)
+	}
+
+	now := time.Now().UTC()
+
+	if from == models.Authorized {
+		kept := payment.Price - req.Amount
+		err = p.call(payment, func(reference string) (*models.ProviderResult, error) {
+			if kept == 0 {
+				return p.provider.Void(ctx, reference)
+			}
+
+			return p.provider.Capture(ctx, reference, kept)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("release payment: %w", err)
+		}
+
+		payment.Post(payment.ChargeEntries(now)...)
+	} else {
+		err = p.call(payment, func(reference string) (*models.ProviderResult, error) {
+			return p.provider.Refund(ctx, reference, req.Amount)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("refund payment: %w", err)
+		}
+	}
+
+	payment.Post(payment.RefundEntry(req.Amount, now))
+
+	err = p.repo.Refund(ctx, *payment, from, models.Refund{
+		UUID:        uuid.New(),
+		PaymentUUID: payment.UUID,
+		Amount:      req.Amount,
+		Currency:    payment.Currency,
+		Percent:     int(math.Round(float64(req.Amount) * 100 / float64(payment.Price))),
+		CreatedAt:   now,
+	}, models.NewPaymentEvent(models.PaymentRefundedEvent, *payment, req.Amount, traceContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("refund payment in repo: %w", err)
+	}
+
+	return payment, nil
+}
+
+func refundedStatus(percent, amount int, otherwise models.PaymentStatus) models.PaymentStatus {
+	switch {
+	case percent >= 100:
+		return models.Refunded
+	case amount > 0:
+		return models.PartiallyRefunded
+	default:
+		return otherwise
+	}
+}
+
+// Get returns the payment if the caller owns it or is privileged.
+func (p *Payment) Get(ctx context.Context, uid uuid.UUID, caller models.Caller) (*models.Payment, error) {
 	payment, err := p.repo.Get(ctx, uid)
 	if err != nil {
 		return nil, fmt.Errorf("get payment from repo: %w", err)
 	}
 
+	if !caller.CanAccess(*payment) {
+		return nil, fmt.Errorf("check owner: %w", models.ErrForbidden)
+	}
+
 	return payment, nil
 }
 
+// RentalLedger returns entries of all payments of the rental with balances of the accounts they moved money between.
+func (p *Payment) RentalLedger(ctx context.Context, rentalUUID uuid.UUID, caller models.Caller) (*models.RentalLedger, error) {
+	owners, err := p.repo.ListRentalOwners(ctx, rentalUUID)
+	if err != nil {
+		return nil, fmt.Errorf("list rental owners from repo: %w", err)
+	}
+
+	if !caller.CanAccessRental(owners) {
+		return nil, fmt.Errorf("check owner: %w", models.ErrForbidden)
+	}
+
+	entries, err := p.repo.ListRentalEntries(ctx, rentalUUID)
+	if err != nil {
+		return nil, fmt.Errorf("list rental entries from repo: %w", err)
+	}
+
+	return &models.RentalLedger{
+		RentalUUID: rentalUUID,
+		Balances:   models.Balances(entries),
+		Entries:    entries,
+	}, nil
+}
+
 //go:generate mockery --all --with-expecter --exported --output mocks/
 
 type paymentRepo interface {
 	Get(ctx context.Context, uid uuid.UUID) (*models.Payment, error)
-	Create(ctx context.Context, payment models.Payment) (*models.Payment, error)
-	ChangeStatus(ctx context.Context, uid uuid.UUID, status models.PaymentStatus) error
+	Create(ctx context.Context, payment models.Payment, events ...outbox.DomainEvent) (*models.Payment, error)
+	Update(ctx context.Context, payment models.Payment, from models.PaymentStatus, events ...outbox.DomainEvent) error
+	Cancel(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund *models.Refund, events ...outbox.DomainEvent) error
+	Refund(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund models.Refund, events ...outbox.DomainEvent) error
+	GetPromoCode(ctx context.Context, code string) (*models.PromoCode, error)
+	CreateWithRedemption(ctx context.Context, payment models.Payment, redemption models.PromoRedemption, events ...outbox.DomainEvent) (*models.Payment, error)
+	ListRentalEntries(ctx context.Context, rentalUUID uuid.UUID) ([]models.JournalEntry, error)
+	ListRentalOwners(ctx context.Context, rentalUUID uuid.UUID) ([]string, error)
+}
+
+type paymentProvider interface {
+	Authorize(ctx context.Context, req models.AuthorizeRequest) (*models.ProviderResult, error)
+	Confirm(ctx context.Context, reference string) (*models.ProviderResult, error)
+	Capture(ctx context.Context, reference string, amount int) (*models.ProviderResult, error)
+	Void(ctx context.Context, reference string) (*models.ProviderResult, error)
+	Refund(ctx context.Context, reference string, amount int) (*models.ProviderResult, error)
 }
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/logic/mocks"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/go-playground/assert.v1"
 )
@@ -19,17 +23,46 @@ func TestPaymentsLogic_Get(t *testing.T) {
 		id := 1
 		uuid := uuid.New()
 		want := &models.Payment{
-			ID:     id,
-			UUID:   uuid,
-			Price:  1000,
-			Status: "PAID",
+			ID:       id,
+			UUID:     uuid,
+			Price:    1000,
+			Status:   "PAID",
+			Username: "user",
 		}
 
 		repository := mocks.NewPaymentRepo(t)
 		repository.EXPECT().Get(ctx, uuid).Return(want, nil)
 
-		p := New(repository)
-		got, err := p.Get(ctx, uuid)
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Get(ctx, uuid, models.Caller{Username: "user"})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("payment of another user", func(t *testing.T) {
+		ctx := context.Background()
+
+		uuid := uuid.New()
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, uuid).Return(&models.Payment{UUID: uuid, Username: "user"}, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Get(ctx, uuid, models.Caller{Username: "other"})
+		require.ErrorIs(t, err, models.ErrForbidden)
+		require.Nil(t, got)
+	})
+
+	t.Run("privileged caller", func(t *testing.T) {
+		ctx := context.Background()
+
+		uuid := uuid.New()
+		want := &models.Payment{UUID: uuid, Username: "user"}
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, uuid).Return(want, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Get(ctx, uuid, models.Caller{Privileged: true})
 		require.NoError(t, err)
 		assert.Equal(t, want, got)
 	})
@@ -41,9 +74,681 @@ func TestPaymentsLogic_Get(t *testing.T) {
 		repository := mocks.NewPaymentRepo(t)
 		repository.EXPECT().Get(ctx, uuid).Return(nil, errors.New("error"))
 
-		p := New(repository)
-		got, err := p.Get(ctx, uuid)
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Get(ctx, uuid, models.Caller{Username: "user"})
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 }
+
+// paymentEvent matches the domain event of the type.
+func paymentEvent(eventType outbox.EventType) any {
+	return mock.MatchedBy(func(event outbox.DomainEvent) bool {
+		return event.Type == eventType && event.Version == models.PaymentEventVersion
+	})
+}
+
+func TestPaymentsLogic_Create(t *testing.T) {
+	req := models.CreatePaymentRequest{
+		Price:    10000,
+		Currency: "RUB",
+		Method:   "token",
+	}
+
+	newRepository := func(t *testing.T, ctx context.Context) *mocks.PaymentRepo {
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Create(ctx, mock.Anything, paymentEvent(models.PaymentCreatedEvent)).RunAndReturn(func(_ context.Context, payment models.Payment, _ ...outbox.DomainEvent) (*models.Payment, error) {
+			assert.Equal(t, models.Pending, payment.Status)
+
+			return &payment, nil
+		})
+
+		return repository
+	}
+
+	t.Run("authorized", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := newRepository(t, ctx)
+		repository.EXPECT().Update(ctx, mock.Anything, models.Pending, paymentEvent(models.PaymentAuthorizedEvent)).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Authorize(ctx, mock.Anything).RunAndReturn(
+			func(_ context.Context, r models.AuthorizeRequest) (*models.ProviderResult, error) {
+				assert.Equal(t, 10000, r.Amount)
+				assert.Equal(t, "token", r.Method)
+
+				return &models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil
+			})
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, models.Authorized, got.Status)
+		assert.Equal(t, "ref", got.ProviderRef)
+	})
+
+	t.Run("confirmation required", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := newRepository(t, ctx)
+		repository.EXPECT().Update(ctx, mock.Anything, models.Pending).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Authorize(ctx, mock.Anything).Return(&models.ProviderResult{
+			Reference: "ref",
+			Status:    models.ProviderActionRequired,
+		}, nil)
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, models.Pending, got.Status)
+		assert.Equal(t, "ref", got.ProviderRef)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := newRepository(t, ctx)
+		repository.EXPECT().Cancel(ctx, mock.Anything, models.Pending, (*models.Refund)(nil), paymentEvent(models.PaymentFailedEvent)).RunAndReturn(
+			func(_ context.Context, payment models.Payment, _ models.PaymentStatus, _ *models.Refund, _ ...outbox.DomainEvent) error {
+				assert.Equal(t, models.Failed, payment.Status)
+				assert.Equal(t, "card declined", payment.FailureReason)
+
+				return nil
+			})
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Authorize(ctx, mock.Anything).Return(&models.ProviderResult{
+			Reference: "ref",
+			Status:    models.ProviderDeclined,
+			Reason:    "card declined",
+		}, nil)
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, req)
+		require.ErrorIs(t, err, models.ErrPaymentDeclined)
+		require.Nil(t, got)
+	})
+
+	t.Run("provider timeout", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := newRepository(t, ctx)
+		repository.EXPECT().Cancel(ctx, mock.Anything, models.Pending, (*models.Refund)(nil), paymentEvent(models.PaymentFailedEvent)).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Authorize(ctx, mock.Anything).Return(nil, models.ErrProviderUnavailable)
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, req)
+		require.ErrorIs(t, err, models.ErrProviderUnavailable)
+		require.Nil(t, got)
+	})
+}
+
+func TestPaymentsLogic_Taxes(t *testing.T) {
+	policy := models.TaxPolicy{
+		Jurisdiction: "RU-MOW",
+		Rates: []models.TaxRate{
+			{Jurisdiction: "RU", Name: "VAT", Percent: 20},
+			{Jurisdiction: "RU-MOW", Name: "city rental tax", Percent: 2},
+			{Jurisdiction: "KZ", Name: "VAT", Percent: 12},
+		},
+	}
+
+	create := func(t *testing.T, policy models.TaxPolicy, req models.CreatePaymentRequest) *models.Payment {
+		ctx := context.Background()
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Create(ctx, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, payment models.Payment, _ ...outbox.DomainEvent) (*models.Payment, error) {
+			return &payment, nil
+		})
+		repository.EXPECT().Update(ctx, mock.Anything, models.Pending, mock.Anything).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Authorize(ctx, mock.Anything).RunAndReturn(
+			func(_ context.Context, r models.AuthorizeRequest) (*models.ProviderResult, error) {
+				return &models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil
+			})
+
+		got, err := New(repository, provider, models.CancellationPolicy{}, policy).Create(ctx, req)
+		require.NoError(t, err)
+
+		return got
+	}
+
+	t.Run("inclusive taxes are extracted from the price", func(t *testing.T) {
+		got := create(t, policy, models.CreatePaymentRequest{Price: 12200, Currency: "RUB"})
+		assert.Equal(t, 12200, got.Price)
+
+		require.Equal(t, 2, len(got.Taxes))
+		assert.Equal(t, 2000, got.Taxes[0].Amount)
+		assert.Equal(t, 200, got.Taxes[1].Amount)
+		assert.Equal(t, models.TaxInclusive, got.Taxes[0].Mode)
+		assert.Equal(t, got.UUID, got.Taxes[0].PaymentUUID)
+	})
+
+	t.Run("exclusive taxes are added to the price", func(t *testing.T) {
+		exclusive := policy
+		exclusive.Mode = models.TaxExclusive
+
+		got := create(t, exclusive, models.CreatePaymentRequest{Price: 10000, Currency: "RUB"})
+		assert.Equal(t, 12200, got.Price)
+		assert.Equal(t, 2, len(got.Taxes))
+	})
+
+	t.Run("requested jurisdiction", func(t *testing.T) {
+		got := create(t, policy, models.CreatePaymentRequest{Price: 11200, Currency: "KZT", Jurisdiction: "KZ"})
+
+		require.Equal(t, 1, len(got.Taxes))
+		assert.Equal(t, "KZ", got.Taxes[0].Jurisdiction)
+		assert.Equal(t, 1200, got.Taxes[0].Amount)
+	})
+
+	t.Run("taxes are posted to tax accounts", func(t *testing.T) {
+		rentalUUID := uuid.New()
+		payment := models.Payment{
+			UUID:       uuid.New(),
+			Price:      12200,
+			Currency:   "RUB",
+			Username:   "user",
+			RentalUUID: &rentalUUID,
+			Taxes: []models.Tax{
+				{Jurisdiction: "RU", Name: "VAT", Amount: 2000},
+				{Jurisdiction: "RU-MOW", Name: "city rental tax", Amount: 200},
+			},
+		}
+
+		payment.Post(payment.ChargeEntries(time.Now())...)
+		payment.Post(payment.RefundEntry(6100, time.Now()))
+
+		for _, entry := range payment.Entries {
+			require.NoError(t, entry.Validate())
+		}
+
+		assert.Equal(t, []models.Balance{
+			{Account: "customer:user", Money: models.Money{Amount: 6100, Currency: "RUB"}},
+			{Account: "rental:" + rentalUUID.String(), Money: models.Money{Amount: -5000, Currency: "RUB"}},
+			{Account: "tax:RU-MOW:city rental tax", Money: models.Money{Amount: -100, Currency: "RUB"}},
+			{Account: "tax:RU:VAT", Money: models.Money{Amount: -1000, Currency: "RUB"}},
+		}, models.Balances(payment.Entries))
+	})
+}
+
+func TestPaymentsLogic_Capture(t *testing.T) {
+	newPayment := func(status models.PaymentStatus) *models.Payment {
+		return &models.Payment{
+			UUID:        uuid.New(),
+			Price:       10000,
+			Currency:    "RUB",
+			Status:      status,
+			ProviderRef: "ref",
+		}
+	}
+
+	t.Run("captured", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Authorized)
+		payment.Username = "user"
+		payment.Discount = 1000
+		rentalUUID := uuid.New()
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+		repository.EXPECT().Update(ctx, mock.Anything, models.Authorized, paymentEvent(models.PaymentCapturedEvent)).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Capture(ctx, "ref", 10000).Return(&models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil)
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Capture(ctx, payment.UUID, models.Caller{Username: "user"}, &rentalUUID)
+		require.NoError(t, err)
+		assert.Equal(t, models.Captured, got.Status)
+		assert.Equal(t, 10000, got.Captured)
+		assert.Equal(t, &rentalUUID, got.RentalUUID)
+
+		require.Equal(t, 2, len(got.Entries))
+		assert.Equal(t, models.EntryCharge, got.Entries[0].Kind)
+		assert.Equal(t, 11000, got.Entries[0].Amount())
+		assert.Equal(t, models.EntryDiscount, got.Entries[1].Kind)
+
+		balances := models.Balances(got.Entries)
+		assert.Equal(t, []models.Balance{
+			{Account: "customer:user", Money: models.Money{Amount: 10000, Currency: "RUB"}},
+			{Account: "discounts", Money: models.Money{Amount: 1000, Currency: "RUB"}},
+			{Account: "rental:" + rentalUUID.String(), Money: models.Money{Amount: -11000, Currency: "RUB"}},
+		}, balances)
+	})
+
+	t.Run("declined", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Authorized)
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Capture(ctx, "ref", 10000).Return(&models.ProviderResult{Status: models.ProviderDeclined}, nil)
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		_, err := p.Capture(ctx, payment.UUID, models.Caller{Privileged: true}, nil)
+		require.ErrorIs(t, err, models.ErrPaymentDeclined)
+	})
+
+	t.Run("pending payment can't be captured", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Pending)
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		_, err := p.Capture(ctx, payment.UUID, models.Caller{Privileged: true}, nil)
+		require.ErrorIs(t, err, models.ErrPaymentState)
+	})
+
+	t.Run("payment of another user", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Authorized)
+		payment.Username = "user"
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		_, err := p.Capture(ctx, payment.UUID, models.Caller{Username: "other"}, nil)
+		require.ErrorIs(t, err, models.ErrForbidden)
+	})
+}
+
+func TestPaymentsLogic_CreateWithPromo(t *testing.T) {
+	newPromo := func() *models.PromoCode {
+		return &models.PromoCode{
+			ID:        1,
+			Code:      "SUMMER",
+			Kind:      models.PromoPercent,
+			Value:     10,
+			ValidFrom: time.Now().Add(-time.Hour),
+			CarTypes:  []string{"SEDAN"},
+			MinDays:   2,
+		}
+	}
+
+	newRequest := func() models.CreatePaymentRequest {
+		return models.CreatePaymentRequest{
+			Price:      10000,
+			Currency:   "RUB",
+			PromoCode:  "summer",
+			Username:   "user",
+			CarType:    "SEDAN",
+			RentalDays: 3,
+		}
+	}
+
+	t.Run("discount applied", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().GetPromoCode(ctx, "SUMMER").Return(newPromo(), nil)
+		repository.EXPECT().CreateWithRedemption(ctx, mock.Anything, mock.Anything, paymentEvent(models.PaymentCreatedEvent)).RunAndReturn(
+			func(_ context.Context, payment models.Payment, redemption models.PromoRedemption, _ ...outbox.DomainEvent) (*models.Payment, error) {
+				assert.Equal(t, payment.UUID, redemption.PaymentUUID)
+				assert.Equal(t, 1, redemption.PromoCodeID)
+				assert.Equal(t, "user", redemption.Username)
+				assert.Equal(t, 1000, redemption.Discount)
+
+				return &payment, nil
+			})
+		repository.EXPECT().Update(ctx, mock.Anything, models.Pending, mock.Anything).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Authorize(ctx, mock.Anything).RunAndReturn(
+			func(_ context.Context, req models.AuthorizeRequest) (*models.ProviderResult, error) {
+				assert.Equal(t, 9000, req.Amount)
+
+				return &models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil
+			})
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, newRequest())
+		require.NoError(t, err)
+		assert.Equal(t, models.Authorized, got.Status)
+		assert.Equal(t, 9000, got.Price)
+		assert.Equal(t, 1000, got.Discount)
+		assert.Equal(t, "SUMMER", got.PromoCode)
+	})
+
+	t.Run("restrictions not met", func(t *testing.T) {
+		ctx := context.Background()
+
+		req := newRequest()
+		req.CarType = "SUV"
+		req.RentalDays = 1
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().GetPromoCode(ctx, "SUMMER").Return(newPromo(), nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, req)
+		require.ErrorIs(t, err, models.ErrInvalidPayment)
+		require.Nil(t, got)
+
+		var fieldErrors models.ValidationErrors
+		require.ErrorAs(t, err, &fieldErrors)
+		assert.Equal(t, 2, len(fieldErrors))
+	})
+
+	t.Run("fixed discount in another currency", func(t *testing.T) {
+		ctx := context.Background()
+
+		promo := newPromo()
+		promo.Kind = models.PromoFixed
+		promo.Value = 50000
+		promo.Currency = "USD"
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().GetPromoCode(ctx, "SUMMER").Return(promo, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, newRequest())
+		require.ErrorIs(t, err, models.ErrInvalidPayment)
+		require.Nil(t, got)
+
+		var fieldErrors models.ValidationErrors
+		require.ErrorAs(t, err, &fieldErrors)
+		assert.Equal(t, "Currency", fieldErrors[0].Field)
+	})
+
+	t.Run("usage limit reached", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().GetPromoCode(ctx, "SUMMER").Return(newPromo(), nil)
+		repository.EXPECT().CreateWithRedemption(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, models.ErrPromoUsedUp)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Create(ctx, newRequest())
+		require.ErrorIs(t, err, models.ErrInvalidPayment)
+		require.Nil(t, got)
+	})
+}
+
+func TestPaymentsLogic_Cancel(t *testing.T) {
+	policy := models.CancellationPolicy{
+		Refunds: []models.RefundRule{
+			{Before: 0, Percent: 50},
+			{Before: 24 * time.Hour, Percent: 100},
+		},
+	}
+	start := time.Date(2024, 11, 10, 0, 0, 0, 0, time.UTC)
+	owner := models.Caller{Username: "user"}
+
+	newPayment := func(status models.PaymentStatus) *models.Payment {
+		payment := &models.Payment{
+			UUID:     uuid.New(),
+			Price:    10000,
+			Currency: "RUB",
+			Status:   status,
+			Username: "user",
+		}
+
+		if status == models.Paid {
+			payment.Post(payment.ChargeEntries(start.AddDate(0, 0, -7))...)
+		}
+
+		return payment
+	}
+
+	tests := map[string]struct {
+		canceledAt time.Time
+		status     models.PaymentStatus
+		refunded   int
+	}{
+		"free cancellation":      {start.Add(-48 * time.Hour), models.Refunded, 10000},
+		"less than day to start": {start.Add(-time.Hour), models.PartiallyRefunded, 5000},
+		"after pickup":           {start.Add(time.Hour), models.Paid, 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			payment := newPayment(models.Paid)
+
+			repository := mocks.NewPaymentRepo(t)
+			repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+			repository.EXPECT().Cancel(ctx, mock.Anything, models.Paid, mock.Anything, paymentEvent(models.PaymentCanceledEvent)).RunAndReturn(
+				func(_ context.Context, payment models.Payment, _ models.PaymentStatus, refund *models.Refund, _ ...outbox.DomainEvent) error {
+					assert.Equal(t, tt.status, payment.Status)
+					if tt.refunded == 0 {
+						assert.Equal(t, (*models.Refund)(nil), refund)
+						assert.Equal(t, 1, len(payment.Entries))
+					} else {
+						assert.Equal(t, tt.refunded, refund.Amount)
+						assert.Equal(t, models.EntryRefund, payment.Entries[1].Kind)
+						assert.Equal(t, tt.refunded, payment.Entries[1].Amount())
+					}
+
+					return nil
+				})
+
+			p := New(repository, mocks.NewPaymentProvider(t), policy, models.TaxPolicy{})
+			got, err := p.Cancel(ctx, payment.UUID, owner, models.CancelPaymentRequest{
+				RentalStart: &start,
+				CanceledAt:  tt.canceledAt,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.refunded, got.Refunded)
+		})
+	}
+
+	t.Run("voided without rental start", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Paid)
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+		repository.EXPECT().Cancel(ctx, mock.Anything, models.Paid, (*models.Refund)(nil), paymentEvent(models.PaymentCanceledEvent)).Return(nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), policy, models.TaxPolicy{})
+		got, err := p.Cancel(ctx, payment.UUID, owner, models.CancelPaymentRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, models.Canceled, got.Status)
+	})
+
+	t.Run("authorized amount released", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Authorized)
+		payment.ProviderRef = "ref"
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+		repository.EXPECT().Cancel(ctx, mock.Anything, models.Authorized, mock.Anything, paymentEvent(models.PaymentCanceledEvent)).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Capture(ctx, "ref", 5000).Return(&models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil)
+
+		p := New(repository, provider, policy, models.TaxPolicy{})
+		got, err := p.Cancel(ctx, payment.UUID, owner, models.CancelPaymentRequest{
+			RentalStart: &start,
+			CanceledAt:  start.Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.PartiallyRefunded, got.Status)
+		assert.Equal(t, 5000, got.Captured)
+		assert.Equal(t, 5000, got.Refunded)
+	})
+
+	t.Run("authorization voided", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Authorized)
+		payment.ProviderRef = "ref"
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+		repository.EXPECT().Cancel(ctx, mock.Anything, models.Authorized, (*models.Refund)(nil), paymentEvent(models.PaymentCanceledEvent)).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Void(ctx, "ref").Return(&models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil)
+
+		p := New(repository, provider, policy, models.TaxPolicy{})
+		got, err := p.Cancel(ctx, payment.UUID, owner, models.CancelPaymentRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, models.Canceled, got.Status)
+	})
+
+	t.Run("already refunded", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Refunded)
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), policy, models.TaxPolicy{})
+		got, err := p.Cancel(ctx, payment.UUID, owner, models.CancelPaymentRequest{RentalStart: &start})
+		require.NoError(t, err)
+		assert.Equal(t, payment, got)
+	})
+
+	t.Run("payment of another user", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Paid)
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), policy, models.TaxPolicy{})
+		got, err := p.Cancel(ctx, payment.UUID, models.Caller{Username: "other"}, models.CancelPaymentRequest{})
+		require.ErrorIs(t, err, models.ErrForbidden)
+		require.Nil(t, got)
+	})
+
+	t.Run("rest of partially refunded", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Paid)
+		payment.Post(payment.RefundEntry(2000, start.AddDate(0, 0, -3)))
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+		repository.EXPECT().Cancel(ctx, mock.Anything, models.PartiallyRefunded, mock.Anything, paymentEvent(models.PaymentCanceledEvent)).Return(nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), policy, models.TaxPolicy{})
+		got, err := p.Cancel(ctx, payment.UUID, owner, models.CancelPaymentRequest{
+			RentalStart: &start,
+			CanceledAt:  start.Add(-48 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.Refunded, got.Status)
+		assert.Equal(t, 10000, got.Refunded)
+		require.NotNil(t, got.CanceledAt)
+	})
+
+	t.Run("already canceled", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Paid)
+		payment.Post(payment.RefundEntry(5000, start.Add(-time.Hour)))
+		payment.CanceledAt = lo.ToPtr(start.Add(-time.Hour))
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), policy, models.TaxPolicy{})
+		got, err := p.Cancel(ctx, payment.UUID, owner, models.CancelPaymentRequest{RentalStart: &start})
+		require.NoError(t, err)
+		assert.Equal(t, payment, got)
+	})
+}
+
+func TestPaymentsLogic_Refund(t *testing.T) {
+	owner := models.Caller{Username: "user"}
+	rentalUUID := uuid.New()
+
+	newPayment := func(status models.PaymentStatus) *models.Payment {
+		payment := &models.Payment{
+			UUID:        uuid.New(),
+			Price:       10000,
+			Currency:    "RUB",
+			Status:      status,
+			Username:    "user",
+			ProviderRef: "ref",
+		}
+
+		if status == models.Captured {
+			payment.Post(payment.ChargeEntries(time.Now().UTC())...)
+		}
+
+		return payment
+	}
+
+	t.Run("authorized amount captured without refund", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Authorized)
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+		repository.EXPECT().Refund(ctx, mock.Anything, models.Authorized, mock.Anything, mock.Anything).RunAndReturn(
+			func(_ context.Context, _ models.Payment, _ models.PaymentStatus, refund models.Refund, events ...outbox.DomainEvent) error {
+				assert.Equal(t, 3000, refund.Amount)
+				assert.Equal(t, models.PaymentRefundedEvent, events[0].Type)
+				assert.Equal(t, 3000, events[0].Data.(models.PaymentEventData).Amount)
+				assert.Equal(t, 30, refund.Percent)
+				return nil
+			})
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Capture(ctx, "ref", 7000).Return(&models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil)
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Refund(ctx, payment.UUID, owner, models.RefundPaymentRequest{Amount: 3000, RentalUUID: &rentalUUID})
+		require.NoError(t, err)
+		assert.Equal(t, models.PartiallyRefunded, got.Status)
+		assert.Equal(t, 7000, got.Captured)
+		assert.Equal(t, 3000, got.Refunded)
+		assert.Equal(t, &rentalUUID, got.RentalUUID)
+		assert.Equal(t, (*time.Time)(nil), got.CanceledAt)
+	})
+
+	t.Run("captured amount refunded", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Captured)
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+		repository.EXPECT().Refund(ctx, mock.Anything, models.Captured, mock.Anything, paymentEvent(models.PaymentRefundedEvent)).Return(nil)
+
+		provider := mocks.NewPaymentProvider(t)
+		provider.EXPECT().Refund(ctx, "ref", 10000).Return(&models.ProviderResult{Reference: "ref", Status: models.ProviderApproved}, nil)
+
+		p := New(repository, provider, models.CancellationPolicy{}, models.TaxPolicy{})
+		got, err := p.Refund(ctx, payment.UUID, owner, models.RefundPaymentRequest{Amount: 10000})
+		require.NoError(t, err)
+		assert.Equal(t, models.Refunded, got.Status)
+	})
+
+	t.Run("more than left to refund", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Captured)
+		payment.Post(payment.RefundEntry(8000, time.Now().UTC()))
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		_, err := p.Refund(ctx, payment.UUID, owner, models.RefundPaymentRequest{Amount: 3000})
+		require.ErrorIs(t, err, models.ErrInvalidPayment)
+	})
+
+	t.Run("canceled payment", func(t *testing.T) {
+		ctx := context.Background()
+		payment := newPayment(models.Captured)
+		payment.CanceledAt = lo.ToPtr(time.Now().UTC())
+
+		repository := mocks.NewPaymentRepo(t)
+		repository.EXPECT().Get(ctx, payment.UUID).Return(payment, nil)
+
+		p := New(repository, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{})
+		_, err := p.Refund(ctx, payment.UUID, owner, models.RefundPaymentRequest{Amount: 3000})
+		require.ErrorIs(t, err, models.ErrPaymentState)
+	})
+}
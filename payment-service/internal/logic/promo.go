@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+)
+
+type Promo struct {
+	repo promoRepo
+}
+
+func NewPromo(repo promoRepo) *Promo {
+	return &Promo{
+		repo: repo,
+	}
+}
+
+func (p *Promo) Create(ctx context.Context, promo models.PromoCode) (*models.PromoCode, error) {
+	promo.Code = normalizePromoCode(promo.Code)
+	promo.CreatedAt = time.Now().UTC()
+
+	err := promo.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate promo code: %w", err)
+	}
+
+	created, err := p.repo.CreatePromoCode(ctx, promo)
+	if err != nil {
+		return nil, fmt.Errorf("create promo code in repo: %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *Promo) List(ctx context.Context) ([]models.PromoCodeUsage, error) {
+	promos, err := p.repo.ListPromoCodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list promo codes in repo: %w", err)
+	}
+
+	return promos, nil
+}
+
+// normalizePromoCode makes codes case-insensitive for users.
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+type promoRepo interface {
+	CreatePromoCode(ctx context.Context, promo models.PromoCode) (*models.PromoCode, error)
+	ListPromoCodes(ctx context.Context) ([]models.PromoCodeUsage, error)
+}
@@ -0,0 +1,16 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/requestid"
+)
+
+// traceContext links events to the request being handled.
+func traceContext(ctx context.Context) outbox.TraceContext {
+	return outbox.TraceContext{
+		RequestID:   requestid.Get(ctx),
+		Traceparent: requestid.Traceparent(ctx),
+	}
+}
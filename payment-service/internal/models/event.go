@@ -0,0 +1,96 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+)
+
+// EventSource is the name of the service in the envelope of its domain events.
+const EventSource = "payment-service"
+
+const (
+	PaymentCreatedEvent    outbox.EventType = "PaymentCreated"
+	PaymentAuthorizedEvent outbox.EventType = "PaymentAuthorized"
+	PaymentCapturedEvent   outbox.EventType = "PaymentCaptured"
+	PaymentFailedEvent     outbox.EventType = "PaymentFailed"
+	PaymentCanceledEvent   outbox.EventType = "PaymentCanceled"
+	PaymentRefundedEvent   outbox.EventType = "PaymentRefunded"
+)
+
+// PaymentEventVersion is the version of PaymentEventData, it is increased on incompatible changes of the payload.
+const PaymentEventVersion = 1
+
+// PaymentEventData is the state of the payment after the change. Amount is the refunded amount for refunds
+// and cancellations.
+type PaymentEventData struct {
+	PaymentUID    uuid.UUID     `json:"payment_uid"`
+	RentalUID     *uuid.UUID    `json:"rental_uid,omitempty"`
+	Username      string        `json:"username,omitempty"`
+	Kind          PaymentKind   `json:"kind"`
+	Status        PaymentStatus `json:"status"`
+	Price         int           `json:"price"`
+	Discount      int           `json:"discount,omitempty"`
+	PromoCode     string        `json:"promo_code,omitempty"`
+	Currency      string        `json:"currency"`
+	Captured      int           `json:"captured"`
+	Refunded      int           `json:"refunded"`
+	Amount        int           `json:"amount,omitempty"`
+	FailureReason string        `json:"failure_reason,omitempty"`
+	CanceledAt    *time.Time    `json:"canceled_at,omitempty"`
+	Taxes         []EventTax    `json:"taxes,omitempty"`
+}
+
+// EventTax is the tax included in the payment price.
+type EventTax struct {
+	Jurisdiction string  `json:"jurisdiction"`
+	Name         string  `json:"name"`
+	Percent      float64 `json:"percent"`
+	Amount       int     `json:"amount"`
+	Mode         TaxMode `json:"mode"`
+}
+
+func NewPaymentEvent(eventType outbox.EventType, payment Payment, amount int, trace outbox.TraceContext) outbox.DomainEvent {
+	return outbox.DomainEvent{
+		ID:          uuid.New(),
+		Type:        eventType,
+		Version:     PaymentEventVersion,
+		Source:      EventSource,
+		AggregateID: payment.UUID,
+		OccurredAt:  time.Now().UTC(),
+		Trace:       trace,
+		Data: PaymentEventData{
+			PaymentUID:    payment.UUID,
+			RentalUID:     payment.RentalUUID,
+			Username:      payment.Username,
+			Kind:          payment.Kind,
+			Status:        payment.Status,
+			Price:         payment.Price,
+			Discount:      payment.Discount,
+			PromoCode:     payment.PromoCode,
+			Currency:      payment.Currency,
+			Captured:      payment.Captured,
+			Refunded:      payment.Refunded,
+			Amount:        amount,
+			FailureReason: payment.FailureReason,
+			CanceledAt:    payment.CanceledAt,
+			Taxes:         eventTaxes(payment.Taxes),
+		},
+	}
+}
+
+func eventTaxes(taxes []Tax) []EventTax {
+	result := make([]EventTax, 0, len(taxes))
+	for _, tax := range taxes {
+		result = append(result, EventTax{
+			Jurisdiction: tax.Jurisdiction,
+			Name:         tax.Name,
+			Percent:      tax.Percent,
+			Amount:       tax.Amount,
+			Mode:         tax.Mode,
+		})
+	}
+
+	return result
+}
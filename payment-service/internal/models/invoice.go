@@ -0,0 +1,159 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvoiceNotFound  = errors.New("invoice not found")
+	ErrInvalidInvoice   = errors.New("invalid invoice")
+	ErrInvoiceExists    = errors.New("invoice already exists")
+	ErrNothingToInvoice = errors.New("no money was charged for the rental")
+	ErrRentalNotClosed  = errors.New("rental is not finished or canceled")
+)
+
+// InvoiceCar describes the rented car on the invoice.
+type InvoiceCar struct {
+	Brand              string
+	Model              string
+	RegistrationNumber string
+	Type               string
+}
+
+// InvoiceLine is a line of the rental price or an extra charge, amounts are in minor units of the invoice currency.
+type InvoiceLine struct {
+	Description string `validate:"required"`
+	Quantity    int    `validate:"gte=0"`
+	Amount      int
+}
+
+// Rental statuses of rental service, the invoice is generated only for closed rentals.
+const (
+	RentalFinished = "FINISHED"
+	RentalCanceled = "CANCELED"
+)
+
+// InvoiceRequest has rental details which are not known to the payment service.
+// Money movements are taken from the rental ledger.
+type InvoiceRequest struct {
+	RentalUUID   uuid.UUID
+	Username     string
+	Car          InvoiceCar
+	DateFrom     time.Time
+	DateTo       time.Time
+	Currency     string        `validate:"required,iso4217"`
+	RentalStatus string        `validate:"required"`
+	Lines        []InvoiceLine `validate:"required,min=1,dive"`
+}
+
+// Closed reports whether the rental is finished or canceled, so its ledger doesn't change anymore.
+func (r *InvoiceRequest) Closed() bool {
+	return r.RentalStatus == RentalFinished || r.RentalStatus == RentalCanceled
+}
+
+func (r *InvoiceRequest) Validate() error {
+	err := validator.New().Struct(r)
+	if err != nil {
+		return fmt.Errorf("validate invoice: %w (%w)", err, ErrInvalidInvoice)
+	}
+
+	return nil
+}
+
+// Invoice is generated once per rental. The document is stored, so the invoice doesn't change
+// when the rental or exchange rates are changed afterwards.
+type Invoice struct {
+	ID         int       `gorm:"column:id;primaryKey"`
+	UUID       uuid.UUID `gorm:"column:invoice_uid;type:uuid"`
+	Number     string    `gorm:"column:number"`
+	RentalUUID uuid.UUID `gorm:"column:rental_uid;type:uuid"`
+	Username   string    `gorm:"column:username"`
+	Currency   string    `gorm:"column:currency"`
+	Total      int       `gorm:"column:total"`
+	CreatedAt  time.Time `gorm:"column:created_at;type:timestamptz"`
+	Document   []byte    `gorm:"column:document"`
+
+	Details InvoiceRequest `gorm:"-"`
+	// Exponent is the number of minor units digits of the currency.
+	Exponent int       `gorm:"-"`
+	Subtotal int       `gorm:"-"`
+	Discount int       `gorm:"-"`
+	Refunded int       `gorm:"-"`
+	Taxes    []TaxLine `gorm:"-"`
+}
+
+// TaxLine sums the taxes of the rental payments with the same rate. Exclusive taxes are added to the subtotal,
+// inclusive ones are already in it.
+type TaxLine struct {
+	Name     string
+	Amount   int
+	Included bool
+}
+
+// AddTaxes sums the taxes of the charged rental payments by rate.
+func (i *Invoice) AddTaxes(taxes []Tax) {
+	for _, tax := range taxes {
+		line := TaxLine{
+			Name:     fmt.Sprintf("%s %s%% (%s)", tax.Name, strconv.FormatFloat(tax.Percent, 'f', -1, 64), tax.Jurisdiction),
+			Amount:   tax.Amount,
+			Included: tax.Mode == TaxInclusive,
+		}
+
+		j := slices.IndexFunc(i.Taxes, func(other TaxLine) bool {
+			return other.Name == line.Name && other.Included == line.Included
+		})
+		if j < 0 {
+			i.Taxes = append(i.Taxes, line)
+			continue
+		}
+
+		i.Taxes[j].Amount += line.Amount
+	}
+}
+
+// InvoiceNumber formats the number of the invoice sequence, e.g. INV-2024-000042.
+func InvoiceNumber(seq int, at time.Time) string {
+	return fmt.Sprintf("INV-%d-%06d", at.Year(), seq)
+}
+
+// Settle fills the invoice amounts: the subtotal is the sum of the lines, the discount, refunds and the total
+// are what was posted to the rental ledger.
+func (i *Invoice) Settle(entries []JournalEntry) error {
+	i.Subtotal = 0
+	for _, line := range i.Details.Lines {
+		i.Subtotal += line.Amount
+	}
+
+	charged, discount, refunded := 0, 0, 0
+	for _, entry := range entries {
+		if entry.Currency != i.Currency {
+			return fmt.Errorf("%s entry in %s invoice: %w", entry.Currency, i.Currency, ErrCurrencyMismatch)
+		}
+
+		switch entry.Kind {
+		case EntryCharge, EntryFee:
+			charged += entry.Amount()
+		case EntryDiscount:
+			discount += entry.Amount()
+		case EntryRefund:
+			refunded += entry.Amount()
+		}
+	}
+
+	if charged == 0 {
+		return ErrNothingToInvoice
+	}
+
+	i.Discount = discount
+	i.Refunded = refunded
+	i.Total = charged - discount - refunded
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrUnbalancedEntry = errors.New("journal entry is unbalanced")
+
+// EntryKind is the kind of money movement recorded in the ledger.
+type EntryKind string
+
+const (
+	// EntryCharge charges the customer for the rental, EntryFee - for extra charges, e.g. late return.
+	EntryCharge   EntryKind = "CHARGE"
+	EntryFee      EntryKind = "FEE"
+	EntryDiscount EntryKind = "DISCOUNT"
+	EntryRefund   EntryKind = "REFUND"
+)
+
+// DiscountsAccount accumulates promo code discounts covered by the company.
+const DiscountsAccount = "discounts"
+
+// CustomerAccount is debited with what the customer is charged and credited with discounts and refunds.
+func CustomerAccount(username string) string {
+	if username == "" {
+		return "customer:unknown"
+	}
+
+	return "customer:" + username
+}
+
+// RentalAccount is credited with the rental revenue. Payments made before they were linked to rentals
+// are posted to the common unassigned account.
+func RentalAccount(rentalUUID *uuid.UUID) string {
+	if rentalUUID == nil {
+		return "rental:unassigned"
+	}
+
+	return "rental:" + rentalUUID.String()
+}
+
+// JournalEntry is an append-only record of a money movement. Entries are never changed:
+// a refund is a new entry, so the history can be reconciled with the payment provider.
+type JournalEntry struct {
+	ID          int        `gorm:"column:id;primaryKey"`
+	UUID        uuid.UUID  `gorm:"column:entry_uid;type:uuid"`
+	PaymentUUID uuid.UUID  `gorm:"column:payment_uid;type:uuid"`
+	RentalUUID  *uuid.UUID `gorm:"column:rental_uid;type:uuid"`
+	Kind        EntryKind  `gorm:"column:kind"`
+	Currency    string     `gorm:"column:currency"`
+	CreatedAt   time.Time  `gorm:"column:created_at;type:timestamptz"`
+	Postings    []Posting  `gorm:"-"`
+}
+
+// Posting debits or credits one account, amounts are in minor units of the entry currency.
+type Posting struct {
+	ID        int       `gorm:"column:id;primaryKey"`
+	EntryUUID uuid.UUID `gorm:"column:entry_uid;type:uuid"`
+	Account   string    `gorm:"column:account"`
+	Debit     int       `gorm:"column:debit"`
+	Credit    int       `gorm:"column:credit"`
+}
+
+// Amount is the amount moved by the entry.
+func (e JournalEntry) Amount() int {
+	amount := 0
+	for _, posting := range e.Postings {
+		amount += posting.Debit
+	}
+
+	return amount
+}
+
+// Validate checks that debits are equal to credits and every posting has only one side.
+func (e JournalEntry) Validate() error {
+	debit, credit := 0, 0
+	for _, posting := range e.Postings {
+		if posting.Debit < 0 || posting.Credit < 0 || (posting.Debit > 0) == (posting.Credit > 0) {
+			return fmt.Errorf("posting to %s: %w", posting.Account, ErrUnbalancedEntry)
+		}
+
+		debit += posting.Debit
+		credit += posting.Credit
+	}
+
+	if debit != credit {
+		return fmt.Errorf("debit %d, credit %d: %w", debit, credit, ErrUnbalancedEntry)
+	}
+
+	return nil
+}
+
+// Balance is debits minus credits of the account.
+type Balance struct {
+	Account string
+	Money
+}
+
+// Balances sums postings of the entries by account and currency.
+func Balances(entries []JournalEntry) []Balance {
+	type key struct {
+		account  string
+		currency string
+	}
+
+	sums := make(map[key]int)
+	for _, entry := range entries {
+		for _, posting := range entry.Postings {
+			sums[key{posting.Account, entry.Currency}] += posting.Debit - posting.Credit
+		}
+	}
+
+	balances := make([]Balance, 0, len(sums))
+	for k, amount := range sums {
+		balances = append(balances, Balance{
+			Account: k.account,
+			Money:   Money{Amount: amount, Currency: k.currency},
+		})
+	}
+
+	slices.SortFunc(balances, func(a, b Balance) int {
+		return cmp.Or(cmp.Compare(a.Account, b.Account), cmp.Compare(a.Currency, b.Currency))
+	})
+
+	return balances
+}
+
+// RentalLedger is the history of money movements of the rental payments.
+type RentalLedger struct {
+	RentalUUID uuid.UUID
+	Balances   []Balance
+	Entries    []JournalEntry
+}
@@ -3,32 +3,224 @@ package models
 import (
 	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 var (
 	ErrPaymentNotFound = errors.New("payment not found")
 	ErrInvalidPayment  = errors.New("invalid payment")
+	ErrPromoNotFound   = errors.New("promo code not found")
+	ErrInvalidPromo    = errors.New("invalid promo code")
+	ErrPromoExists     = errors.New("promo code already exists")
+	ErrPromoUsedUp     = errors.New("promo code usage limit is reached")
+	ErrPaymentChanged  = errors.New("payment is changed by another request")
+	ErrForbidden       = errors.New("forbidden")
 )
 
+// Caller is who requests the payment. Privileged callers are other services and admins,
+// they have access to payments of all users.
+type Caller struct {
+	Username   string
+	Privileged bool
+}
+
+// CanAccess tells whether the caller may read or cancel the payment. Payments without the owner
+// are accessible to privileged callers only.
+func (c Caller) CanAccess(payment Payment) bool {
+	return c.Privileged || (payment.Username != "" && payment.Username == c.Username)
+}
+
+// CanAccessRental tells whether the caller may read the ledger and the invoice of the rental paid by owners.
+// The rental without linked payments has nothing to read, so it is accessible to everyone.
+func (c Caller) CanAccessRental(owners []string) bool {
+	return c.Privileged || lo.EveryBy(owners, func(owner string) bool {
+		return owner != "" && owner == c.Username
+	})
+}
+
 type PaymentStatus string
 
+// Payments are authorized at booking and captured when the rental is finished.
+// PAID is kept for payments made before the provider was introduced, they are treated as captured.
 const (
-	Paid     PaymentStatus = "PAID"
-	Canceled PaymentStatus = "CANCELED"
+	Pending           PaymentStatus = "PENDING"
+	Authorized        PaymentStatus = "AUTHORIZED"
+	Captured          PaymentStatus = "CAPTURED"
+	Failed            PaymentStatus = "FAILED"
+	Paid              PaymentStatus = "PAID"
+	Canceled          PaymentStatus = "CANCELED"
+	Refunded          PaymentStatus = "REFUNDED"
+	PartiallyRefunded PaymentStatus = "PARTIALLY_REFUNDED"
 )
 
+// PaymentKind is what the payment is for. Extra charges for the rental are paid by separate payments.
+type PaymentKind string
+
+const (
+	KindRental     PaymentKind = "RENTAL"
+	KindLateReturn PaymentKind = "LATE_RETURN"
+	KindMileage    PaymentKind = "MILEAGE"
+	KindRefuel     PaymentKind = "REFUEL"
+	// KindExtension pays for days added to the rental.
+	KindExtension PaymentKind = "EXTENSION"
+)
+
+// Payment amounts are in minor units of the payment currency.
+// Captured and Refunded amounts are not stored, they are derived from the payment ledger entries.
 type Payment struct {
-	ID     int           `gorm:"column:id;primaryKey"`
-	UUID   uuid.UUID     `gorm:"column:payment_uid;type:uuid"`
-	Price  int           `gorm:"column:price"`
-	Status PaymentStatus `gorm:"column:status"`
+	ID         int           `gorm:"column:id;primaryKey"`
+	UUID       uuid.UUID     `gorm:"column:payment_uid;type:uuid"`
+	Price      int           `gorm:"column:price"`
+	Currency   string        `gorm:"column:currency"`
+	Status     PaymentStatus `gorm:"column:status"`
+	Discount   int           `gorm:"column:discount"`
+	PromoCode  string        `gorm:"column:promo_code"`
+	Username   string        `gorm:"column:username"`
+	RentalUUID *uuid.UUID    `gorm:"column:rental_uid;type:uuid"`
+	Kind       PaymentKind   `gorm:"column:kind"`
+	// ProviderRef identifies the authorization at the payment provider.
+	ProviderRef   string `gorm:"column:provider_ref"`
+	FailureReason string `gorm:"column:failure_reason"`
+	// CanceledAt is set once the payment is canceled, refunds of active rentals don't cancel it.
+	CanceledAt *time.Time     `gorm:"column:canceled_at;type:timestamptz"`
+	Captured   int            `gorm:"-"`
+	Refunded   int            `gorm:"-"`
+	Entries    []JournalEntry `gorm:"-"`
+	// Taxes are included in the price.
+	Taxes []Tax `gorm:"-"`
+}
+
+// Money returns the amount in the payment currency.
+func (p Payment) Money(amount int) Money {
+	return Money{Amount: amount, Currency: p.Currency}
+}
+
+// ChargeEntries charge the customer the price before the discount, the discount is covered by the company.
+// Taxes are credited to tax accounts and the rest of the price to the rental account.
+// Free payments move no money and have no entries.
+func (p Payment) ChargeEntries(at time.Time) []JournalEntry {
+	gross := p.Price + p.Discount
+	if gross == 0 {
+		return nil
+	}
+
+	kind := EntryCharge
+	if p.Kind != "" && p.Kind != KindRental {
+		kind = EntryFee
+	}
+
+	postings := append([]Posting{{Account: CustomerAccount(p.Username), Debit: gross}}, p.revenue(gross, p.Price)...)
+
+	entries := []JournalEntry{p.entry(kind, at, postings...)}
+	if p.Discount > 0 {
+		entries = append(entries, p.entry(EntryDiscount, at,
+			Posting{Account: DiscountsAccount, Debit: p.Discount},
+			Posting{Account: CustomerAccount(p.Username), Credit: p.Discount},
+		))
+	}
+
+	return entries
+}
+
+// RefundEntry returns the amount to the customer from the rental and tax accounts in proportion to the price.
+func (p Payment) RefundEntry(amount int, at time.Time) JournalEntry {
+	postings := p.revenue(amount, amount)
+	for i := range postings {
+		postings[i].Debit, postings[i].Credit = postings[i].Credit, 0
+	}
+
+	return p.entry(EntryRefund, at, append(postings, Posting{Account: CustomerAccount(p.Username), Credit: amount})...)
+}
+
+// revenue credits the amount to the rental account except for the taxes on the paid part of the price.
+func (p Payment) revenue(amount, paid int) []Posting {
+	postings := []Posting{{Account: RentalAccount(p.RentalUUID), Credit: amount}}
+	if p.Price == 0 {
+		return postings
+	}
+
+	for _, tax := range p.Taxes {
+		share := int(math.Round(float64(tax.Amount) * float64(paid) / float64(p.Price)))
+		if share == 0 {
+			continue
+		}
+
+		postings[0].Credit -= share
+		postings = append(postings, Posting{Account: tax.Account(), Credit: share})
+	}
+
+	return postings
+}
+
+func (p Payment) entry(kind EntryKind, at time.Time, postings ...Posting) JournalEntry {
+	entryUUID := uuid.New()
+	for i := range postings {
+		postings[i].EntryUUID = entryUUID
+	}
+
+	return JournalEntry{
+		UUID:        entryUUID,
+		PaymentUUID: p.UUID,
+		RentalUUID:  p.RentalUUID,
+		Kind:        kind,
+		Currency:    p.Currency,
+		CreatedAt:   at,
+		Postings:    postings,
+	}
+}
+
+// Post adds entries to the payment ledger and derives the captured and refunded amounts from it.
+// The status is derived too once money is moved, otherwise it's the authorization status.
+// Canceled payments keep the status: paid payments were canceled without refunds before the provider was introduced.
+// Entries which are not saved yet have no ID.
+func (p *Payment) Post(entries ...JournalEntry) {
+	p.Entries = append(p.Entries, entries...)
+
+	charged, refunded := 0, 0
+	for _, entry := range p.Entries {
+		switch entry.Kind {
+		case EntryCharge, EntryFee:
+			charged += entry.Amount()
+		case EntryDiscount:
+			charged -= entry.Amount()
+		case EntryRefund:
+			refunded += entry.Amount()
+		}
+	}
+
+	p.Captured = charged - refunded
+	p.Refunded = refunded
+
+	switch {
+	case charged == 0 && refunded == 0, p.Status == Canceled:
+	case refunded >= charged:
+		p.Status = Refunded
+	case refunded > 0:
+		p.Status = PartiallyRefunded
+	case p.Status != Paid:
+		p.Status = Captured
+	}
 }
 
 type CreatePaymentRequest struct {
-	Price int `gorm:"column:price" validate:"omitempty,gte=0"`
+	Price      int    `gorm:"column:price" validate:"omitempty,gte=0"`
+	Currency   string `validate:"required,iso4217"`
+	PromoCode  string
+	Username   string
+	CarType    string
+	RentalDays int `validate:"gte=0"`
+	RentalUUID *uuid.UUID
+	Kind       PaymentKind `validate:"omitempty,oneof=RENTAL LATE_RETURN MILEAGE REFUEL EXTENSION"`
+	// Method is the payment method token passed to the provider.
+	Method string
+	// Jurisdiction is where the payment is taxed, the default one of the tax policy is used if it's empty.
+	Jurisdiction string
 }
 
 func (r *CreatePaymentRequest) Validate() error {
@@ -39,3 +231,20 @@ func (r *CreatePaymentRequest) Validate() error {
 
 	return nil
 }
+
+type FieldError struct {
+	Field string
+	Error string
+}
+
+// ValidationErrors describes problems with request fields which can't be expressed with validator tags.
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldError := range e {
+		messages = append(messages, fmt.Sprintf("%s: %s", fieldError.Field, fieldError.Error))
+	}
+
+	return strings.Join(messages, "; ")
+}
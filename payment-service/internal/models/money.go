@@ -0,0 +1,93 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("currencies don't match")
+	ErrRateNotFound     = errors.New("exchange rate not found")
+	ErrInvalidRate      = errors.New("invalid exchange rate")
+)
+
+// Money is an amount in minor units of the ISO 4217 currency, e.g. kopecks for RUB.
+// Amounts in different currencies can't be added or subtracted, they have to be converted first.
+type Money struct {
+	Amount   int
+	Currency string
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("add %s to %s: %w", other.Currency, m.Currency, ErrCurrencyMismatch)
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("subtract %s from %s: %w", other.Currency, m.Currency, ErrCurrencyMismatch)
+	}
+
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}, nil
+}
+
+// Percent returns the share of the amount rounded to the minor unit.
+func (m Money) Percent(percent int) Money {
+	return Money{
+		Amount:   int(math.Round(float64(m.Amount) * float64(percent) / 100)),
+		Currency: m.Currency,
+	}
+}
+
+// ExchangeRate is the price of one major unit of the base currency in Currency.
+// Rates are maintained by administrators and are used only to display prices, payments are never converted.
+type ExchangeRate struct {
+	Currency string `gorm:"column:currency;primaryKey" validate:"iso4217"`
+	// Exponent is the number of minor units digits, e.g. 2 for RUB and 0 for JPY.
+	Exponent  int       `gorm:"column:exponent" validate:"gte=0,lte=4"`
+	Rate      float64   `gorm:"column:rate" validate:"gt=0"`
+	UpdatedAt time.Time `gorm:"column:updated_at;type:timestamptz"`
+}
+
+func (r *ExchangeRate) Validate() error {
+	err := validator.New().Struct(r)
+	if err != nil {
+		return fmt.Errorf("validate exchange rate: %w (%w)", err, ErrInvalidRate)
+	}
+
+	return nil
+}
+
+// ExchangeRates are rates by currency code.
+type ExchangeRates map[string]ExchangeRate
+
+// Convert converts the amount through the base currency and rounds it to the minor unit of the target currency.
+func (r ExchangeRates) Convert(m Money, currency string) (Money, error) {
+	if m.Currency == currency {
+		return m, nil
+	}
+
+	from, ok := r[m.Currency]
+	if !ok {
+		return Money{}, fmt.Errorf("convert from %s: %w", m.Currency, ErrRateNotFound)
+	}
+
+	to, ok := r[currency]
+	if !ok {
+		return Money{}, fmt.Errorf("convert to %s: %w", currency, ErrRateNotFound)
+	}
+
+	major := float64(m.Amount) / math.Pow10(from.Exponent) / from.Rate * to.Rate
+
+	return Money{
+		Amount:   int(math.Round(major * math.Pow10(to.Exponent))),
+		Currency: currency,
+	}, nil
+}
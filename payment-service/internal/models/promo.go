@@ -0,0 +1,122 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type PromoKind string
+
+const (
+	PromoPercent PromoKind = "PERCENT"
+	PromoFixed   PromoKind = "FIXED"
+)
+
+// PromoCode gives a discount on the payment. Zero limits mean no limit, empty CarTypes mean any car.
+// Fixed discounts are in minor units of Currency and apply only to payments in it.
+type PromoCode struct {
+	ID             int        `gorm:"column:id;primaryKey"`
+	Code           string     `gorm:"column:code" validate:"required,max=40"`
+	Kind           PromoKind  `gorm:"column:kind" validate:"oneof=PERCENT FIXED"`
+	Value          int        `gorm:"column:value" validate:"gt=0,max=100000000"`
+	Currency       string     `gorm:"column:currency" validate:"omitempty,iso4217"`
+	ValidFrom      time.Time  `gorm:"column:valid_from;type:timestamptz" validate:"required"`
+	ValidTo        *time.Time `gorm:"column:valid_to;type:timestamptz" validate:"omitempty,gtfield=ValidFrom"`
+	MaxUses        int        `gorm:"column:max_uses" validate:"gte=0"`
+	MaxUsesPerUser int        `gorm:"column:max_uses_per_user" validate:"gte=0"`
+	CarTypes       []string   `gorm:"column:car_types;type:jsonb;serializer:json"`
+	MinDays        int        `gorm:"column:min_days" validate:"gte=0"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamptz"`
+}
+
+func (p *PromoCode) Validate() error {
+	err := validator.New().Struct(p)
+	if err != nil {
+		return fmt.Errorf("validate promo code: %w (%w)", err, ErrInvalidPromo)
+	}
+
+	if p.Kind == PromoPercent && p.Value > 100 {
+		return fmt.Errorf("validate promo code: %w (%w)", ValidationErrors{{
+			Field: "Value",
+			Error: "percent discount must not exceed 100",
+		}}, ErrInvalidPromo)
+	}
+
+	if p.Kind == PromoFixed && p.Currency == "" {
+		return fmt.Errorf("validate promo code: %w (%w)", ValidationErrors{{
+			Field: "Currency",
+			Error: "fixed discount must have a currency",
+		}}, ErrInvalidPromo)
+	}
+
+	return nil
+}
+
+// Check returns problems which don't allow to apply the code to the payment. Usage limits are checked on redemption.
+func (p *PromoCode) Check(req CreatePaymentRequest, now time.Time) ValidationErrors {
+	var fieldErrors ValidationErrors
+
+	if now.Before(p.ValidFrom) || (p.ValidTo != nil && !now.Before(*p.ValidTo)) {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field: "PromoCode",
+			Error: "promo code is not active",
+		})
+	}
+
+	if len(p.CarTypes) > 0 && !slices.Contains(p.CarTypes, req.CarType) {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field: "CarType",
+			Error: fmt.Sprintf("promo code is valid only for %v cars", p.CarTypes),
+		})
+	}
+
+	if p.Kind == PromoFixed && p.Currency != req.Currency {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field: "Currency",
+			Error: fmt.Sprintf("promo code is valid only for payments in %s", p.Currency),
+		})
+	}
+
+	if req.RentalDays < p.MinDays {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field: "RentalDays",
+			Error: fmt.Sprintf("promo code is valid for rents of at least %d days", p.MinDays),
+		})
+	}
+
+	return fieldErrors
+}
+
+// Discount returns the discount for the price, it never exceeds the price.
+func (p *PromoCode) Discount(price Money) (Money, error) {
+	if p.Kind == PromoPercent {
+		return price.Percent(p.Value), nil
+	}
+
+	if p.Currency != price.Currency {
+		return Money{}, fmt.Errorf("discount in %s for price in %s: %w", p.Currency, price.Currency, ErrCurrencyMismatch)
+	}
+
+	return Money{Amount: min(p.Value, price.Amount), Currency: price.Currency}, nil
+}
+
+// PromoRedemption is a use of the promo code by the payment. Canceled redemptions don't count towards limits.
+type PromoRedemption struct {
+	ID          int        `gorm:"column:id;primaryKey"`
+	PromoCodeID int        `gorm:"column:promo_code_id"`
+	PaymentUUID uuid.UUID  `gorm:"column:payment_uid;type:uuid"`
+	Username    string     `gorm:"column:username"`
+	Discount    int        `gorm:"column:discount"`
+	RedeemedAt  time.Time  `gorm:"column:redeemed_at;type:timestamptz"`
+	CanceledAt  *time.Time `gorm:"column:canceled_at;type:timestamptz"`
+}
+
+// PromoCodeUsage is the promo code with the number of its active redemptions.
+type PromoCodeUsage struct {
+	PromoCode
+	Used int `gorm:"column:used"`
+}
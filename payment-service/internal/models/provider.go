@@ -0,0 +1,35 @@
+package models
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrPaymentDeclined     = errors.New("payment is declined by provider")
+	ErrProviderUnavailable = errors.New("payment provider is unavailable")
+	ErrPaymentState        = errors.New("operation is not allowed in the payment status")
+)
+
+// ProviderStatus is the outcome of an operation reported by the payment provider.
+type ProviderStatus string
+
+const (
+	ProviderApproved ProviderStatus = "APPROVED"
+	ProviderDeclined ProviderStatus = "DECLINED"
+	// ProviderActionRequired means the customer has to confirm the payment, e.g. pass 3-D Secure.
+	ProviderActionRequired ProviderStatus = "ACTION_REQUIRED"
+)
+
+type AuthorizeRequest struct {
+	PaymentUUID uuid.UUID
+	Amount      int
+	Method      string
+}
+
+type ProviderResult struct {
+	Reference string
+	Status    ProviderStatus
+	Reason    string
+}
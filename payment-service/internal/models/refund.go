@@ -0,0 +1,78 @@
+package models
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// RefundRule refunds Percent of the price when the rental is canceled at least Before its start.
+type RefundRule struct {
+	Before  time.Duration
+	Percent int
+}
+
+// CancellationPolicy describes how much is refunded depending on the time left before the rental starts.
+// Nothing is refunded if no rule matches, the empty policy refunds everything.
+type CancellationPolicy struct {
+	Refunds []RefundRule
+}
+
+func (p CancellationPolicy) RefundPercent(start, canceledAt time.Time) int {
+	if len(p.Refunds) == 0 {
+		return 100
+	}
+
+	rules := slices.Clone(p.Refunds)
+	slices.SortFunc(rules, func(a, b RefundRule) int {
+		return cmp.Compare(b.Before, a.Before)
+	})
+
+	left := start.Sub(canceledAt)
+	for _, rule := range rules {
+		if left >= rule.Before {
+			return rule.Percent
+		}
+	}
+
+	return 0
+}
+
+type Refund struct {
+	ID          int       `gorm:"column:id;primaryKey"`
+	UUID        uuid.UUID `gorm:"column:refund_uid;type:uuid"`
+	PaymentUUID uuid.UUID `gorm:"column:payment_uid;type:uuid"`
+	Amount      int       `gorm:"column:amount"`
+	Currency    string    `gorm:"column:currency"`
+	Percent     int       `gorm:"column:percent"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:timestamptz"`
+}
+
+// CancelPaymentRequest without RentalStart voids the payment completely,
+// it is used to revert payments of rentals which were not created.
+// RentalUUID links the payment made before the rental was created to it in the ledger.
+type CancelPaymentRequest struct {
+	RentalStart *time.Time
+	CanceledAt  time.Time
+	RentalUUID  *uuid.UUID
+}
+
+// RefundPaymentRequest returns Amount of the payment to the customer without canceling it,
+// e.g. when the rental is shortened. RentalUUID links the payment to the rental like in CancelPaymentRequest.
+type RefundPaymentRequest struct {
+	Amount     int `validate:"gt=0"`
+	RentalUUID *uuid.UUID
+}
+
+func (r *RefundPaymentRequest) Validate() error {
+	err := validator.New().Struct(r)
+	if err != nil {
+		return fmt.Errorf("validate refund: %w (%w)", err, ErrInvalidPayment)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// TaxMode tells whether prices already include taxes or taxes are added on top of them.
+type TaxMode string
+
+const (
+	TaxInclusive TaxMode = "INCLUSIVE"
+	TaxExclusive TaxMode = "EXCLUSIVE"
+)
+
+// TaxRate applies to payments in the jurisdiction: a country, e.g. RU, or a region, e.g. RU-MOW.
+// Rates of the country apply to all its regions.
+type TaxRate struct {
+	Jurisdiction string
+	Name         string
+	Percent      float64
+}
+
+func (r TaxRate) appliesTo(jurisdiction string) bool {
+	return r.Jurisdiction == jurisdiction || strings.HasPrefix(jurisdiction, r.Jurisdiction+"-")
+}
+
+// TaxPolicy is configured per deployment. Payments are taxed in the default Jurisdiction unless another is requested.
+// The empty policy taxes nothing.
+type TaxPolicy struct {
+	Mode         TaxMode
+	Jurisdiction string
+	Rates        []TaxRate
+}
+
+// Tax is a tax line of the payment, the amount is in minor units of the payment currency.
+type Tax struct {
+	ID           int       `gorm:"column:id;primaryKey"`
+	PaymentUUID  uuid.UUID `gorm:"column:payment_uid;type:uuid"`
+	Jurisdiction string    `gorm:"column:jurisdiction"`
+	Name         string    `gorm:"column:name"`
+	Percent      float64   `gorm:"column:percent"`
+	Amount       int       `gorm:"column:amount"`
+	Mode         TaxMode   `gorm:"column:mode"`
+}
+
+// Account is credited with the tax collected from customers.
+func (t Tax) Account() string {
+	return "tax:" + t.Jurisdiction + ":" + t.Name
+}
+
+// Calculate returns the price with taxes and the tax lines. Inclusive taxes are extracted from the price,
+// exclusive ones are added to it. Amounts are rounded to the minor unit, the rounding difference
+// of inclusive taxes goes to the last line, so taxes and the net price sum up to the price.
+func (p TaxPolicy) Calculate(price Money, jurisdiction string) (Money, []Tax) {
+	if jurisdiction == "" {
+		jurisdiction = p.Jurisdiction
+	}
+
+	var rates []TaxRate
+	total := 0.0
+	for _, rate := range p.Rates {
+		if rate.appliesTo(jurisdiction) {
+			rates = append(rates, rate)
+			total += rate.Percent
+		}
+	}
+
+	if len(rates) == 0 || price.Amount == 0 {
+		return price, nil
+	}
+
+	mode := p.Mode
+	if mode == "" {
+		mode = TaxInclusive
+	}
+
+	base := float64(price.Amount)
+	if mode == TaxInclusive {
+		base = math.Round(base * 100 / (100 + total))
+	}
+
+	taxes := make([]Tax, 0, len(rates))
+	sum := 0
+	for _, rate := range rates {
+		amount := int(math.Round(base * rate.Percent / 100))
+		sum += amount
+
+		taxes = append(taxes, Tax{
+			Jurisdiction: rate.Jurisdiction,
+			Name:         rate.Name,
+			Percent:      rate.Percent,
+			Amount:       amount,
+			Mode:         mode,
+		})
+	}
+
+	if mode == TaxInclusive {
+		taxes[len(taxes)-1].Amount += price.Amount - int(base) - sum
+		return price, taxes
+	}
+
+	return Money{Amount: price.Amount + sum, Currency: price.Currency}, taxes
+}
@@ -4,26 +4,185 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/generated/openapi"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/samber/lo"
 )
 
 func fromPayment(p models.Payment) openapi.PaymentInfo {
 	return openapi.PaymentInfo{
-		PaymentUid: p.UUID,
-		Price:      p.Price,
-		Status:     openapi.PaymentInfoStatus(p.Status),
+		PaymentUid:    p.UUID,
+		Price:         p.Price,
+		Currency:      p.Currency,
+		Status:        openapi.PaymentInfoStatus(p.Status),
+		Discount:      lo.EmptyableToPtr(p.Discount),
+		PromoCode:     lo.EmptyableToPtr(p.PromoCode),
+		Refunded:      lo.EmptyableToPtr(p.Refunded),
+		RentalUid:     p.RentalUUID,
+		Kind:          lo.EmptyableToPtr(openapi.PaymentInfoKind(p.Kind)),
+		Captured:      lo.EmptyableToPtr(p.Captured),
+		FailureReason: lo.EmptyableToPtr(p.FailureReason),
+		Taxes:         fromTaxes(p.Taxes),
 	}
 }
 
+// fromTaxes omits taxes of untaxed payments.
+func fromTaxes(taxes []models.Tax) *[]openapi.Tax {
+	if len(taxes) == 0 {
+		return nil
+	}
+
+	return lo.ToPtr(lo.Map(taxes, func(tax models.Tax, _ int) openapi.Tax {
+		return openapi.Tax{
+			Amount:       tax.Amount,
+			Jurisdiction: tax.Jurisdiction,
+			Mode:         openapi.TaxMode(tax.Mode),
+			Name:         tax.Name,
+			Percent:      float32(tax.Percent),
+		}
+	}))
+}
+
+func toPromoCode(r openapi.PromoCodeRequest) models.PromoCode {
+	return models.PromoCode{
+		Code:           r.Code,
+		Kind:           models.PromoKind(r.Kind),
+		Value:          r.Value,
+		Currency:       lo.FromPtr(r.Currency),
+		ValidFrom:      r.ValidFrom,
+		ValidTo:        r.ValidTo,
+		MaxUses:        lo.FromPtr(r.MaxUses),
+		MaxUsesPerUser: lo.FromPtr(r.MaxUsesPerUser),
+		CarTypes:       lo.FromPtr(r.CarTypes),
+		MinDays:        lo.FromPtr(r.MinDays),
+	}
+}
+
+func fromPromoCode(p models.PromoCodeUsage) openapi.PromoCodeResponse {
+	return openapi.PromoCodeResponse{
+		Code:           p.Code,
+		Kind:           openapi.PromoCodeResponseKind(p.Kind),
+		Value:          p.Value,
+		Currency:       lo.EmptyableToPtr(p.Currency),
+		ValidFrom:      p.ValidFrom,
+		ValidTo:        p.ValidTo,
+		MaxUses:        &p.MaxUses,
+		MaxUsesPerUser: &p.MaxUsesPerUser,
+		CarTypes:       &p.CarTypes,
+		MinDays:        &p.MinDays,
+		Used:           p.Used,
+	}
+}
+
+func toMoney(m openapi.Money) models.Money {
+	return models.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func fromMoney(m models.Money) openapi.Money {
+	return openapi.Money{
+		Amount:   m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+func fromExchangeRate(r models.ExchangeRate) openapi.ExchangeRate {
+	return openapi.ExchangeRate{
+		Currency:  r.Currency,
+		Exponent:  r.Exponent,
+		Rate:      r.Rate,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func fromRentalLedger(l models.RentalLedger) openapi.RentalLedger {
+	return openapi.RentalLedger{
+		RentalUid: l.RentalUUID,
+		Balances: lo.Map(l.Balances, func(b models.Balance, _ int) openapi.LedgerBalance {
+			return openapi.LedgerBalance{
+				Account:  b.Account,
+				Amount:   b.Amount,
+				Currency: b.Currency,
+			}
+		}),
+		Entries: lo.Map(l.Entries, func(e models.JournalEntry, _ int) openapi.JournalEntry {
+			return openapi.JournalEntry{
+				Amount:     e.Amount(),
+				CreatedAt:  e.CreatedAt,
+				Currency:   e.Currency,
+				EntryUid:   e.UUID,
+				Kind:       openapi.JournalEntryKind(e.Kind),
+				PaymentUid: e.PaymentUUID,
+				Postings: lo.Map(e.Postings, func(p models.Posting, _ int) openapi.Posting {
+					return openapi.Posting{
+						Account: p.Account,
+						Credit:  p.Credit,
+						Debit:   p.Debit,
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func toInvoiceRequest(r openapi.InvoiceRequest, rentalUUID uuid.UUID, username string) (*models.InvoiceRequest, error) {
+	dateFrom, err := time.Parse(time.DateOnly, r.DateFrom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date from (%w): %w", models.ErrInvalidInvoice, err)
+	}
+
+	dateTo, err := time.Parse(time.DateOnly, r.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date to (%w): %w", models.ErrInvalidInvoice, err)
+	}
+
+	return &models.InvoiceRequest{
+		RentalUUID:   rentalUUID,
+		Username:     username,
+		Car:          models.InvoiceCar(r.Car),
+		DateFrom:     dateFrom,
+		DateTo:       dateTo,
+		Currency:     r.Currency,
+		RentalStatus: string(r.RentalStatus),
+		Lines: lo.Map(r.Lines, func(line openapi.InvoiceLine, _ int) models.InvoiceLine {
+			return models.InvoiceLine{
+				Description: line.Description,
+				Quantity:    line.Quantity,
+				Amount:      line.Amount,
+			}
+		}),
+	}, nil
+}
+
 func processError(c echo.Context, err error, comment string) error {
 	err = fmt.Errorf("%s: %w", comment, err)
 
 	switch {
-	case errors.Is(err, models.ErrInvalidPayment):
+	case errors.Is(err, models.ErrInvalidPayment), errors.Is(err, models.ErrInvalidPromo),
+		errors.Is(err, models.ErrInvalidRate), errors.Is(err, models.ErrInvalidInvoice):
+		var fieldErrors models.ValidationErrors
+		if errors.As(err, &fieldErrors) {
+			errorSlice := make([]openapi.ErrorDescription, 0, len(fieldErrors))
+			for _, v := range fieldErrors {
+				errorSlice = append(errorSlice, openapi.ErrorDescription{
+					Error: v.Error,
+					Field: v.Field,
+				})
+			}
+
+			return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+				Message: err.Error(),
+				Errors:  errorSlice,
+			})
+		}
+
 		var valErrors validator.ValidationErrors
 		if errors.As(err, &valErrors) {
 			errorSlice := make([]openapi.ErrorDescription, 0, len(valErrors))
@@ -42,10 +201,29 @@ func processError(c echo.Context, err error, comment string) error {
 		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
 			Message: err.Error(),
 		})
-	case errors.Is(err, models.ErrPaymentNotFound):
+	case errors.Is(err, models.ErrPaymentNotFound), errors.Is(err, models.ErrPromoNotFound),
+		errors.Is(err, models.ErrRateNotFound), errors.Is(err, models.ErrInvoiceNotFound):
 		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
 			Message: err.Error(),
 		})
+	case errors.Is(err, models.ErrPromoExists), errors.Is(err, models.ErrPaymentChanged), errors.Is(err, models.ErrPaymentState),
+		errors.Is(err, models.ErrCurrencyMismatch), errors.Is(err, models.ErrNothingToInvoice),
+		errors.Is(err, models.ErrRentalNotClosed):
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: err.Error(),
+		})
+	case errors.Is(err, models.ErrForbidden):
+		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{
+			Message: err.Error(),
+		})
+	case errors.Is(err, models.ErrPaymentDeclined):
+		return c.JSON(http.StatusPaymentRequired, openapi.ErrorResponse{
+			Message: err.Error(),
+		})
+	case errors.Is(err, models.ErrProviderUnavailable):
+		return c.JSON(http.StatusServiceUnavailable, openapi.ErrorResponse{
+			Message: err.Error(),
+		})
 	default:
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
 			Message: err.Error(),
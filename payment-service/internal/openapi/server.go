@@ -3,22 +3,31 @@ package openapi
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/auth"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/generated/openapi"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/samber/lo"
 )
 
 type Server struct {
-	paymentLogic paymentLogic
+	paymentLogic  paymentLogic
+	promoLogic    promoLogic
+	exchangeLogic exchangeLogic
+	invoiceLogic  invoiceLogic
 }
 
-func New(paymentLogic paymentLogic) *Server {
+func New(paymentLogic paymentLogic, promoLogic promoLogic, exchangeLogic exchangeLogic, invoiceLogic invoiceLogic) *Server {
 	return &Server{
-		paymentLogic: paymentLogic,
+		paymentLogic:  paymentLogic,
+		promoLogic:    promoLogic,
+		exchangeLogic: exchangeLogic,
+		invoiceLogic:  invoiceLogic,
 	}
 }
 
@@ -30,7 +39,16 @@ func (s *Server) Create(c echo.Context) error {
 	}
 
 	payment, err := s.paymentLogic.Create(c.Request().Context(), models.CreatePaymentRequest{
-		Price: int(req.Price),
+		Price:        req.Price,
+		Currency:     req.Currency,
+		PromoCode:    lo.FromPtr(req.PromoCode),
+		Username:     owner(c.Request().Context(), req.Username),
+		CarType:      lo.FromPtr(req.CarType),
+		RentalDays:   lo.FromPtr(req.RentalDays),
+		RentalUUID:   req.RentalUid,
+		Kind:         models.PaymentKind(lo.FromPtr(req.Kind)),
+		Method:       lo.FromPtr(req.PaymentMethod),
+		Jurisdiction: lo.FromPtr(req.Jurisdiction),
 	})
 	if err != nil {
 		return processError(c, err, "create payment")
@@ -39,17 +57,57 @@ func (s *Server) Create(c echo.Context) error {
 	return c.JSON(http.StatusOK, fromPayment(*payment))
 }
 
-func (s *Server) Cancel(c echo.Context, paymentUid openapi_types.UUID) error {
-	err := s.paymentLogic.Cancel(c.Request().Context(), paymentUid)
+func (s *Server) Cancel(c echo.Context, paymentUid openapi_types.UUID, params openapi.CancelParams) error {
+	payment, err := s.paymentLogic.Cancel(c.Request().Context(), paymentUid, caller(c.Request().Context()), models.CancelPaymentRequest{
+		RentalStart: params.RentalStart,
+		CanceledAt:  lo.FromPtr(params.CanceledAt),
+		RentalUUID:  params.RentalUid,
+	})
 	if err != nil {
 		return processError(c, err, "cancel payment")
 	}
 
-	return c.NoContent(http.StatusNoContent)
+	return c.JSON(http.StatusOK, fromPayment(*payment))
+}
+
+func (s *Server) Refund(c echo.Context, paymentUid openapi_types.UUID) error {
+	var req openapi.RefundRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidPayment), "cannot unmarshal request body")
+	}
+
+	payment, err := s.paymentLogic.Refund(c.Request().Context(), paymentUid, caller(c.Request().Context()), models.RefundPaymentRequest{
+		Amount:     req.Amount,
+		RentalUUID: req.RentalUid,
+	})
+	if err != nil {
+		return processError(c, err, "refund payment")
+	}
+
+	return c.JSON(http.StatusOK, fromPayment(*payment))
+}
+
+func (s *Server) Capture(c echo.Context, paymentUid openapi_types.UUID, params openapi.CaptureParams) error {
+	payment, err := s.paymentLogic.Capture(c.Request().Context(), paymentUid, caller(c.Request().Context()), params.RentalUid)
+	if err != nil {
+		return processError(c, err, "capture payment")
+	}
+
+	return c.JSON(http.StatusOK, fromPayment(*payment))
+}
+
+func (s *Server) Confirm(c echo.Context, paymentUid openapi_types.UUID) error {
+	payment, err := s.paymentLogic.Confirm(c.Request().Context(), paymentUid, caller(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "confirm payment")
+	}
+
+	return c.JSON(http.StatusOK, fromPayment(*payment))
 }
 
 func (s *Server) Get(c echo.Context, paymentUid openapi_types.UUID) error {
-	payment, err := s.paymentLogic.Get(c.Request().Context(), paymentUid)
+	payment, err := s.paymentLogic.Get(c.Request().Context(), paymentUid, caller(c.Request().Context()))
 	if err != nil {
 		return processError(c, err, "get payment")
 	}
@@ -57,12 +115,168 @@ func (s *Server) Get(c echo.Context, paymentUid openapi_types.UUID) error {
 	return c.JSON(http.StatusOK, fromPayment(*payment))
 }
 
+func (s *Server) GetRentalLedger(c echo.Context, rentalUid openapi_types.UUID) error {
+	ledger, err := s.paymentLogic.RentalLedger(c.Request().Context(), rentalUid, caller(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "get rental ledger")
+	}
+
+	return c.JSON(http.StatusOK, fromRentalLedger(*ledger))
+}
+
+func (s *Server) GetInvoice(c echo.Context, rentalUid openapi_types.UUID) error {
+	invoice, err := s.invoiceLogic.Get(c.Request().Context(), rentalUid, caller(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "get invoice")
+	}
+
+	return invoiceBlob(c, invoice)
+}
+
+func (s *Server) GenerateInvoice(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.InvoiceRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidInvoice), "cannot unmarshal request body")
+	}
+
+	invoiceReq, err := toInvoiceRequest(req, rentalUid, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "convert request")
+	}
+
+	invoice, err := s.invoiceLogic.Generate(c.Request().Context(), *invoiceReq, caller(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "generate invoice")
+	}
+
+	return invoiceBlob(c, invoice)
+}
+
+// caller is the user of the request, other services and admins are privileged.
+// owner returns the user the payment is created for, only admins and services may create payments for others.
+func owner(ctx context.Context, username *string) string {
+	if username != nil && *username != "" && auth.IsPrivileged(ctx) {
+		return *username
+	}
+
+	return auth.GetUsername(ctx)
+}
+
+func caller(ctx context.Context) models.Caller {
+	return models.Caller{
+		Username:   auth.GetUsername(ctx),
+		Privileged: auth.IsPrivileged(ctx),
+	}
+}
+
+func invoiceBlob(c echo.Context, invoice *models.Invoice) error {
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", invoice.Number+".pdf"))
+
+	return c.Blob(http.StatusOK, "application/pdf", invoice.Document)
+}
+
+func (s *Server) CreatePromoCode(c echo.Context) error {
+	var req openapi.PromoCodeRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidPromo), "cannot unmarshal request body")
+	}
+
+	promo, err := s.promoLogic.Create(c.Request().Context(), toPromoCode(req))
+	if err != nil {
+		return processError(c, err, "create promo code")
+	}
+
+	return c.JSON(http.StatusCreated, fromPromoCode(models.PromoCodeUsage{PromoCode: *promo}))
+}
+
+func (s *Server) ListPromoCodes(c echo.Context) error {
+	promos, err := s.promoLogic.List(c.Request().Context())
+	if err != nil {
+		return processError(c, err, "list promo codes")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(promos, func(p models.PromoCodeUsage, _ int) openapi.PromoCodeResponse {
+		return fromPromoCode(p)
+	}))
+}
+
+func (s *Server) ListExchangeRates(c echo.Context) error {
+	rates, err := s.exchangeLogic.List(c.Request().Context())
+	if err != nil {
+		return processError(c, err, "list exchange rates")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(rates, func(r models.ExchangeRate, _ int) openapi.ExchangeRate {
+		return fromExchangeRate(r)
+	}))
+}
+
+func (s *Server) SetExchangeRate(c echo.Context, currency string) error {
+	var req openapi.ExchangeRateRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidRate), "cannot unmarshal request body")
+	}
+
+	rate, err := s.exchangeLogic.Set(c.Request().Context(), models.ExchangeRate{
+		Currency: currency,
+		Exponent: req.Exponent,
+		Rate:     req.Rate,
+	})
+	if err != nil {
+		return processError(c, err, "set exchange rate")
+	}
+
+	return c.JSON(http.StatusOK, fromExchangeRate(*rate))
+}
+
+func (s *Server) Convert(c echo.Context) error {
+	var req openapi.ConvertRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidRate), "cannot unmarshal request body")
+	}
+
+	amounts, err := s.exchangeLogic.Convert(c.Request().Context(), lo.Map(req.Amounts, func(m openapi.Money, _ int) models.Money {
+		return toMoney(m)
+	}), req.Currency)
+	if err != nil {
+		return processError(c, err, "convert amounts")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(amounts, func(m models.Money, _ int) openapi.Money {
+		return fromMoney(m)
+	}))
+}
+
 func (s *Server) Live(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
 type paymentLogic interface {
 	Create(ctx context.Context, req models.CreatePaymentRequest) (*models.Payment, error)
-	Cancel(ctx context.Context, uid uuid.UUID) error
-	Get(ctx context.Context, uid uuid.UUID) (*models.Payment, error)
+	Cancel(ctx context.Context, uid uuid.UUID, caller models.Caller, req models.CancelPaymentRequest) (*models.Payment, error)
+	Refund(ctx context.Context, uid uuid.UUID, caller models.Caller, req models.RefundPaymentRequest) (*models.Payment, error)
+	Capture(ctx context.Context, uid uuid.UUID, caller models.Caller, rentalUUID *uuid.UUID) (*models.Payment, error)
+	Confirm(ctx context.Context, uid uuid.UUID, caller models.Caller) (*models.Payment, error)
+	Get(ctx context.Context, uid uuid.UUID, caller models.Caller) (*models.Payment, error)
+	RentalLedger(ctx context.Context, rentalUUID uuid.UUID, caller models.Caller) (*models.RentalLedger, error)
+}
+
+type promoLogic interface {
+	Create(ctx context.Context, promo models.PromoCode) (*models.PromoCode, error)
+	List(ctx context.Context) ([]models.PromoCodeUsage, error)
+}
+
+type invoiceLogic interface {
+	Get(ctx context.Context, rentalUUID uuid.UUID, caller models.Caller) (*models.Invoice, error)
+	Generate(ctx context.Context, req models.InvoiceRequest, caller models.Caller) (*models.Invoice, error)
+}
+
+type exchangeLogic interface {
+	List(ctx context.Context) ([]models.ExchangeRate, error)
+	Set(ctx context.Context, rate models.ExchangeRate) (*models.ExchangeRate, error)
+	Convert(ctx context.Context, amounts []models.Money, currency string) ([]models.Money, error)
 }
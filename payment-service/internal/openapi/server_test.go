@@ -0,0 +1,140 @@
+package openapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/auth"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/generated/openapi"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/logic"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/logic/mocks"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestServer_Owner(t *testing.T) {
+	paymentUUID := uuid.New()
+	rentalUUID := uuid.New()
+
+	payment := &models.Payment{
+		UUID:        paymentUUID,
+		Price:       10000,
+		Currency:    "RUB",
+		Status:      models.Authorized,
+		Username:    "user",
+		ProviderRef: "ref",
+	}
+
+	invoiceBody := `{"car":{"brand":"Mercedes Benz","model":"GLA 250","registrationNumber":"ЛО777Х799","type":"SEDAN"},
+"dateFrom":"2021-10-08","dateTo":"2021-10-11","currency":"RUB","rentalStatus":"FINISHED",
+"lines":[{"description":"Rental","quantity":3,"amount":1050000}]}`
+
+	newServer := func(t *testing.T) (*Server, *mocks.PaymentRepo, *mocks.InvoiceRepo) {
+		paymentRepo := mocks.NewPaymentRepo(t)
+		invoiceRepo := mocks.NewInvoiceRepo(t)
+
+		return New(
+			logic.New(paymentRepo, mocks.NewPaymentProvider(t), models.CancellationPolicy{}, models.TaxPolicy{}),
+			nil,
+			nil,
+			logic.NewInvoice(invoiceRepo, mocks.NewInvoiceRenderer(t)),
+		), paymentRepo, invoiceRepo
+	}
+
+	newContext := func(method, body, username string) (echo.Context, *httptest.ResponseRecorder) {
+		req := httptest.NewRequest(method, "/", strings.NewReader(body))
+		req = req.WithContext(auth.WithUser(req.Context(), username, false))
+		rec := httptest.NewRecorder()
+
+		return echo.New().NewContext(req, rec), rec
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		call   func(s *Server, c echo.Context) error
+		expect func(ctx context.Context, paymentRepo *mocks.PaymentRepo, invoiceRepo *mocks.InvoiceRepo)
+	}{
+		{
+			name:   "capture",
+			method: http.MethodPost,
+			call: func(s *Server, c echo.Context) error {
+				return s.Capture(c, paymentUUID, openapi.CaptureParams{RentalUid: &rentalUUID})
+			},
+			expect: func(ctx context.Context, paymentRepo *mocks.PaymentRepo, _ *mocks.InvoiceRepo) {
+				paymentRepo.EXPECT().Get(ctx, paymentUUID).Return(payment, nil)
+			},
+		},
+		{
+			name:   "confirm",
+			method: http.MethodPost,
+			call: func(s *Server, c echo.Context) error {
+				return s.Confirm(c, paymentUUID)
+			},
+			expect: func(ctx context.Context, paymentRepo *mocks.PaymentRepo, _ *mocks.InvoiceRepo) {
+				paymentRepo.EXPECT().Get(ctx, paymentUUID).Return(payment, nil)
+			},
+		},
+		{
+			name:   "rental ledger",
+			method: http.MethodGet,
+			call: func(s *Server, c echo.Context) error {
+				return s.GetRentalLedger(c, rentalUUID)
+			},
+			expect: func(ctx context.Context, paymentRepo *mocks.PaymentRepo, _ *mocks.InvoiceRepo) {
+				paymentRepo.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"user"}, nil)
+			},
+		},
+		{
+			name:   "invoice",
+			method: http.MethodGet,
+			call: func(s *Server, c echo.Context) error {
+				return s.GetInvoice(c, rentalUUID)
+			},
+			expect: func(ctx context.Context, _ *mocks.PaymentRepo, invoiceRepo *mocks.InvoiceRepo) {
+				invoiceRepo.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"user"}, nil)
+			},
+		},
+		{
+			name:   "invoice generation",
+			method: http.MethodPost,
+			body:   invoiceBody,
+			call: func(s *Server, c echo.Context) error {
+				return s.GenerateInvoice(c, rentalUUID)
+			},
+			expect: func(ctx context.Context, _ *mocks.PaymentRepo, invoiceRepo *mocks.InvoiceRepo) {
+				invoiceRepo.EXPECT().ListRentalOwners(ctx, rentalUUID).Return([]string{"user"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" of another user", func(t *testing.T) {
+			s, paymentRepo, invoiceRepo := newServer(t)
+			c, rec := newContext(tt.method, tt.body, "other")
+
+			tt.expect(c.Request().Context(), paymentRepo, invoiceRepo)
+
+			require.NoError(t, tt.call(s, c))
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		})
+	}
+
+	t.Run("rental ledger of the owner", func(t *testing.T) {
+		s, paymentRepo, _ := newServer(t)
+		c, rec := newContext(http.MethodGet, "", "user")
+
+		paymentRepo.EXPECT().ListRentalOwners(c.Request().Context(), rentalUUID).Return([]string{"user"}, nil)
+		paymentRepo.EXPECT().ListRentalEntries(c.Request().Context(), rentalUUID).Return(nil, nil)
+
+		require.NoError(t, s.GetRentalLedger(c, rentalUUID))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
@@ -0,0 +1,129 @@
+// Package fake is an in-process payment provider for development and tests.
+// It keeps no state: the outcome of an authorization depends only on the payment method token,
+// and the token is kept in the reference, so captures and refunds behave the same after a restart.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+)
+
+// Payment method tokens understood by the provider. Any other token is approved.
+const (
+	MethodDeclined          = "tok_declined"
+	MethodInsufficientFunds = "tok_insufficient_funds"
+	MethodTimeout           = "tok_timeout"
+	Method3DS               = "tok_3ds"
+	// MethodCaptureDeclined is authorized, but the capture is declined.
+	MethodCaptureDeclined = "tok_capture_declined"
+)
+
+const (
+	defaultMethod   = "tok_ok"
+	referencePrefix = "fake"
+)
+
+type Provider struct{}
+
+func New() *Provider {
+	return &Provider{}
+}
+
+func (p *Provider) Authorize(_ context.Context, req models.AuthorizeRequest) (*models.ProviderResult, error) {
+	method := req.Method
+	if method == "" {
+		method = defaultMethod
+	}
+
+	reference := strings.Join([]string{referencePrefix, method, req.PaymentUUID.String()}, ":")
+
+	switch {
+	case method == MethodTimeout:
+		return nil, fmt.Errorf("authorize: %w (%w)", context.DeadlineExceeded, models.ErrProviderUnavailable)
+	case method == MethodDeclined:
+		return declined(reference, "card declined"), nil
+	case method == MethodInsufficientFunds:
+		return declined(reference, "insufficient funds"), nil
+	case req.Amount <= 0:
+		return declined(reference, "invalid amount"), nil
+	case method == Method3DS:
+		return &models.ProviderResult{
+			Reference: reference,
+			Status:    models.ProviderActionRequired,
+		}, nil
+	default:
+		return approved(reference), nil
+	}
+}
+
+// Confirm completes the 3-D Secure challenge, it is always passed.
+func (p *Provider) Confirm(_ context.Context, reference string) (*models.ProviderResult, error) {
+	if _, err := parseReference(reference); err != nil {
+		return nil, err
+	}
+
+	return approved(reference), nil
+}
+
+func (p *Provider) Capture(_ context.Context, reference string, amount int) (*models.ProviderResult, error) {
+	method, err := parseReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case method == MethodCaptureDeclined:
+		return declined(reference, "capture declined"), nil
+	case amount <= 0:
+		return declined(reference, "invalid amount"), nil
+	default:
+		return approved(reference), nil
+	}
+}
+
+func (p *Provider) Void(_ context.Context, reference string) (*models.ProviderResult, error) {
+	if _, err := parseReference(reference); err != nil {
+		return nil, err
+	}
+
+	return approved(reference), nil
+}
+
+func (p *Provider) Refund(_ context.Context, reference string, amount int) (*models.ProviderResult, error) {
+	if _, err := parseReference(reference); err != nil {
+		return nil, err
+	}
+
+	if amount <= 0 {
+		return declined(reference, "invalid amount"), nil
+	}
+
+	return approved(reference), nil
+}
+
+func parseReference(reference string) (string, error) {
+	parts := strings.Split(reference, ":")
+	if len(parts) != 3 || parts[0] != referencePrefix {
+		return "", fmt.Errorf("unknown reference %q (%w)", reference, models.ErrInvalidPayment)
+	}
+
+	return parts[1], nil
+}
+
+func approved(reference string) *models.ProviderResult {
+	return &models.ProviderResult{
+		Reference: reference,
+		Status:    models.ProviderApproved,
+	}
+}
+
+func declined(reference, reason string) *models.ProviderResult {
+	return &models.ProviderResult{
+		Reference: reference,
+		Status:    models.ProviderDeclined,
+		Reason:    reason,
+	}
+}
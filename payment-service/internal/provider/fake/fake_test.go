@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestProvider_Authorize(t *testing.T) {
+	tests := map[string]struct {
+		method string
+		status models.ProviderStatus
+	}{
+		"approved by default": {"", models.ProviderApproved},
+		"declined":            {MethodDeclined, models.ProviderDeclined},
+		"insufficient funds":  {MethodInsufficientFunds, models.ProviderDeclined},
+		"3-D Secure":          {Method3DS, models.ProviderActionRequired},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := New().Authorize(context.Background(), models.AuthorizeRequest{
+				PaymentUUID: uuid.New(),
+				Amount:      1000,
+				Method:      tt.method,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.status, got.Status)
+		})
+	}
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := New().Authorize(context.Background(), models.AuthorizeRequest{
+			PaymentUUID: uuid.New(),
+			Amount:      1000,
+			Method:      MethodTimeout,
+		})
+		require.ErrorIs(t, err, models.ErrProviderUnavailable)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestProvider_Capture(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+
+	authorized, err := p.Authorize(ctx, models.AuthorizeRequest{PaymentUUID: uuid.New(), Amount: 1000})
+	require.NoError(t, err)
+
+	got, err := p.Capture(ctx, authorized.Reference, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, models.ProviderApproved, got.Status)
+
+	authorized, err = p.Authorize(ctx, models.AuthorizeRequest{PaymentUUID: uuid.New(), Amount: 1000, Method: MethodCaptureDeclined})
+	require.NoError(t, err)
+	assert.Equal(t, models.ProviderApproved, authorized.Status)
+
+	got, err = p.Capture(ctx, authorized.Reference, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, models.ProviderDeclined, got.Status)
+
+	_, err = p.Capture(ctx, "unknown", 1000)
+	require.ErrorIs(t, err, models.ErrInvalidPayment)
+}
@@ -0,0 +1,31 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+func (p *Payment) ListExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	var rates []models.ExchangeRate
+
+	err := p.db.Table("exchange_rates").WithContext(ctx).Order("currency").Find(&rates).Error
+	if err != nil {
+		return nil, fmt.Errorf("find exchange rates in db: %w", err)
+	}
+
+	return rates, nil
+}
+
+func (p *Payment) SaveExchangeRate(ctx context.Context, rate models.ExchangeRate) (*models.ExchangeRate, error) {
+	err := p.db.Table("exchange_rates").WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(&rate).Error
+	if err != nil {
+		return nil, fmt.Errorf("save exchange rate in db: %w", err)
+	}
+
+	return &rate, nil
+}
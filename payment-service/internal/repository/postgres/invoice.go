@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"gorm.io/gorm"
+)
+
+func (p *Payment) GetInvoice(ctx context.Context, rentalUUID uuid.UUID) (*models.Invoice, error) {
+	var invoice models.Invoice
+
+	err := p.db.Table("invoices").WithContext(ctx).First(&invoice, "rental_uid = ?", rentalUUID).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get invoice from db: %w", models.ErrInvoiceNotFound)
+		}
+
+		return nil, fmt.Errorf("get invoice from db: %w", err)
+	}
+
+	return &invoice, nil
+}
+
+func (p *Payment) CreateInvoice(ctx context.Context, invoice models.Invoice) (*models.Invoice, error) {
+	err := p.db.Table("invoices").WithContext(ctx).Create(&invoice).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, fmt.Errorf("create invoice in db: %w", models.ErrInvoiceExists)
+		}
+
+		return nil, fmt.Errorf("create invoice in db: %w", err)
+	}
+
+	return &invoice, nil
+}
+
+// NextInvoiceNumber takes the number from the database sequence. Numbers of invoices which failed to save are skipped.
+func (p *Payment) NextInvoiceNumber(ctx context.Context) (int, error) {
+	var seq int
+
+	err := p.db.WithContext(ctx).Raw("SELECT nextval('invoice_number_seq')").Scan(&seq).Error
+	if err != nil {
+		return 0, fmt.Errorf("get next invoice number from db: %w", err)
+	}
+
+	return seq, nil
+}
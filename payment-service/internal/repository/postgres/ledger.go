@@ -0,0 +1,88 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+)
+
+func (p *Payment) ListRentalEntries(ctx context.Context, rentalUUID uuid.UUID) ([]models.JournalEntry, error) {
+	entries, err := listEntries(p.db.WithContext(ctx), "rental_uid = ?", rentalUUID)
+	if err != nil {
+		return nil, fmt.Errorf("list rental entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ListRentalOwners returns the users who paid for the rental.
+func (p *Payment) ListRentalOwners(ctx context.Context, rentalUUID uuid.UUID) ([]string, error) {
+	var owners []string
+
+	err := p.db.Table("payment").WithContext(ctx).Where("rental_uid = ?", rentalUUID).Distinct().Pluck("username", &owners).Error
+	if err != nil {
+		return nil, fmt.Errorf("find rental owners in db: %w", err)
+	}
+
+	return owners, nil
+}
+
+func listEntries(db *gorm.DB, query string, args ...any) ([]models.JournalEntry, error) {
+	var entries []models.JournalEntry
+
+	err := db.Table("journal_entries").Where(query, args...).Order("id").Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("find journal entries in db: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	var postings []models.Posting
+
+	err = db.Table("ledger_postings").
+		Where("entry_uid IN ?", lo.Map(entries, func(entry models.JournalEntry, _ int) uuid.UUID {
+			return entry.UUID
+		})).
+		Order("id").
+		Find(&postings).Error
+	if err != nil {
+		return nil, fmt.Errorf("find ledger postings in db: %w", err)
+	}
+
+	byEntry := lo.GroupBy(postings, func(posting models.Posting) uuid.UUID {
+		return posting.EntryUUID
+	})
+	for i := range entries {
+		entries[i].Postings = byEntry[entries[i].UUID]
+	}
+
+	return entries, nil
+}
+
+// createEntries checks that entries are balanced before saving them, the ledger can't be corrected afterwards.
+func createEntries(tx *gorm.DB, entries []models.JournalEntry) error {
+	for _, entry := range entries {
+		err := entry.Validate()
+		if err != nil {
+			return fmt.Errorf("validate journal entry: %w", err)
+		}
+
+		err = tx.Table("journal_entries").Create(&entry).Error
+		if err != nil {
+			return fmt.Errorf("create journal entry in db: %w", err)
+		}
+
+		err = tx.Table("ledger_postings").Create(&entry.Postings).Error
+		if err != nil {
+			return fmt.Errorf("create ledger postings in db: %w", err)
+		}
+	}
+
+	return nil
+}
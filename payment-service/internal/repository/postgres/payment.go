@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"github.com/samber/lo"
 	"gorm.io/gorm"
 )
 
@@ -32,27 +35,170 @@ func (p *Payment) Get(ctx context.Context, uid uuid.UUID) (*models.Payment, erro
 		return nil, fmt.Errorf("get payment from db: %w", err)
 	}
 
+	err = p.db.Table("payment_taxes").WithContext(ctx).Where("payment_uid = ?", uid).Order("id").Find(&payment.Taxes).Error
+	if err != nil {
+		return nil, fmt.Errorf("find payment taxes in db: %w", err)
+	}
+
+	entries, err := listEntries(p.db.WithContext(ctx), "payment_uid = ?", uid)
+	if err != nil {
+		return nil, fmt.Errorf("list payment entries: %w", err)
+	}
+
+	payment.Post(entries...)
+
 	return &payment, nil
 }
 
-func (p *Payment) Create(ctx context.Context, payment models.Payment) (*models.Payment, error) {
-	err := p.db.Table("payment").WithContext(ctx).Create(&payment).Error
+func (p *Payment) Create(ctx context.Context, payment models.Payment, events ...outbox.DomainEvent) (*models.Payment, error) {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := createPayment(tx, &payment)
+		if err != nil {
+			return err
+		}
+
+		return outbox.Save(tx, events)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create payment in db: %w", err)
+		return nil, fmt.Errorf("transaction: %w", err)
 	}
 
 	return &payment, nil
 }
 
-func (p *Payment) ChangeStatus(ctx context.Context, uid uuid.UUID, status models.PaymentStatus) error {
-	res := p.db.Table("payment").WithContext(ctx).Where("payment_uid = ?", uid).Update("status", status)
+// createPayment saves the payment with its tax lines.
+func createPayment(tx *gorm.DB, payment *models.Payment) error {
+	err := tx.Table("payment").Create(payment).Error
+	if err != nil {
+		return fmt.Errorf("create payment in db: %w", err)
+	}
+
+	if len(payment.Taxes) == 0 {
+		return nil
+	}
+
+	err = tx.Table("payment_taxes").Create(&payment.Taxes).Error
+	if err != nil {
+		return fmt.Errorf("create payment taxes in db: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves the new payment state with new ledger entries. Only payments in the expected status are changed,
+// so concurrent requests can't capture or refund twice.
+func (p *Payment) Update(ctx context.Context, payment models.Payment, from models.PaymentStatus, events ...outbox.DomainEvent) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := updatePayment(tx, payment, from)
+		if err != nil {
+			return err
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Cancel saves the new payment state with the refund and cancels the promo code redemption, so the code can be used again.
+func (p *Payment) Cancel(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund *models.Refund, events ...outbox.DomainEvent) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := updatePayment(tx, payment, from)
+		if err != nil {
+			return err
+		}
+
+		if refund != nil {
+			err := tx.Table("refunds").Create(refund).Error
+			if err != nil {
+				return fmt.Errorf("create refund in db: %w", err)
+			}
+		}
+
+		err = tx.Table("promo_redemptions").
+			Where("payment_uid = ? AND canceled_at IS NULL", payment.UUID).
+			Update("canceled_at", time.Now().UTC()).Error
+		if err != nil {
+			return fmt.Errorf("cancel promo redemption in db: %w", err)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Refund saves the new payment state with the refund. Unlike Cancel, the promo code stays redeemed.
+func (p *Payment) Refund(ctx context.Context, payment models.Payment, from models.PaymentStatus, refund models.Refund, events ...outbox.DomainEvent) error {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := updatePayment(tx, payment, from)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Table("refunds").Create(&refund).Error
+		if err != nil {
+			return fmt.Errorf("create refund in db: %w", err)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+func updatePayment(tx *gorm.DB, payment models.Payment, from models.PaymentStatus) error {
+	res := tx.Table("payment").
+		Where("payment_uid = ? AND status = ?", payment.UUID, from).
+		Updates(map[string]any{
+			"status":         payment.Status,
+			"provider_ref":   payment.ProviderRef,
+			"rental_uid":     payment.RentalUUID,
+			"failure_reason": payment.FailureReason,
+			"canceled_at":    payment.CanceledAt,
+		})
 	if res.Error != nil {
 		return fmt.Errorf("update payment in db: %w", res.Error)
 	}
 
 	if res.RowsAffected == 0 {
-		return fmt.Errorf("update payment in db: %w", models.ErrPaymentNotFound)
+		return fmt.Errorf("update payment in db: %w", models.ErrPaymentChanged)
+	}
+
+	err := createEntries(tx, lo.Filter(payment.Entries, func(entry models.JournalEntry, _ int) bool {
+		return entry.ID == 0
+	}))
+	if err != nil {
+		return fmt.Errorf("create payment entries: %w", err)
 	}
 
 	return nil
 }
+
+// ListRentalTaxes returns taxes of the rental payments which were charged, voided payments charged nothing.
+func (p *Payment) ListRentalTaxes(ctx context.Context, rentalUUID uuid.UUID) ([]models.Tax, error) {
+	var taxes []models.Tax
+
+	err := p.db.WithContext(ctx).Table("payment_taxes").
+		Select("payment_taxes.*").
+		Joins("JOIN payment ON payment.payment_uid = payment_taxes.payment_uid").
+		Where("payment.rental_uid = ? AND payment.status IN ?", rentalUUID, []models.PaymentStatus{
+			models.Captured, models.Paid, models.Refunded, models.PartiallyRefunded,
+		}).
+		Order("payment_taxes.id").
+		Find(&taxes).Error
+	if err != nil {
+		return nil, fmt.Errorf("find rental taxes in db: %w", err)
+	}
+
+	return taxes, nil
+}
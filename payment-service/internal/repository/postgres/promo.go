@@ -0,0 +1,107 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+	"github.com/polnaya-katuxa/ds-lab-02/payment-service/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (p *Payment) CreatePromoCode(ctx context.Context, promo models.PromoCode) (*models.PromoCode, error) {
+	err := p.db.Table("promo_codes").WithContext(ctx).Create(&promo).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, fmt.Errorf("create promo code in db: %w", models.ErrPromoExists)
+		}
+
+		return nil, fmt.Errorf("create promo code in db: %w", err)
+	}
+
+	return &promo, nil
+}
+
+func (p *Payment) ListPromoCodes(ctx context.Context) ([]models.PromoCodeUsage, error) {
+	var promos []models.PromoCodeUsage
+
+	err := p.db.Table("promo_codes").WithContext(ctx).
+		Select("promo_codes.*, COUNT(promo_redemptions.id) AS used").
+		Joins("LEFT JOIN promo_redemptions ON promo_redemptions.promo_code_id = promo_codes.id AND promo_redemptions.canceled_at IS NULL").
+		Group("promo_codes.id").
+		Order("promo_codes.created_at DESC").
+		Find(&promos).Error
+	if err != nil {
+		return nil, fmt.Errorf("find promo codes in db: %w", err)
+	}
+
+	return promos, nil
+}
+
+func (p *Payment) GetPromoCode(ctx context.Context, code string) (*models.PromoCode, error) {
+	var promo models.PromoCode
+
+	err := p.db.Table("promo_codes").WithContext(ctx).First(&promo, "code = ?", code).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get promo code from db: %w", models.ErrPromoNotFound)
+		}
+
+		return nil, fmt.Errorf("get promo code from db: %w", err)
+	}
+
+	return &promo, nil
+}
+
+// CreateWithRedemption saves the payment with the promo code redemption.
+// The promo code row is locked while its usage limits are checked, so concurrent payments can't exceed them.
+func (p *Payment) CreateWithRedemption(ctx context.Context, payment models.Payment, redemption models.PromoRedemption, events ...outbox.DomainEvent) (*models.Payment, error) {
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var promo models.PromoCode
+		err := tx.Table("promo_codes").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&promo, "id = ?", redemption.PromoCodeID).Error
+		if err != nil {
+			return fmt.Errorf("lock promo code in db: %w", err)
+		}
+
+		var used, usedByUser int64
+		err = tx.Table("promo_redemptions").
+			Where("promo_code_id = ? AND canceled_at IS NULL", promo.ID).
+			Count(&used).Error
+		if err != nil {
+			return fmt.Errorf("count promo redemptions in db: %w", err)
+		}
+
+		err = tx.Table("promo_redemptions").
+			Where("promo_code_id = ? AND username = ? AND canceled_at IS NULL", promo.ID, redemption.Username).
+			Count(&usedByUser).Error
+		if err != nil {
+			return fmt.Errorf("count user promo redemptions in db: %w", err)
+		}
+
+		if (promo.MaxUses > 0 && int(used) >= promo.MaxUses) ||
+			(promo.MaxUsesPerUser > 0 && int(usedByUser) >= promo.MaxUsesPerUser) {
+			return fmt.Errorf("check promo code limits: %w", models.ErrPromoUsedUp)
+		}
+
+		err = createPayment(tx, &payment)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Table("promo_redemptions").Create(&redemption).Error
+		if err != nil {
+			return fmt.Errorf("create promo redemption in db: %w", err)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction: %w", err)
+	}
+
+	return &payment, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"embed"
 	"errors"
 	"flag"
@@ -9,14 +10,19 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/auth"
 	openapiGenerated "github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/generated/openapi"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/logic"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/openapi"
 	repositoryPostgres "github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/repository/postgres"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/requestid"
 	"github.com/pressly/goose/v3"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -69,13 +75,37 @@ func run() error {
 	}
 
 	repo := repositoryPostgres.New(db)
-	logic := logic.New(repo)
+
+	var relay *outbox.Relay
+	if cfg.Outbox.Interval > 0 {
+		domainEventsProducer, err := outbox.NewProducer(cfg.Kafka.Brokers, cfg.Kafka.DomainEventsTopic, logger)
+		if err != nil {
+			return fmt.Errorf("init domain events producer: %w", err)
+		}
+		defer domainEventsProducer.Stop()
+
+		relay = outbox.NewRelay(outbox.NewPostgresStore(db, outboxLockKey), domainEventsProducer, cfg.Outbox.BatchSize, logger)
+	}
+
+	rentalLogic := logic.New(repo, models.RentLimits{
+		MinDays: cfg.Rental.MinDays,
+		MaxDays: cfg.Rental.MaxDays,
+	}, cfg.Tariff)
+	pricingLogic := logic.NewPricing(repo, cfg.Pricing.toRules())
+	scheduler := logic.NewScheduler(rentalLogic, models.SchedulerPolicy{
+		NoShow:            models.NoShowPolicy(cfg.Scheduler.NoShow.Policy),
+		NoShowGracePeriod: cfg.Scheduler.NoShow.GracePeriod,
+	}, logger)
 
 	e := echo.New()
-	e.Use(auth.CreateMiddleware(cfg.JWKsURL, cfg.ServicePassword))
-	server := openapi.New(logic)
+	e.Use(requestid.CreateMiddleware())
+	e.Use(auth.CreateMiddleware(cfg.JWKsURL, cfg.ServicePassword, cfg.AdminRole))
+	server := openapi.New(rentalLogic, pricingLogic)
 	openapiGenerated.RegisterHandlers(e, server)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
 	go func() {
@@ -83,6 +113,8 @@ func run() error {
 
 		logger.Info("shutting down")
 
+		cancel()
+
 		rawDB, err := db.DB()
 		if err == nil {
 			rawDB.Close()
@@ -101,6 +133,28 @@ func run() error {
 		return nil
 	})
 
+	if cfg.Scheduler.Interval > 0 {
+		if relay == nil {
+			logger.Warn("scheduler events are not published while outbox relay is disabled")
+		}
+
+		g.Go(func() error {
+			scheduler.Run(ctx, cfg.Scheduler.Interval)
+			return nil
+		})
+	} else {
+		logger.Warn("scheduler is disabled")
+	}
+
+	if relay != nil {
+		g.Go(func() error {
+			relay.Run(ctx, cfg.Outbox.Interval)
+			return nil
+		})
+	} else {
+		logger.Warn("outbox relay is disabled")
+	}
+
 	if err := g.Wait(); err != nil {
 		return fmt.Errorf("errgroup: %w", err)
 	}
@@ -178,4 +232,67 @@ type config struct {
 	LogLevel        string
 	JWKsURL         string
 	ServicePassword string
+	AdminRole       string
+	Rental          rental
+	Kafka           kafka
+	Outbox          outboxRelay
+	Scheduler       scheduler
+	Pricing         pricing
+	Tariff          models.Tariff
+}
+
+type rental struct {
+	MinDays int
+	MaxDays int
+}
+
+type kafka struct {
+	Brokers           []string
+	DomainEventsTopic string
+}
+
+// outboxLockKey is the key of the advisory lock which lets one replica publish the outbox at a time,
+// keys of the services differ in case they share a database.
+const outboxLockKey int64 = 7_265_002
+
+// outboxRelay publishes domain events, the relay is disabled when the interval is zero.
+type outboxRelay struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+type scheduler struct {
+	Interval time.Duration
+	NoShow   noShow
+}
+
+type noShow struct {
+	Policy      string
+	GracePeriod time.Duration
+}
+
+type pricing struct {
+	Currency          string
+	QuoteTTL          time.Duration
+	TypeRates         map[string]int
+	WeekendMultiplier float64
+	Seasons           []models.Season
+	DurationDiscounts []models.DurationDiscount
+}
+
+// toRules restores the case of car types, because viper lowercases map keys.
+func (p *pricing) toRules() models.PricingRules {
+	typeRates := make(map[string]int, len(p.TypeRates))
+	for carType, rate := range p.TypeRates {
+		typeRates[strings.ToUpper(carType)] = rate
+	}
+
+	return models.PricingRules{
+		Currency:          p.Currency,
+		QuoteTTL:          p.QuoteTTL,
+		TypeRates:         typeRates,
+		WeekendMultiplier: p.WeekendMultiplier,
+		Seasons:           p.Seasons,
+		DurationDiscounts: p.DurationDiscounts,
+	}
 }
@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Rentals booked before the constraint may already overlap, the constraint can't be added then.
+-- Of the overlapping rentals of a car the earliest booked one is kept, the others are canceled
+-- and reported in rental_overlap_conflicts, their payments are returned by the operator.
+CREATE TABLE rental_overlap_conflicts
+(
+    rental_uid      uuid PRIMARY KEY,
+    kept_rental_uid uuid                     NOT NULL,
+    car_uid         uuid                     NOT NULL,
+    payment_uid     uuid                     NOT NULL,
+    username        VARCHAR(80)              NOT NULL,
+    detected_at     TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+INSERT INTO rental_overlap_conflicts (rental_uid, kept_rental_uid, car_uid, payment_uid, username, detected_at)
+SELECT DISTINCT ON (later.id) later.rental_uid, earlier.rental_uid, later.car_uid, later.payment_uid, later.username, now()
+FROM rental AS later
+         JOIN rental AS earlier
+              ON earlier.car_uid = later.car_uid
+                  AND earlier.id < later.id
+                  AND tstzrange(earlier.date_from, earlier.date_to) && tstzrange(later.date_from, later.date_to)
+WHERE later.status = 'IN_PROGRESS'
+  AND earlier.status = 'IN_PROGRESS'
+ORDER BY later.id, earlier.id;
+
+UPDATE rental
+SET status = 'CANCELED'
+WHERE rental_uid IN (SELECT rental_uid FROM rental_overlap_conflicts);
+
+DO
+$$
+    DECLARE
+        conflicts INT;
+    BEGIN
+        SELECT COUNT(*) INTO conflicts FROM rental_overlap_conflicts;
+        IF conflicts > 0 THEN
+            RAISE WARNING '% overlapping rentals canceled, see rental_overlap_conflicts', conflicts;
+        END IF;
+    END
+$$;
+
+ALTER TABLE rental
+    ADD CONSTRAINT rental_car_period_excl
+        EXCLUDE USING gist (car_uid WITH =, tstzrange(date_from, date_to) WITH &&)
+        WHERE (status = 'IN_PROGRESS');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE rental
+    DROP CONSTRAINT IF EXISTS rental_car_period_excl;
+
+DROP TABLE IF EXISTS rental_overlap_conflicts;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE rental
+    DROP CONSTRAINT rental_status_check;
+
+ALTER TABLE rental
+    ADD CONSTRAINT rental_status_check
+        CHECK (status IN ('RESERVED', 'IN_PROGRESS', 'OVERDUE', 'FINISHED', 'CANCELED'));
+
+ALTER TABLE rental
+    DROP CONSTRAINT rental_car_period_excl;
+
+ALTER TABLE rental
+    ADD CONSTRAINT rental_car_period_excl
+        EXCLUDE USING gist (car_uid WITH =, tstzrange(date_from, date_to) WITH &&)
+        WHERE (status IN ('RESERVED', 'IN_PROGRESS', 'OVERDUE'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE rental
+    DROP CONSTRAINT rental_car_period_excl;
+
+ALTER TABLE rental
+    ADD CONSTRAINT rental_car_period_excl
+        EXCLUDE USING gist (car_uid WITH =, tstzrange(date_from, date_to) WITH &&)
+        WHERE (status = 'IN_PROGRESS');
+
+ALTER TABLE rental
+    DROP CONSTRAINT rental_status_check;
+
+ALTER TABLE rental
+    ADD CONSTRAINT rental_status_check
+        CHECK (status IN ('IN_PROGRESS', 'FINISHED', 'CANCELED'));
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE rental_events
+(
+    id          SERIAL PRIMARY KEY,
+    rental_uid  uuid                     NOT NULL REFERENCES rental (rental_uid),
+    from_status VARCHAR(20),
+    to_status   VARCHAR(20)              NOT NULL,
+    actor       VARCHAR(80)              NOT NULL,
+    reason      TEXT                     NOT NULL DEFAULT '',
+    request_id  VARCHAR(64)              NOT NULL DEFAULT '',
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX rental_events_rental_uid_idx ON rental_events (rental_uid, created_at);
+
+INSERT INTO rental_events (rental_uid, to_status, actor, reason)
+SELECT rental_uid, status, 'migration', 'history started after the rent was created'
+FROM rental;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rental_events;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE quotes
+(
+    id          SERIAL PRIMARY KEY,
+    quote_uid   uuid UNIQUE              NOT NULL,
+    car_uid     uuid                     NOT NULL,
+    date_from   TIMESTAMP WITH TIME ZONE NOT NULL,
+    date_to     TIMESTAMP WITH TIME ZONE NOT NULL,
+    days        INT                      NOT NULL,
+    currency    VARCHAR(3)               NOT NULL,
+    items       jsonb                    NOT NULL,
+    total_price INT                      NOT NULL,
+    expires_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+ALTER TABLE rental
+    ADD COLUMN quote_uid   uuid REFERENCES quotes (quote_uid),
+    ADD COLUMN price       INT        NOT NULL DEFAULT 0,
+    ADD COLUMN currency    VARCHAR(3) NOT NULL DEFAULT '',
+    ADD COLUMN price_items jsonb      NOT NULL DEFAULT '[]';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE rental
+    DROP COLUMN quote_uid,
+    DROP COLUMN price,
+    DROP COLUMN currency,
+    DROP COLUMN price_items;
+
+DROP TABLE IF EXISTS quotes;
+-- +goose StatementEnd
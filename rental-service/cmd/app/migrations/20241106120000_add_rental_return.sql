@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE rental
+    ADD COLUMN start_odometer   INT,
+    ADD COLUMN start_fuel_level INT,
+    ADD COLUMN end_odometer     INT,
+    ADD COLUMN end_fuel_level   INT,
+    ADD COLUMN returned_at      TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN charges          jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE rental
+    DROP COLUMN start_odometer,
+    DROP COLUMN start_fuel_level,
+    DROP COLUMN end_odometer,
+    DROP COLUMN end_fuel_level,
+    DROP COLUMN returned_at,
+    DROP COLUMN charges;
+-- +goose StatementEnd
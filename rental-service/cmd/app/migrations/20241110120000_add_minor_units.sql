@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Amounts are stored in minor units of the currency, e.g. kopecks.
+-- Rentals created before quotes were priced in RUB, the only currency at that time.
+CREATE FUNCTION scale_amounts(items jsonb, factor NUMERIC) RETURNS jsonb AS
+$$
+SELECT COALESCE(jsonb_agg(item || jsonb_build_object('amount', ROUND((item ->> 'amount')::NUMERIC * factor)::INT)),
+                '[]'::jsonb)
+FROM jsonb_array_elements(items) item
+$$ LANGUAGE SQL IMMUTABLE STRICT;
+
+UPDATE rental
+SET price       = price * 100,
+    price_items = scale_amounts(price_items, 100),
+    charges     = scale_amounts(charges, 100),
+    currency    = CASE WHEN currency = '' THEN 'RUB' ELSE currency END;
+
+UPDATE quotes
+SET total_price = total_price * 100,
+    items       = scale_amounts(items, 100);
+
+DROP FUNCTION scale_amounts(jsonb, NUMERIC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE FUNCTION scale_amounts(items jsonb, factor NUMERIC) RETURNS jsonb AS
+$$
+SELECT COALESCE(jsonb_agg(item || jsonb_build_object('amount', ROUND((item ->> 'amount')::NUMERIC * factor)::INT)),
+                '[]'::jsonb)
+FROM jsonb_array_elements(items) item
+$$ LANGUAGE SQL IMMUTABLE STRICT;
+
+UPDATE rental
+SET price       = price / 100,
+    price_items = scale_amounts(price_items, 0.01),
+    charges     = scale_amounts(charges, 0.01);
+
+UPDATE quotes
+SET total_price = total_price / 100,
+    items       = scale_amounts(items, 0.01);
+
+DROP FUNCTION scale_amounts(jsonb, NUMERIC);
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE rental_changes
+(
+    id               SERIAL PRIMARY KEY,
+    change_uid       uuid UNIQUE              NOT NULL,
+    rental_uid       uuid                     NOT NULL REFERENCES rental (rental_uid),
+    previous_date_to TIMESTAMP WITH TIME ZONE NOT NULL,
+    date_to          TIMESTAMP WITH TIME ZONE NOT NULL,
+    previous_price   INT                      NOT NULL,
+    price            INT                      NOT NULL,
+    currency         CHAR(3)                  NOT NULL,
+    actor            VARCHAR(80)              NOT NULL,
+    request_id       VARCHAR(64)              NOT NULL DEFAULT '',
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+    reverted_at      TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX rental_changes_rental_uid_idx ON rental_changes (rental_uid, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS rental_changes;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX rental_username_date_from_idx ON rental (username, date_from);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS rental_username_date_from_idx;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Domain events are saved with the state change and published to kafka by the relay.
+CREATE TABLE outbox
+(
+    id           SERIAL PRIMARY KEY,
+    event_uid    uuid UNIQUE              NOT NULL,
+    event_type   VARCHAR(80)              NOT NULL,
+    key          VARCHAR(80)              NOT NULL,
+    payload      JSONB                    NOT NULL,
+    created_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    published_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox;
+-- +goose StatementEnd
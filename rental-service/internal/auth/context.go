@@ -11,3 +11,9 @@ func GetUsername(ctx context.Context) string {
 	value, _ := ctx.Value(usernameKey).(string)
 	return value
 }
+
+// GetActor returns the user or the service which made the request.
+func GetActor(ctx context.Context) string {
+	value, _ := ctx.Value(actorKey).(string)
+	return value
+}
@@ -6,19 +6,115 @@ package openapi
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ChargeKind.
+const (
+	LATERETURN ChargeKind = "LATE_RETURN"
+	MILEAGE    ChargeKind = "MILEAGE"
+	REFUEL     ChargeKind = "REFUEL"
+)
+
+// Defines values for ChargeUnit.
+const (
+	DAY     ChargeUnit = "DAY"
+	HOUR    ChargeUnit = "HOUR"
+	KM      ChargeUnit = "KM"
+	PERCENT ChargeUnit = "PERCENT"
+)
+
+// Defines values for PriceItemKind.
+const (
+	BASE             PriceItemKind = "BASE"
+	DATECHANGE       PriceItemKind = "DATE_CHANGE"
+	DURATIONDISCOUNT PriceItemKind = "DURATION_DISCOUNT"
+	SEASON           PriceItemKind = "SEASON"
+	WEEKEND          PriceItemKind = "WEEKEND"
+)
+
 // Defines values for RentalResponseStatus.
 const (
-	CANCELED   RentalResponseStatus = "CANCELED"
-	FINISHED   RentalResponseStatus = "FINISHED"
-	INPROGRESS RentalResponseStatus = "IN_PROGRESS"
+	RentalResponseStatusCANCELED   RentalResponseStatus = "CANCELED"
+	RentalResponseStatusFINISHED   RentalResponseStatus = "FINISHED"
+	RentalResponseStatusINPROGRESS RentalResponseStatus = "IN_PROGRESS"
+	RentalResponseStatusOVERDUE    RentalResponseStatus = "OVERDUE"
+	RentalResponseStatusRESERVED   RentalResponseStatus = "RESERVED"
+)
+
+// Defines values for SearchRentalsParamsStatus.
+const (
+	SearchRentalsParamsStatusCANCELED   SearchRentalsParamsStatus = "CANCELED"
+	SearchRentalsParamsStatusFINISHED   SearchRentalsParamsStatus = "FINISHED"
+	SearchRentalsParamsStatusINPROGRESS SearchRentalsParamsStatus = "IN_PROGRESS"
+	SearchRentalsParamsStatusOVERDUE    SearchRentalsParamsStatus = "OVERDUE"
+	SearchRentalsParamsStatusRESERVED   SearchRentalsParamsStatus = "RESERVED"
+)
+
+// Defines values for SearchRentalsParamsSort.
+const (
+	SearchRentalsParamsSortDATEFROMASC  SearchRentalsParamsSort = "DATE_FROM_ASC"
+	SearchRentalsParamsSortDATEFROMDESC SearchRentalsParamsSort = "DATE_FROM_DESC"
+)
+
+// Defines values for GetUserRentalsParamsStatus.
+const (
+	CANCELED   GetUserRentalsParamsStatus = "CANCELED"
+	FINISHED   GetUserRentalsParamsStatus = "FINISHED"
+	INPROGRESS GetUserRentalsParamsStatus = "IN_PROGRESS"
+	OVERDUE    GetUserRentalsParamsStatus = "OVERDUE"
+	RESERVED   GetUserRentalsParamsStatus = "RESERVED"
+)
+
+// Defines values for GetUserRentalsParamsSort.
+const (
+	GetUserRentalsParamsSortDATEFROMASC  GetUserRentalsParamsSort = "DATE_FROM_ASC"
+	GetUserRentalsParamsSortDATEFROMDESC GetUserRentalsParamsSort = "DATE_FROM_DESC"
 )
 
+// CarReadings defines model for CarReadings.
+type CarReadings struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+}
+
+// ChangeRentalRequest defines model for ChangeRentalRequest.
+type ChangeRentalRequest struct {
+	// DateTo Новая дата окончания аренды
+	DateTo string `json:"dateTo"`
+}
+
+// Charge defines model for Charge.
+type Charge struct {
+	// Amount Сумма начисления в минимальных единицах валюты аренды
+	Amount int `json:"amount"`
+
+	// Description Описание начисления
+	Description string `json:"description"`
+
+	// Kind Вид начисления
+	Kind ChargeKind `json:"kind"`
+
+	// Quantity Количество единиц
+	Quantity int `json:"quantity"`
+
+	// Unit Единица измерения
+	Unit ChargeUnit `json:"unit"`
+}
+
+// ChargeKind Вид начисления
+type ChargeKind string
+
+// ChargeUnit Единица измерения
+type ChargeUnit string
+
 // CreateRentalRequest defines model for CreateRentalRequest.
 type CreateRentalRequest struct {
 	// CarUid UUID автомобиля
@@ -32,6 +128,9 @@ type CreateRentalRequest struct {
 
 	// PaymentUid UUID платежа
 	PaymentUid openapi_types.UUID `json:"paymentUid"`
+
+	// QuoteUid UUID расчета стоимости, по которому оформляется аренда
+	QuoteUid openapi_types.UUID `json:"quoteUid"`
 }
 
 // ErrorDescription defines model for ErrorDescription.
@@ -46,11 +145,190 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// FinishRentalRequest defines model for FinishRentalRequest.
+type FinishRentalRequest struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+
+	// ReturnedAt Фактическое время возврата, по умолчанию - текущее
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+}
+
+// ForceCancelRequest defines model for ForceCancelRequest.
+type ForceCancelRequest struct {
+	// Reason Причина отмены, записывается в историю аренды
+	Reason string `json:"reason"`
+}
+
+// ForceFinishRequest defines model for ForceFinishRequest.
+type ForceFinishRequest struct {
+	// FuelLevel Уровень топлива, процент от бака
+	FuelLevel *int `json:"fuelLevel,omitempty"`
+
+	// Odometer Показания одометра, км
+	Odometer *int `json:"odometer,omitempty"`
+
+	// Reason Причина завершения, записывается в историю аренды
+	Reason string `json:"reason"`
+
+	// ReturnedAt Фактическое время возврата, по умолчанию - текущее
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+}
+
+// OwnersRequest defines model for OwnersRequest.
+type OwnersRequest struct {
+	// PaymentUids UUID платежей за аренду
+	PaymentUids *[]openapi_types.UUID `json:"paymentUids,omitempty"`
+
+	// RentalUids UUID аренд
+	RentalUids *[]openapi_types.UUID `json:"rentalUids,omitempty"`
+}
+
+// PriceItem defines model for PriceItem.
+type PriceItem struct {
+	// Amount Сумма строки в минимальных единицах валюты, отрицательная для скидок
+	Amount int `json:"amount"`
+
+	// Description Описание строки расчета
+	Description string `json:"description"`
+
+	// Kind Вид строки расчета
+	Kind PriceItemKind `json:"kind"`
+
+	// Quantity Количество дней, к которым применена строка
+	Quantity int `json:"quantity"`
+}
+
+// PriceItemKind Вид строки расчета
+type PriceItemKind string
+
+// QuoteRequest defines model for QuoteRequest.
+type QuoteRequest struct {
+	// CarType Тип автомобиля
+	CarType string `json:"carType"`
+
+	// CarUid UUID автомобиля
+	CarUid openapi_types.UUID `json:"carUid"`
+
+	// Currency Код валюты ISO 4217 цены автомобиля, по умолчанию - валюта сервиса
+	Currency *string `json:"currency,omitempty"`
+
+	// DailyPrice Цена аренды автомобиля за день в минимальных единицах валюты
+	DailyPrice int `json:"dailyPrice"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+}
+
+// QuoteResponse defines model for QuoteResponse.
+type QuoteResponse struct {
+	// CarUid UUID автомобиля
+	CarUid openapi_types.UUID `json:"carUid"`
+
+	// Currency Код валюты ISO 4217
+	Currency string `json:"currency"`
+
+	// DateFrom Дата начала аренды
+	DateFrom string `json:"dateFrom"`
+
+	// DateTo Дата окончания аренды
+	DateTo string `json:"dateTo"`
+
+	// Days Количество дней аренды
+	Days int `json:"days"`
+
+	// ExpiresAt Время, до которого действует цена
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Items Детализация стоимости
+	Items []PriceItem `json:"items"`
+
+	// QuoteUid UUID расчета стоимости
+	QuoteUid openapi_types.UUID `json:"quoteUid"`
+
+	// TotalPrice Итоговая стоимость аренды в минимальных единицах валюты
+	TotalPrice int `json:"totalPrice"`
+}
+
+// RentalChange defines model for RentalChange.
+type RentalChange struct {
+	ChangeUid openapi_types.UUID `json:"changeUid"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Currency  string             `json:"currency"`
+	DateTo    string             `json:"dateTo"`
+
+	// Difference Доплата, если положительная, или сумма возврата, если отрицательная
+	Difference     int    `json:"difference"`
+	PreviousDateTo string `json:"previousDateTo"`
+
+	// PreviousPrice Стоимость аренды до изменения в минимальных единицах валюты
+	PreviousPrice int `json:"previousPrice"`
+
+	// Price Стоимость аренды после изменения в минимальных единицах валюты
+	Price      int                `json:"price"`
+	RentalUid  openapi_types.UUID `json:"rentalUid"`
+	RevertedAt *time.Time         `json:"revertedAt,omitempty"`
+}
+
+// RentalEvent defines model for RentalEvent.
+type RentalEvent struct {
+	// Actor Пользователь или сервис, изменивший статус
+	Actor string `json:"actor"`
+
+	// CreatedAt Время изменения
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromStatus Статус до изменения, отсутствует для создания аренды
+	FromStatus *string `json:"fromStatus,omitempty"`
+
+	// Reason Причина изменения
+	Reason *string `json:"reason,omitempty"`
+
+	// RequestId Идентификатор запроса, в рамках которого изменен статус
+	RequestId *string `json:"requestId,omitempty"`
+
+	// ToStatus Статус после изменения
+	ToStatus string `json:"toStatus"`
+}
+
+// RentalOwner defines model for RentalOwner.
+type RentalOwner struct {
+	PaymentUid openapi_types.UUID `json:"paymentUid"`
+	RentalUid  openapi_types.UUID `json:"rentalUid"`
+	Username   string             `json:"username"`
+}
+
+// RentalPaginationResponse defines model for RentalPaginationResponse.
+type RentalPaginationResponse struct {
+	Items []RentalResponse `json:"items"`
+
+	// Page Номер страницы
+	Page int `json:"page"`
+
+	// PageSize Количество элементов на странице
+	PageSize int `json:"pageSize"`
+
+	// TotalElements Количество аренд, подходящих под фильтр
+	TotalElements int `json:"totalElements"`
+}
+
 // RentalResponse defines model for RentalResponse.
 type RentalResponse struct {
 	// CarUid UUID автомобиля
 	CarUid openapi_types.UUID `json:"carUid"`
 
+	// Charges Дополнительные начисления при возврате автомобиля
+	Charges *[]Charge `json:"charges,omitempty"`
+
+	// Currency Код валюты ISO 4217
+	Currency *string `json:"currency,omitempty"`
+
 	// DateFrom Дата начала аренды
 	DateFrom string `json:"dateFrom"`
 
@@ -60,11 +338,23 @@ type RentalResponse struct {
 	// PaymentUid UUID платежа
 	PaymentUid openapi_types.UUID `json:"paymentUid"`
 
+	// Price Стоимость аренды, зафиксированная при бронировании, в минимальных единицах валюты
+	Price *int `json:"price,omitempty"`
+
+	// PriceItems Детализация стоимости
+	PriceItems *[]PriceItem `json:"priceItems,omitempty"`
+
 	// RentalUid UUID аренды
 	RentalUid openapi_types.UUID `json:"rentalUid"`
 
+	// ReturnedAt Фактическое время возврата автомобиля
+	ReturnedAt *time.Time `json:"returnedAt,omitempty"`
+
 	// Status Статус аренды
 	Status RentalResponseStatus `json:"status"`
+
+	// Username Имя пользователя
+	Username *string `json:"username,omitempty"`
 }
 
 // RentalResponseStatus Статус аренды
@@ -79,26 +369,141 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// SearchRentalsParams defines parameters for SearchRentals.
+type SearchRentalsParams struct {
+	// Username Имя пользователя
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+
+	// CarUid UUID автомобиля
+	CarUid *openapi_types.UUID `form:"carUid,omitempty" json:"carUid,omitempty"`
+
+	// Status Статусы аренд, по умолчанию - все
+	Status *[]SearchRentalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// DateFrom Начало периода, аренды которого пересекаются с периодом
+	DateFrom *string `form:"dateFrom,omitempty" json:"dateFrom,omitempty"`
+
+	// DateTo Конец периода, аренды которого пересекаются с периодом
+	DateTo *string `form:"dateTo,omitempty" json:"dateTo,omitempty"`
+
+	// Sort Сортировка по дате начала аренды, по умолчанию - сначала новые
+	Sort *SearchRentalsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page *int                     `form:"page,omitempty" json:"page,omitempty"`
+	Size *int                     `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// SearchRentalsParamsStatus defines parameters for SearchRentals.
+type SearchRentalsParamsStatus string
+
+// SearchRentalsParamsSort defines parameters for SearchRentals.
+type SearchRentalsParamsSort string
+
+// GetUserRentalsParams defines parameters for GetUserRentals.
+type GetUserRentalsParams struct {
+	// Status Статусы аренд, по умолчанию - все
+	Status *[]GetUserRentalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// DateFrom Начало периода, аренды которого пересекаются с периодом
+	DateFrom *string `form:"dateFrom,omitempty" json:"dateFrom,omitempty"`
+
+	// DateTo Конец периода, аренды которого пересекаются с периодом
+	DateTo *string `form:"dateTo,omitempty" json:"dateTo,omitempty"`
+
+	// Sort Сортировка по дате начала аренды, по умолчанию - сначала новые
+	Sort *GetUserRentalsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page *int                      `form:"page,omitempty" json:"page,omitempty"`
+	Size *int                      `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetUserRentalsParamsStatus defines parameters for GetUserRentals.
+type GetUserRentalsParamsStatus string
+
+// GetUserRentalsParamsSort defines parameters for GetUserRentals.
+type GetUserRentalsParamsSort string
+
+// CancelParams defines parameters for Cancel.
+type CancelParams struct {
+	// Reason Причина отмены
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// ForceCancelJSONRequestBody defines body for ForceCancel for application/json ContentType.
+type ForceCancelJSONRequestBody = ForceCancelRequest
+
+// ForceFinishJSONRequestBody defines body for ForceFinish for application/json ContentType.
+type ForceFinishJSONRequestBody = ForceFinishRequest
+
+// CreateQuoteJSONRequestBody defines body for CreateQuote for application/json ContentType.
+type CreateQuoteJSONRequestBody = QuoteRequest
+
 // CreateJSONRequestBody defines body for Create for application/json ContentType.
 type CreateJSONRequestBody = CreateRentalRequest
 
+// FindOwnersJSONRequestBody defines body for FindOwners for application/json ContentType.
+type FindOwnersJSONRequestBody = OwnersRequest
+
+// ChangeDatesJSONRequestBody defines body for ChangeDates for application/json ContentType.
+type ChangeDatesJSONRequestBody = ChangeRentalRequest
+
+// FinishJSONRequestBody defines body for Finish for application/json ContentType.
+type FinishJSONRequestBody = FinishRentalRequest
+
+// StartJSONRequestBody defines body for Start for application/json ContentType.
+type StartJSONRequestBody = CarReadings
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Получить информацию о всех арендах пользователя
+	// Поиск аренд всех пользователей
+	// (GET /api/v1/admin/rentals)
+	SearchRentals(ctx echo.Context, params SearchRentalsParams) error
+	// Информация по аренде любого пользователя
+	// (GET /api/v1/admin/rentals/{rentalUid})
+	GetAnyRental(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Принудительная отмена аренды
+	// (POST /api/v1/admin/rentals/{rentalUid}/cancel)
+	ForceCancel(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Принудительное завершение аренды
+	// (POST /api/v1/admin/rentals/{rentalUid}/finish)
+	ForceFinish(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// История изменений статуса аренды любого пользователя
+	// (GET /api/v1/admin/rentals/{rentalUid}/history)
+	GetAnyRentalHistory(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Расчет стоимости аренды
+	// (POST /api/v1/quotes)
+	CreateQuote(ctx echo.Context) error
+	// Получить расчет стоимости аренды
+	// (GET /api/v1/quotes/{quoteUid})
+	GetQuote(ctx echo.Context, quoteUid openapi_types.UUID) error
+	// Получить страницу аренд пользователя
 	// (GET /api/v1/rental)
-	GetUserRentals(ctx echo.Context) error
+	GetUserRentals(ctx echo.Context, params GetUserRentalsParams) error
 	// Оформить аренду
 	// (POST /api/v1/rental)
 	Create(ctx echo.Context) error
+	// Владельцы аренд по UUID платежей и аренд
+	// (POST /api/v1/rental/owners)
+	FindOwners(ctx echo.Context) error
 	// Отмена аренды
 	// (DELETE /api/v1/rental/{rentalUid})
-	Cancel(ctx echo.Context, rentalUid openapi_types.UUID) error
+	Cancel(ctx echo.Context, rentalUid openapi_types.UUID, params CancelParams) error
 	// Информация по конкретной аренде пользователя
 	// (GET /api/v1/rental/{rentalUid})
 	Get(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Изменение даты окончания аренды
+	// (PATCH /api/v1/rental/{rentalUid})
+	ChangeDates(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Отмена изменения даты окончания аренды
+	// (POST /api/v1/rental/{rentalUid}/changes/{changeUid}/revert)
+	RevertChange(ctx echo.Context, rentalUid openapi_types.UUID, changeUid openapi_types.UUID) error
 	// Завершение аренды
 	// (POST /api/v1/rental/{rentalUid}/finish)
 	Finish(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// История изменений статуса аренды
+	// (GET /api/v1/rental/{rentalUid}/history)
+	GetHistory(ctx echo.Context, rentalUid openapi_types.UUID) error
+	// Начало аренды (автомобиль получен)
+	// (POST /api/v1/rental/{rentalUid}/start)
+	Start(ctx echo.Context, rentalUid openapi_types.UUID) error
 	// Liveness probe
 	// (GET /manage/health)
 	Live(ctx echo.Context) error
@@ -109,12 +514,212 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// SearchRentals converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchRentals(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchRentalsParams
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "carUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "carUid", ctx.QueryParams(), &params.CarUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter carUid: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateFrom", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateFrom: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateTo", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateTo: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchRentals(ctx, params)
+	return err
+}
+
+// GetAnyRental converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAnyRental(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAnyRental(ctx, rentalUid)
+	return err
+}
+
+// ForceCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) ForceCancel(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForceCancel(ctx, rentalUid)
+	return err
+}
+
+// ForceFinish converts echo context to params.
+func (w *ServerInterfaceWrapper) ForceFinish(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ForceFinish(ctx, rentalUid)
+	return err
+}
+
+// GetAnyRentalHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAnyRentalHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAnyRentalHistory(ctx, rentalUid)
+	return err
+}
+
+// CreateQuote converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateQuote(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateQuote(ctx)
+	return err
+}
+
+// GetQuote converts echo context to params.
+func (w *ServerInterfaceWrapper) GetQuote(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "quoteUid" -------------
+	var quoteUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "quoteUid", ctx.Param("quoteUid"), &quoteUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter quoteUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetQuote(ctx, quoteUid)
+	return err
+}
+
 // GetUserRentals converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUserRentals(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserRentalsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateFrom", ctx.QueryParams(), &params.DateFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateFrom: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateTo", ctx.QueryParams(), &params.DateTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateTo: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUserRentals(ctx)
+	err = w.Handler.GetUserRentals(ctx, params)
 	return err
 }
 
@@ -127,6 +732,15 @@ func (w *ServerInterfaceWrapper) Create(ctx echo.Context) error {
 	return err
 }
 
+// FindOwners converts echo context to params.
+func (w *ServerInterfaceWrapper) FindOwners(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FindOwners(ctx)
+	return err
+}
+
 // Cancel converts echo context to params.
 func (w *ServerInterfaceWrapper) Cancel(ctx echo.Context) error {
 	var err error
@@ -138,8 +752,17 @@ func (w *ServerInterfaceWrapper) Cancel(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelParams
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", ctx.QueryParams(), &params.Reason)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reason: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Cancel(ctx, rentalUid)
+	err = w.Handler.Cancel(ctx, rentalUid, params)
 	return err
 }
 
@@ -159,6 +782,46 @@ func (w *ServerInterfaceWrapper) Get(ctx echo.Context) error {
 	return err
 }
 
+// ChangeDates converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangeDates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ChangeDates(ctx, rentalUid)
+	return err
+}
+
+// RevertChange converts echo context to params.
+func (w *ServerInterfaceWrapper) RevertChange(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// ------------- Path parameter "changeUid" -------------
+	var changeUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "changeUid", ctx.Param("changeUid"), &changeUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter changeUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevertChange(ctx, rentalUid, changeUid)
+	return err
+}
+
 // Finish converts echo context to params.
 func (w *ServerInterfaceWrapper) Finish(ctx echo.Context) error {
 	var err error
@@ -175,6 +838,38 @@ func (w *ServerInterfaceWrapper) Finish(ctx echo.Context) error {
 	return err
 }
 
+// GetHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHistory(ctx, rentalUid)
+	return err
+}
+
+// Start converts echo context to params.
+func (w *ServerInterfaceWrapper) Start(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rentalUid" -------------
+	var rentalUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rentalUid", ctx.Param("rentalUid"), &rentalUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rentalUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Start(ctx, rentalUid)
+	return err
+}
+
 // Live converts echo context to params.
 func (w *ServerInterfaceWrapper) Live(ctx echo.Context) error {
 	var err error
@@ -212,11 +907,23 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api/v1/admin/rentals", wrapper.SearchRentals)
+	router.GET(baseURL+"/api/v1/admin/rentals/:rentalUid", wrapper.GetAnyRental)
+	router.POST(baseURL+"/api/v1/admin/rentals/:rentalUid/cancel", wrapper.ForceCancel)
+	router.POST(baseURL+"/api/v1/admin/rentals/:rentalUid/finish", wrapper.ForceFinish)
+	router.GET(baseURL+"/api/v1/admin/rentals/:rentalUid/history", wrapper.GetAnyRentalHistory)
+	router.POST(baseURL+"/api/v1/quotes", wrapper.CreateQuote)
+	router.GET(baseURL+"/api/v1/quotes/:quoteUid", wrapper.GetQuote)
 	router.GET(baseURL+"/api/v1/rental", wrapper.GetUserRentals)
 	router.POST(baseURL+"/api/v1/rental", wrapper.Create)
+	router.POST(baseURL+"/api/v1/rental/owners", wrapper.FindOwners)
 	router.DELETE(baseURL+"/api/v1/rental/:rentalUid", wrapper.Cancel)
 	router.GET(baseURL+"/api/v1/rental/:rentalUid", wrapper.Get)
+	router.PATCH(baseURL+"/api/v1/rental/:rentalUid", wrapper.ChangeDates)
+	router.POST(baseURL+"/api/v1/rental/:rentalUid/changes/:changeUid/revert", wrapper.RevertChange)
 	router.POST(baseURL+"/api/v1/rental/:rentalUid/finish", wrapper.Finish)
+	router.GET(baseURL+"/api/v1/rental/:rentalUid/history", wrapper.GetHistory)
+	router.POST(baseURL+"/api/v1/rental/:rentalUid/start", wrapper.Start)
 	router.GET(baseURL+"/manage/health", wrapper.Live)
 
 }
@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+)
+
+// Search returns a page of rents of all users, it is used by support staff.
+func (r *Rental) Search(ctx context.Context, filter models.RentFilter) (*models.RentList, error) {
+	list, err := r.find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("find rentals: %w", err)
+	}
+
+	return list, nil
+}
+
+// GetAny returns the rent without checking its owner.
+func (r *Rental) GetAny(ctx context.Context, uid uuid.UUID) (*models.Rent, error) {
+	rent, err := r.repo.Get(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get rent: %w", err)
+	}
+
+	return rent, nil
+}
+
+// GetAnyHistory returns the status history of the rent without checking its owner.
+func (r *Rental) GetAnyHistory(ctx context.Context, uid uuid.UUID) ([]models.RentEvent, error) {
+	_, err := r.repo.Get(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get rent: %w", err)
+	}
+
+	events, err := r.repo.GetHistory(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get rent history: %w", err)
+	}
+
+	return events, nil
+}
+
+// ForceCancel cancels the rent on behalf of its owner. Statuses change by the same transition table,
+// the reason is required to explain the change in the rent history.
+func (r *Rental) ForceCancel(ctx context.Context, uid uuid.UUID, source models.ChangeSource) error {
+	err := validateReason(source)
+	if err != nil {
+		return fmt.Errorf("validate reason: %w", err)
+	}
+
+	rent, err := r.repo.Get(ctx, uid)
+	if err != nil {
+		return fmt.Errorf("get rent: %w", err)
+	}
+
+	err = r.transition(ctx, rent, models.Canceled, source)
+	if err != nil {
+		return fmt.Errorf("cancel rent: %w", err)
+	}
+
+	return nil
+}
+
+// ForceFinish records the car return on behalf of the rent owner and calculates extra charges by the tariff.
+func (r *Rental) ForceFinish(ctx context.Context, uid uuid.UUID, req models.FinishRentRequest, source models.ChangeSource) (*models.Rent, error) {
+	err := validateReason(source)
+	if err != nil {
+		return nil, fmt.Errorf("validate reason: %w", err)
+	}
+
+	err = validator.New().Struct(req.CarReadings)
+	if err != nil {
+		return nil, fmt.Errorf("validate car readings: %w (%w)", err, models.ErrInvalidRent)
+	}
+
+	rent, err := r.repo.Get(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get rent: %w", err)
+	}
+
+	rent, err = r.finish(ctx, rent, req, source)
+	if err != nil {
+		return nil, fmt.Errorf("finish rent: %w", err)
+	}
+
+	return rent, nil
+}
+
+func validateReason(source models.ChangeSource) error {
+	if source.Reason == "" {
+		return fmt.Errorf("%w (%w)", models.ValidationErrors{{
+			Field: "Reason",
+			Error: "reason is required",
+		}}, models.ErrInvalidRent)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/logic/mocks"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestRentalLogic_Search(t *testing.T) {
+	t.Run("rentals of all users", func(t *testing.T) {
+		ctx := context.Background()
+		carUUID := uuid.New()
+		want := &models.RentList{Items: []models.Rent{{UUID: uuid.New(), CarUUID: carUUID}}, Total: 1}
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Find(ctx, models.RentFilter{
+			CarUUID:  &carUUID,
+			Sort:     models.SortDateFromDesc,
+			PageSize: models.DefaultRentPageSize,
+		}).Return(want, nil)
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		got, err := p.Search(ctx, models.RentFilter{CarUUID: &carUUID})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("user rentals without user", func(t *testing.T) {
+		p := New(mocks.NewRentalRepo(t), models.RentLimits{}, models.Tariff{})
+		got, err := p.GetUserRentals(context.Background(), models.RentFilter{})
+		require.ErrorIs(t, err, models.ErrForbidden)
+		require.Nil(t, got)
+	})
+}
+
+func TestRentalLogic_ForceCancel(t *testing.T) {
+	t.Run("canceled rental of another user", func(t *testing.T) {
+		ctx := context.Background()
+		rent := &models.Rent{UUID: uuid.New(), Username: "user", Status: models.Reserved}
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().ChangeStatus(ctx, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, event models.RentEvent, _ ...outbox.DomainEvent) error {
+			assert.Equal(t, models.Canceled, event.ToStatus)
+			assert.Equal(t, "admin", event.Actor)
+			assert.Equal(t, "car is broken", event.Reason)
+
+			return nil
+		})
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		err := p.ForceCancel(ctx, rent.UUID, models.ChangeSource{Actor: "admin", Reason: "car is broken"})
+		require.NoError(t, err)
+	})
+
+	t.Run("reason is required", func(t *testing.T) {
+		p := New(mocks.NewRentalRepo(t), models.RentLimits{}, models.Tariff{})
+		err := p.ForceCancel(context.Background(), uuid.New(), models.ChangeSource{Actor: "admin"})
+		require.ErrorIs(t, err, models.ErrInvalidRent)
+	})
+
+	t.Run("rental in progress", func(t *testing.T) {
+		ctx := context.Background()
+		rent := &models.Rent{UUID: uuid.New(), Username: "user", Status: models.InProgress}
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		err := p.ForceCancel(ctx, rent.UUID, models.ChangeSource{Actor: "admin", Reason: "car is broken"})
+		require.ErrorIs(t, err, models.ErrTransition)
+	})
+}
+
+func TestRentalLogic_ForceFinish(t *testing.T) {
+	t.Run("finished rental of another user", func(t *testing.T) {
+		ctx := context.Background()
+		dateFrom := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+		rent := &models.Rent{UUID: uuid.New(), Username: "user", Status: models.Overdue, DateFrom: dateFrom, DateTo: dateFrom.AddDate(0, 0, 2)}
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().Finish(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, got models.Rent, event models.RentEvent, _ ...outbox.DomainEvent) error {
+			assert.Equal(t, models.Finished, got.Status)
+			assert.Equal(t, "car returned to the office", event.Reason)
+
+			return nil
+		})
+
+		p := New(repository, models.RentLimits{}, models.Tariff{LateFeePerDay: 5000})
+		got, err := p.ForceFinish(ctx, rent.UUID, models.FinishRentRequest{
+			ReturnedAt: rent.DateTo.Add(24 * time.Hour),
+		}, models.ChangeSource{Actor: "admin", Reason: "car returned to the office"})
+		require.NoError(t, err)
+		assert.Equal(t, 5000, got.Charges[0].Amount)
+	})
+
+	t.Run("reason is required", func(t *testing.T) {
+		p := New(mocks.NewRentalRepo(t), models.RentLimits{}, models.Tariff{})
+		got, err := p.ForceFinish(context.Background(), uuid.New(), models.FinishRentRequest{}, models.ChangeSource{Actor: "admin"})
+		require.ErrorIs(t, err, models.ErrInvalidRent)
+		require.Nil(t, got)
+	})
+}
@@ -9,6 +9,10 @@ import (
 
 	models "github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
 
+	outbox "github.com/polnaya-katuxa/ds-lab-02/outbox"
+
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -25,17 +29,87 @@ func (_m *RentalRepo) EXPECT() *RentalRepo_Expecter {
 	return &RentalRepo_Expecter{mock: &_m.Mock}
 }
 
-// ChangeStatus provides a mock function with given fields: ctx, uid, status
-func (_m *RentalRepo) ChangeStatus(ctx context.Context, uid uuid.UUID, status models.RentStatus) error {
-	ret := _m.Called(ctx, uid, status)
+// ChangeDateTo provides a mock function with given fields: ctx, rent, change, events
+func (_m *RentalRepo) ChangeDateTo(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, rent, change)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeDateTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Rent, models.RentChange, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, rent, change, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RentalRepo_ChangeDateTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeDateTo'
+type RentalRepo_ChangeDateTo_Call struct {
+	*mock.Call
+}
+
+// ChangeDateTo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rent models.Rent
+//   - change models.RentChange
+//   - events ...outbox.DomainEvent
+func (_e *RentalRepo_Expecter) ChangeDateTo(ctx interface{}, rent interface{}, change interface{}, events ...interface{}) *RentalRepo_ChangeDateTo_Call {
+	return &RentalRepo_ChangeDateTo_Call{Call: _e.mock.On("ChangeDateTo",
+		append([]interface{}{ctx, rent, change}, events...)...)}
+}
+
+func (_c *RentalRepo_ChangeDateTo_Call) Run(run func(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent)) *RentalRepo_ChangeDateTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Rent), args[2].(models.RentChange), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RentalRepo_ChangeDateTo_Call) Return(_a0 error) *RentalRepo_ChangeDateTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RentalRepo_ChangeDateTo_Call) RunAndReturn(run func(context.Context, models.Rent, models.RentChange, ...outbox.DomainEvent) error) *RentalRepo_ChangeDateTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeStatus provides a mock function with given fields: ctx, event, events
+func (_m *RentalRepo) ChangeStatus(ctx context.Context, event models.RentEvent, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, event)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ChangeStatus")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.RentStatus) error); ok {
-		r0 = rf(ctx, uid, status)
+	if rf, ok := ret.Get(0).(func(context.Context, models.RentEvent, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, event, events...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -50,15 +124,22 @@ type RentalRepo_ChangeStatus_Call struct {
 
 // ChangeStatus is a helper method to define mock.On call
 //   - ctx context.Context
-//   - uid uuid.UUID
-//   - status models.RentStatus
-func (_e *RentalRepo_Expecter) ChangeStatus(ctx interface{}, uid interface{}, status interface{}) *RentalRepo_ChangeStatus_Call {
-	return &RentalRepo_ChangeStatus_Call{Call: _e.mock.On("ChangeStatus", ctx, uid, status)}
+//   - event models.RentEvent
+//   - events ...outbox.DomainEvent
+func (_e *RentalRepo_Expecter) ChangeStatus(ctx interface{}, event interface{}, events ...interface{}) *RentalRepo_ChangeStatus_Call {
+	return &RentalRepo_ChangeStatus_Call{Call: _e.mock.On("ChangeStatus",
+		append([]interface{}{ctx, event}, events...)...)}
 }
 
-func (_c *RentalRepo_ChangeStatus_Call) Run(run func(ctx context.Context, uid uuid.UUID, status models.RentStatus)) *RentalRepo_ChangeStatus_Call {
+func (_c *RentalRepo_ChangeStatus_Call) Run(run func(ctx context.Context, event models.RentEvent, events ...outbox.DomainEvent)) *RentalRepo_ChangeStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(models.RentStatus))
+		variadicArgs := make([]outbox.DomainEvent, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.RentEvent), variadicArgs...)
 	})
 	return _c
 }
@@ -68,14 +149,21 @@ func (_c *RentalRepo_ChangeStatus_Call) Return(_a0 error) *RentalRepo_ChangeStat
 	return _c
 }
 
-func (_c *RentalRepo_ChangeStatus_Call) RunAndReturn(run func(context.Context, uuid.UUID, models.RentStatus) error) *RentalRepo_ChangeStatus_Call {
+func (_c *RentalRepo_ChangeStatus_Call) RunAndReturn(run func(context.Context, models.RentEvent, ...outbox.DomainEvent) error) *RentalRepo_ChangeStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Create provides a mock function with given fields: ctx, rent
-func (_m *RentalRepo) Create(ctx context.Context, rent models.Rent) (*models.Rent, error) {
-	ret := _m.Called(ctx, rent)
+// Create provides a mock function with given fields: ctx, rent, event, events
+func (_m *RentalRepo) Create(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent) (*models.Rent, error) {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, rent, event)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -83,19 +171,19 @@ func (_m *RentalRepo) Create(ctx context.Context, rent models.Rent) (*models.Ren
 
 	var r0 *models.Rent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Rent) (*models.Rent, error)); ok {
-		return rf(ctx, rent)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Rent, models.RentEvent, ...outbox.DomainEvent) (*models.Rent, error)); ok {
+		return rf(ctx, rent, event, events...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.Rent) *models.Rent); ok {
-		r0 = rf(ctx, rent)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Rent, models.RentEvent, ...outbox.DomainEvent) *models.Rent); ok {
+		r0 = rf(ctx, rent, event, events...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.Rent)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.Rent) error); ok {
-		r1 = rf(ctx, rent)
+	if rf, ok := ret.Get(1).(func(context.Context, models.Rent, models.RentEvent, ...outbox.DomainEvent) error); ok {
+		r1 = rf(ctx, rent, event, events...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,13 +199,22 @@ type RentalRepo_Create_Call struct {
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
 //   - rent models.Rent
-func (_e *RentalRepo_Expecter) Create(ctx interface{}, rent interface{}) *RentalRepo_Create_Call {
-	return &RentalRepo_Create_Call{Call: _e.mock.On("Create", ctx, rent)}
+//   - event models.RentEvent
+//   - events ...outbox.DomainEvent
+func (_e *RentalRepo_Expecter) Create(ctx interface{}, rent interface{}, event interface{}, events ...interface{}) *RentalRepo_Create_Call {
+	return &RentalRepo_Create_Call{Call: _e.mock.On("Create",
+		append([]interface{}{ctx, rent, event}, events...)...)}
 }
 
-func (_c *RentalRepo_Create_Call) Run(run func(ctx context.Context, rent models.Rent)) *RentalRepo_Create_Call {
+func (_c *RentalRepo_Create_Call) Run(run func(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent)) *RentalRepo_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Rent))
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Rent), args[2].(models.RentEvent), variadicArgs...)
 	})
 	return _c
 }
@@ -127,7 +224,129 @@ func (_c *RentalRepo_Create_Call) Return(_a0 *models.Rent, _a1 error) *RentalRep
 	return _c
 }
 
-func (_c *RentalRepo_Create_Call) RunAndReturn(run func(context.Context, models.Rent) (*models.Rent, error)) *RentalRepo_Create_Call {
+func (_c *RentalRepo_Create_Call) RunAndReturn(run func(context.Context, models.Rent, models.RentEvent, ...outbox.DomainEvent) (*models.Rent, error)) *RentalRepo_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Find provides a mock function with given fields: ctx, filter
+func (_m *RentalRepo) Find(ctx context.Context, filter models.RentFilter) (*models.RentList, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 *models.RentList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.RentFilter) (*models.RentList, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.RentFilter) *models.RentList); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RentList)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.RentFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_Find_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Find'
+type RentalRepo_Find_Call struct {
+	*mock.Call
+}
+
+// Find is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter models.RentFilter
+func (_e *RentalRepo_Expecter) Find(ctx interface{}, filter interface{}) *RentalRepo_Find_Call {
+	return &RentalRepo_Find_Call{Call: _e.mock.On("Find", ctx, filter)}
+}
+
+func (_c *RentalRepo_Find_Call) Run(run func(ctx context.Context, filter models.RentFilter)) *RentalRepo_Find_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.RentFilter))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_Find_Call) Return(_a0 *models.RentList, _a1 error) *RentalRepo_Find_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_Find_Call) RunAndReturn(run func(context.Context, models.RentFilter) (*models.RentList, error)) *RentalRepo_Find_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finish provides a mock function with given fields: ctx, rent, event, events
+func (_m *RentalRepo) Finish(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, rent, event)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finish")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Rent, models.RentEvent, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, rent, event, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RentalRepo_Finish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finish'
+type RentalRepo_Finish_Call struct {
+	*mock.Call
+}
+
+// Finish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rent models.Rent
+//   - event models.RentEvent
+//   - events ...outbox.DomainEvent
+func (_e *RentalRepo_Expecter) Finish(ctx interface{}, rent interface{}, event interface{}, events ...interface{}) *RentalRepo_Finish_Call {
+	return &RentalRepo_Finish_Call{Call: _e.mock.On("Finish",
+		append([]interface{}{ctx, rent, event}, events...)...)}
+}
+
+func (_c *RentalRepo_Finish_Call) Run(run func(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent)) *RentalRepo_Finish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Rent), args[2].(models.RentEvent), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RentalRepo_Finish_Call) Return(_a0 error) *RentalRepo_Finish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RentalRepo_Finish_Call) RunAndReturn(run func(context.Context, models.Rent, models.RentEvent, ...outbox.DomainEvent) error) *RentalRepo_Finish_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -191,29 +410,567 @@ func (_c *RentalRepo_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID)
 	return _c
 }
 
-// GetUserRentals provides a mock function with given fields: ctx, username
-func (_m *RentalRepo) GetUserRentals(ctx context.Context, username string) ([]models.Rent, error) {
-	ret := _m.Called(ctx, username)
+// GetByPayments provides a mock function with given fields: ctx, paymentUUIDs, rentalUUIDs
+func (_m *RentalRepo) GetByPayments(ctx context.Context, paymentUUIDs []uuid.UUID, rentalUUIDs []uuid.UUID) ([]models.Rent, error) {
+	ret := _m.Called(ctx, paymentUUIDs, rentalUUIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUserRentals")
+		panic("no return value specified for GetByPayments")
 	}
 
 	var r0 []models.Rent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Rent, error)); ok {
-		return rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, []uuid.UUID) ([]models.Rent, error)); ok {
+		return rf(ctx, paymentUUIDs, rentalUUIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Rent); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID, []uuid.UUID) []models.Rent); ok {
+		r0 = rf(ctx, paymentUUIDs, rentalUUIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Rent)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, username)
+	if rf, ok := ret.Get(1).(func(context.Context, []uuid.UUID, []uuid.UUID) error); ok {
+		r1 = rf(ctx, paymentUUIDs, rentalUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_GetByPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByPayments'
+type RentalRepo_GetByPayments_Call struct {
+	*mock.Call
+}
+
+// GetByPayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentUUIDs []uuid.UUID
+//   - rentalUUIDs []uuid.UUID
+func (_e *RentalRepo_Expecter) GetByPayments(ctx interface{}, paymentUUIDs interface{}, rentalUUIDs interface{}) *RentalRepo_GetByPayments_Call {
+	return &RentalRepo_GetByPayments_Call{Call: _e.mock.On("GetByPayments", ctx, paymentUUIDs, rentalUUIDs)}
+}
+
+func (_c *RentalRepo_GetByPayments_Call) Run(run func(ctx context.Context, paymentUUIDs []uuid.UUID, rentalUUIDs []uuid.UUID)) *RentalRepo_GetByPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uuid.UUID), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_GetByPayments_Call) Return(_a0 []models.Rent, _a1 error) *RentalRepo_GetByPayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_GetByPayments_Call) RunAndReturn(run func(context.Context, []uuid.UUID, []uuid.UUID) ([]models.Rent, error)) *RentalRepo_GetByPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChange provides a mock function with given fields: ctx, uid
+func (_m *RentalRepo) GetChange(ctx context.Context, uid uuid.UUID) (*models.RentChange, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChange")
+	}
+
+	var r0 *models.RentChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.RentChange, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.RentChange); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.RentChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_GetChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChange'
+type RentalRepo_GetChange_Call struct {
+	*mock.Call
+}
+
+// GetChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid uuid.UUID
+func (_e *RentalRepo_Expecter) GetChange(ctx interface{}, uid interface{}) *RentalRepo_GetChange_Call {
+	return &RentalRepo_GetChange_Call{Call: _e.mock.On("GetChange", ctx, uid)}
+}
+
+func (_c *RentalRepo_GetChange_Call) Run(run func(ctx context.Context, uid uuid.UUID)) *RentalRepo_GetChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_GetChange_Call) Return(_a0 *models.RentChange, _a1 error) *RentalRepo_GetChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_GetChange_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.RentChange, error)) *RentalRepo_GetChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHistory provides a mock function with given fields: ctx, uid
+func (_m *RentalRepo) GetHistory(ctx context.Context, uid uuid.UUID) ([]models.RentEvent, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHistory")
+	}
+
+	var r0 []models.RentEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.RentEvent, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.RentEvent); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.RentEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_GetHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHistory'
+type RentalRepo_GetHistory_Call struct {
+	*mock.Call
+}
+
+// GetHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid uuid.UUID
+func (_e *RentalRepo_Expecter) GetHistory(ctx interface{}, uid interface{}) *RentalRepo_GetHistory_Call {
+	return &RentalRepo_GetHistory_Call{Call: _e.mock.On("GetHistory", ctx, uid)}
+}
+
+func (_c *RentalRepo_GetHistory_Call) Run(run func(ctx context.Context, uid uuid.UUID)) *RentalRepo_GetHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_GetHistory_Call) Return(_a0 []models.RentEvent, _a1 error) *RentalRepo_GetHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_GetHistory_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]models.RentEvent, error)) *RentalRepo_GetHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNoShows provides a mock function with given fields: ctx, before
+func (_m *RentalRepo) GetNoShows(ctx context.Context, before time.Time) ([]models.Rent, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNoShows")
+	}
+
+	var r0 []models.Rent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]models.Rent, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []models.Rent); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_GetNoShows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNoShows'
+type RentalRepo_GetNoShows_Call struct {
+	*mock.Call
+}
+
+// GetNoShows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *RentalRepo_Expecter) GetNoShows(ctx interface{}, before interface{}) *RentalRepo_GetNoShows_Call {
+	return &RentalRepo_GetNoShows_Call{Call: _e.mock.On("GetNoShows", ctx, before)}
+}
+
+func (_c *RentalRepo_GetNoShows_Call) Run(run func(ctx context.Context, before time.Time)) *RentalRepo_GetNoShows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_GetNoShows_Call) Return(_a0 []models.Rent, _a1 error) *RentalRepo_GetNoShows_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_GetNoShows_Call) RunAndReturn(run func(context.Context, time.Time) ([]models.Rent, error)) *RentalRepo_GetNoShows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOverdue provides a mock function with given fields: ctx, now
+func (_m *RentalRepo) GetOverdue(ctx context.Context, now time.Time) ([]models.Rent, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOverdue")
+	}
+
+	var r0 []models.Rent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]models.Rent, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []models.Rent); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Rent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_GetOverdue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOverdue'
+type RentalRepo_GetOverdue_Call struct {
+	*mock.Call
+}
+
+// GetOverdue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *RentalRepo_Expecter) GetOverdue(ctx interface{}, now interface{}) *RentalRepo_GetOverdue_Call {
+	return &RentalRepo_GetOverdue_Call{Call: _e.mock.On("GetOverdue", ctx, now)}
+}
+
+func (_c *RentalRepo_GetOverdue_Call) Run(run func(ctx context.Context, now time.Time)) *RentalRepo_GetOverdue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_GetOverdue_Call) Return(_a0 []models.Rent, _a1 error) *RentalRepo_GetOverdue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_GetOverdue_Call) RunAndReturn(run func(context.Context, time.Time) ([]models.Rent, error)) *RentalRepo_GetOverdue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetQuote provides a mock function with given fields: ctx, uid
+func (_m *RentalRepo) GetQuote(ctx context.Context, uid uuid.UUID) (*models.Quote, error) {
+	ret := _m.Called(ctx, uid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQuote")
+	}
+
+	var r0 *models.Quote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*models.Quote, error)); ok {
+		return rf(ctx, uid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *models.Quote); ok {
+		r0 = rf(ctx, uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Quote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_GetQuote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetQuote'
+type RentalRepo_GetQuote_Call struct {
+	*mock.Call
+}
+
+// GetQuote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid uuid.UUID
+func (_e *RentalRepo_Expecter) GetQuote(ctx interface{}, uid interface{}) *RentalRepo_GetQuote_Call {
+	return &RentalRepo_GetQuote_Call{Call: _e.mock.On("GetQuote", ctx, uid)}
+}
+
+func (_c *RentalRepo_GetQuote_Call) Run(run func(ctx context.Context, uid uuid.UUID)) *RentalRepo_GetQuote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_GetQuote_Call) Return(_a0 *models.Quote, _a1 error) *RentalRepo_GetQuote_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_GetQuote_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*models.Quote, error)) *RentalRepo_GetQuote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasOverlapping provides a mock function with given fields: ctx, carUID, from, to
+func (_m *RentalRepo) HasOverlapping(ctx context.Context, carUID uuid.UUID, from time.Time, to time.Time) (bool, error) {
+	ret := _m.Called(ctx, carUID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasOverlapping")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (bool, error)); ok {
+		return rf(ctx, carUID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) bool); ok {
+		r0 = rf(ctx, carUID, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, carUID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RentalRepo_HasOverlapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasOverlapping'
+type RentalRepo_HasOverlapping_Call struct {
+	*mock.Call
+}
+
+// HasOverlapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - carUID uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *RentalRepo_Expecter) HasOverlapping(ctx interface{}, carUID interface{}, from interface{}, to interface{}) *RentalRepo_HasOverlapping_Call {
+	return &RentalRepo_HasOverlapping_Call{Call: _e.mock.On("HasOverlapping", ctx, carUID, from, to)}
+}
+
+func (_c *RentalRepo_HasOverlapping_Call) Run(run func(ctx context.Context, carUID uuid.UUID, from time.Time, to time.Time)) *RentalRepo_HasOverlapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *RentalRepo_HasOverlapping_Call) Return(_a0 bool, _a1 error) *RentalRepo_HasOverlapping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RentalRepo_HasOverlapping_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) (bool, error)) *RentalRepo_HasOverlapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertChange provides a mock function with given fields: ctx, rent, change, events
+func (_m *RentalRepo) RevertChange(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, rent, change)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Rent, models.RentChange, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, rent, change, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RentalRepo_RevertChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertChange'
+type RentalRepo_RevertChange_Call struct {
+	*mock.Call
+}
+
+// RevertChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rent models.Rent
+//   - change models.RentChange
+//   - events ...outbox.DomainEvent
+func (_e *RentalRepo_Expecter) RevertChange(ctx interface{}, rent interface{}, change interface{}, events ...interface{}) *RentalRepo_RevertChange_Call {
+	return &RentalRepo_RevertChange_Call{Call: _e.mock.On("RevertChange",
+		append([]interface{}{ctx, rent, change}, events...)...)}
+}
+
+func (_c *RentalRepo_RevertChange_Call) Run(run func(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent)) *RentalRepo_RevertChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.Rent), args[2].(models.RentChange), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RentalRepo_RevertChange_Call) Return(_a0 error) *RentalRepo_RevertChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RentalRepo_RevertChange_Call) RunAndReturn(run func(context.Context, models.Rent, models.RentChange, ...outbox.DomainEvent) error) *RentalRepo_RevertChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, event, readings, events
+func (_m *RentalRepo) Start(ctx context.Context, event models.RentEvent, readings models.CarReadings, events ...outbox.DomainEvent) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, event, readings)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.RentEvent, models.CarReadings, ...outbox.DomainEvent) error); ok {
+		r0 = rf(ctx, event, readings, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RentalRepo_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type RentalRepo_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event models.RentEvent
+//   - readings models.CarReadings
+//   - events ...outbox.DomainEvent
+func (_e *RentalRepo_Expecter) Start(ctx interface{}, event interface{}, readings interface{}, events ...interface{}) *RentalRepo_Start_Call {
+	return &RentalRepo_Start_Call{Call: _e.mock.On("Start",
+		append([]interface{}{ctx, event, readings}, events...)...)}
+}
+
+func (_c *RentalRepo_Start_Call) Run(run func(ctx context.Context, event models.RentEvent, readings models.CarReadings, events ...outbox.DomainEvent)) *RentalRepo_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]outbox.DomainEvent, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(outbox.DomainEvent)
+			}
+		}
+		run(args[0].(context.Context), args[1].(models.RentEvent), args[2].(models.CarReadings), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RentalRepo_Start_Call) Return(_a0 error) *RentalRepo_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RentalRepo_Start_Call) RunAndReturn(run func(context.Context, models.RentEvent, models.CarReadings, ...outbox.DomainEvent) error) *RentalRepo_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithAdvisoryLock provides a mock function with given fields: ctx, key, fn
+func (_m *RentalRepo) WithAdvisoryLock(ctx context.Context, key int64, fn func(context.Context) error) (bool, error) {
+	ret := _m.Called(ctx, key, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithAdvisoryLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, func(context.Context) error) (bool, error)); ok {
+		return rf(ctx, key, fn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, func(context.Context) error) bool); ok {
+		r0 = rf(ctx, key, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, func(context.Context) error) error); ok {
+		r1 = rf(ctx, key, fn)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -221,31 +978,32 @@ func (_m *RentalRepo) GetUserRentals(ctx context.Context, username string) ([]mo
 	return r0, r1
 }
 
-// RentalRepo_GetUserRentals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRentals'
-type RentalRepo_GetUserRentals_Call struct {
+// RentalRepo_WithAdvisoryLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithAdvisoryLock'
+type RentalRepo_WithAdvisoryLock_Call struct {
 	*mock.Call
 }
 
-// GetUserRentals is a helper method to define mock.On call
+// WithAdvisoryLock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - username string
-func (_e *RentalRepo_Expecter) GetUserRentals(ctx interface{}, username interface{}) *RentalRepo_GetUserRentals_Call {
-	return &RentalRepo_GetUserRentals_Call{Call: _e.mock.On("GetUserRentals", ctx, username)}
+//   - key int64
+//   - fn func(context.Context) error
+func (_e *RentalRepo_Expecter) WithAdvisoryLock(ctx interface{}, key interface{}, fn interface{}) *RentalRepo_WithAdvisoryLock_Call {
+	return &RentalRepo_WithAdvisoryLock_Call{Call: _e.mock.On("WithAdvisoryLock", ctx, key, fn)}
 }
 
-func (_c *RentalRepo_GetUserRentals_Call) Run(run func(ctx context.Context, username string)) *RentalRepo_GetUserRentals_Call {
+func (_c *RentalRepo_WithAdvisoryLock_Call) Run(run func(ctx context.Context, key int64, fn func(context.Context) error)) *RentalRepo_WithAdvisoryLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int64), args[2].(func(context.Context) error))
 	})
 	return _c
 }
 
-func (_c *RentalRepo_GetUserRentals_Call) Return(_a0 []models.Rent, _a1 error) *RentalRepo_GetUserRentals_Call {
+func (_c *RentalRepo_WithAdvisoryLock_Call) Return(_a0 bool, _a1 error) *RentalRepo_WithAdvisoryLock_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RentalRepo_GetUserRentals_Call) RunAndReturn(run func(context.Context, string) ([]models.Rent, error)) *RentalRepo_GetUserRentals_Call {
+func (_c *RentalRepo_WithAdvisoryLock_Call) RunAndReturn(run func(context.Context, int64, func(context.Context) error) (bool, error)) *RentalRepo_WithAdvisoryLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,156 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+)
+
+// Pricing calculates the rent price with a breakdown by line items and saves it as a quote.
+type Pricing struct {
+	repo  pricingRepo
+	rules models.PricingRules
+}
+
+func NewPricing(repo pricingRepo, rules models.PricingRules) *Pricing {
+	discounts := slices.Clone(rules.DurationDiscounts)
+	slices.SortFunc(discounts, func(a, b models.DurationDiscount) int {
+		return b.MinDays - a.MinDays
+	})
+	rules.DurationDiscounts = discounts
+
+	return &Pricing{
+		repo:  repo,
+		rules: rules,
+	}
+}
+
+func (p *Pricing) Quote(ctx context.Context, req models.QuoteRequest) (*models.Quote, error) {
+	err := validator.New().Struct(req)
+	if err != nil {
+		return nil, fmt.Errorf("validate quote request: %w (%w)", err, models.ErrInvalidRent)
+	}
+
+	days := int(req.DateTo.Sub(req.DateFrom) / day)
+	if days < 1 {
+		return nil, fmt.Errorf("check dates: %w (%w)", models.ValidationErrors{{
+			Field: "DateTo",
+			Error: "date to must be at least one day after date from",
+		}}, models.ErrInvalidRent)
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = p.rules.Currency
+	}
+
+	rate := req.DailyPrice
+	if typeRate, ok := p.rules.TypeRates[req.CarType]; ok && currency == p.rules.Currency {
+		rate = typeRate
+	}
+
+	items := []models.PriceItem{{
+		Kind:        models.PriceBase,
+		Description: fmt.Sprintf("%s daily rate %d", req.CarType, rate),
+		Quantity:    days,
+		Amount:      rate * days,
+	}}
+
+	for _, season := range p.rules.Seasons {
+		items = appendSurcharge(items, models.PriceSeason, fmt.Sprintf("%s season", season.Name),
+			countDays(req.DateFrom, days, season.Contains), rate, season.Multiplier)
+	}
+
+	items = appendSurcharge(items, models.PriceWeekend, "weekend surcharge",
+		countDays(req.DateFrom, days, isWeekend), rate, p.rules.WeekendMultiplier)
+
+	subtotal := sumItems(items)
+	for _, discount := range p.rules.DurationDiscounts {
+		if days < discount.MinDays {
+			continue
+		}
+
+		items = append(items, models.PriceItem{
+			Kind:        models.PriceDurationDiscount,
+			Description: fmt.Sprintf("%d%% discount for %d+ days", discount.Percent, discount.MinDays),
+			Quantity:    days,
+			Amount:      -int(math.Round(float64(subtotal) * float64(discount.Percent) / 100)),
+		})
+
+		break
+	}
+
+	quote, err := p.repo.CreateQuote(ctx, models.Quote{
+		UUID:       uuid.New(),
+		CarUUID:    req.CarUUID,
+		DateFrom:   req.DateFrom,
+		DateTo:     req.DateTo,
+		Days:       days,
+		Currency:   currency,
+		Items:      items,
+		TotalPrice: sumItems(items),
+		ExpiresAt:  time.Now().UTC().Add(p.rules.QuoteTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+func (p *Pricing) GetQuote(ctx context.Context, uid uuid.UUID) (*models.Quote, error) {
+	quote, err := p.repo.GetQuote(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+func appendSurcharge(items []models.PriceItem, kind models.PriceItemKind, description string, days, rate int, multiplier float64) []models.PriceItem {
+	if days == 0 || multiplier == 0 || multiplier == 1 {
+		return items
+	}
+
+	return append(items, models.PriceItem{
+		Kind:        kind,
+		Description: fmt.Sprintf("%s x%.2f", description, multiplier),
+		Quantity:    days,
+		Amount:      int(math.Round(float64(rate*days) * (multiplier - 1))),
+	})
+}
+
+func countDays(from time.Time, days int, match func(time.Time) bool) int {
+	count := 0
+	for i := range days {
+		if match(from.AddDate(0, 0, i)) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func isWeekend(day time.Time) bool {
+	return day.Weekday() == time.Saturday || day.Weekday() == time.Sunday
+}
+
+func sumItems(items []models.PriceItem) int {
+	total := 0
+	for _, item := range items {
+		total += item.Amount
+	}
+
+	return total
+}
+
+type pricingRepo interface {
+	CreateQuote(ctx context.Context, quote models.Quote) (*models.Quote, error)
+	GetQuote(ctx context.Context, uid uuid.UUID) (*models.Quote, error)
+}
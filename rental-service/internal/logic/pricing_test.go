@@ -0,0 +1,105 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/logic/mocks"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestPricing_Quote(t *testing.T) {
+	ctx := context.Background()
+	rules := models.PricingRules{
+		Currency:          "RUB",
+		TypeRates:         map[string]int{"SUV": 5000},
+		WeekendMultiplier: 1.5,
+		Seasons: []models.Season{
+			{Name: "winter", From: "12-20", To: "01-10", Multiplier: 2},
+		},
+		DurationDiscounts: []models.DurationDiscount{
+			{MinDays: 7, Percent: 10},
+			{MinDays: 30, Percent: 20},
+		},
+	}
+
+	newPricing := func(t *testing.T, rules models.PricingRules) *Pricing {
+		repository := mocks.NewPricingRepo(t)
+		repository.EXPECT().CreateQuote(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, quote models.Quote) (*models.Quote, error) {
+			return &quote, nil
+		}).Maybe()
+
+		return NewPricing(repository, rules)
+	}
+
+	newRequest := func(carType string, from string, days int) models.QuoteRequest {
+		dateFrom, _ := time.Parse(time.DateOnly, from)
+
+		return models.QuoteRequest{
+			CarUUID:    uuid.New(),
+			CarType:    carType,
+			DailyPrice: 1000,
+			DateFrom:   dateFrom,
+			DateTo:     dateFrom.AddDate(0, 0, days),
+		}
+	}
+
+	t.Run("weekdays with car price", func(t *testing.T) {
+		// 2024-11-04 is monday.
+		got, err := newPricing(t, rules).Quote(ctx, newRequest("SEDAN", "2024-11-04", 3))
+		require.NoError(t, err)
+		assert.Equal(t, 3, got.Days)
+		assert.Equal(t, "RUB", got.Currency)
+		assert.Equal(t, 1, len(got.Items))
+		assert.Equal(t, 3000, got.TotalPrice)
+		assert.Equal(t, false, got.UUID == uuid.Nil)
+	})
+
+	t.Run("type rate and weekend", func(t *testing.T) {
+		// friday, saturday and sunday.
+		got, err := newPricing(t, rules).Quote(ctx, newRequest("SUV", "2024-11-08", 3))
+		require.NoError(t, err)
+		assert.Equal(t, models.PriceWeekend, got.Items[1].Kind)
+		assert.Equal(t, 2, got.Items[1].Quantity)
+		assert.Equal(t, 15000+5000, got.TotalPrice)
+	})
+
+	t.Run("car priced in another currency", func(t *testing.T) {
+		req := newRequest("SUV", "2024-11-04", 3)
+		req.Currency = "EUR"
+
+		got, err := newPricing(t, rules).Quote(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", got.Currency)
+		assert.Equal(t, 3000, got.TotalPrice)
+	})
+
+	t.Run("season crossing new year with weekly discount", func(t *testing.T) {
+		// 2024-12-30 monday - 2025-01-05 sunday: all days in season, two weekend days.
+		got, err := newPricing(t, rules).Quote(ctx, newRequest("SEDAN", "2024-12-30", 7))
+		require.NoError(t, err)
+
+		subtotal := 7000 + 7000 + 1000
+		assert.Equal(t, models.PriceDurationDiscount, got.Items[3].Kind)
+		assert.Equal(t, -subtotal/10, got.Items[3].Amount)
+		assert.Equal(t, subtotal-subtotal/10, got.TotalPrice)
+	})
+
+	t.Run("largest duration discount wins", func(t *testing.T) {
+		got, err := newPricing(t, models.PricingRules{DurationDiscounts: rules.DurationDiscounts}).
+			Quote(ctx, newRequest("SEDAN", "2024-11-04", 30))
+		require.NoError(t, err)
+		assert.Equal(t, 30000-6000, got.TotalPrice)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		got, err := newPricing(t, rules).Quote(ctx, newRequest("SEDAN", "2024-11-04", 0))
+		require.ErrorIs(t, err, models.ErrInvalidRent)
+		require.Nil(t, got)
+	})
+}
@@ -2,37 +2,112 @@ package logic
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"time"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
 )
 
+const day = 24 * time.Hour
+
 type Rental struct {
-	repo rentalRepo
+	repo   rentalRepo
+	limits models.RentLimits
+	tariff models.Tariff
 }
 
-func New(repo rentalRepo) *Rental {
+func New(repo rentalRepo, limits models.RentLimits, tariff models.Tariff) *Rental {
 	return &Rental{
-		repo: repo,
+		repo:   repo,
+		limits: limits,
+		tariff: tariff,
 	}
 }
 
-func (r *Rental) GetUserRentals(ctx context.Context, username string) ([]models.Rent, error) {
-	rents, err := r.repo.GetUserRentals(ctx, username)
+func (r *Rental) GetUserRentals(ctx context.Context, filter models.RentFilter) (*models.RentList, error) {
+	if filter.Username == "" {
+		return nil, fmt.Errorf("check user: %w", models.ErrForbidden)
+	}
+
+	list, err := r.find(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("get user rentals: %w", err)
+		return nil, fmt.Errorf("find user rentals: %w", err)
+	}
+
+	return list, nil
+}
+
+func (r *Rental) find(ctx context.Context, filter models.RentFilter) (*models.RentList, error) {
+	err := filter.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate filter: %w", err)
+	}
+
+	if filter.DateFrom != nil && filter.DateTo != nil && filter.DateTo.Before(*filter.DateFrom) {
+		return nil, fmt.Errorf("check dates: %w (%w)", models.ValidationErrors{{
+			Field: "DateTo",
+			Error: "date to must not be before date from",
+		}}, models.ErrInvalidRent)
+	}
+
+	if filter.PageSize == 0 {
+		filter.PageSize = models.DefaultRentPageSize
+	}
+
+	if filter.Sort == "" {
+		filter.Sort = models.SortDateFromDesc
+	}
+
+	list, err := r.repo.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("find rentals: %w", err)
+	}
+
+	return list, nil
+}
+
+// maxOwnersLookup limits the number of UUIDs in one owners lookup.
+const maxOwnersLookup = 1000
+
+// GetOwners returns rents paid by the payments or having the UUIDs, their usernames are owners of the payments.
+func (r *Rental) GetOwners(ctx context.Context, paymentUUIDs, rentalUUIDs []uuid.UUID) ([]models.Rent, error) {
+	if len(paymentUUIDs)+len(rentalUUIDs) > maxOwnersLookup {
+		return nil, fmt.Errorf("more than %d uuids: %w", maxOwnersLookup, models.ErrInvalidRent)
+	}
+
+	if len(paymentUUIDs) == 0 && len(rentalUUIDs) == 0 {
+		return nil, nil
+	}
+
+	rents, err := r.repo.GetByPayments(ctx, paymentUUIDs, rentalUUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get rentals by payments: %w", err)
 	}
 
 	return rents, nil
 }
 
-func (r *Rental) Create(ctx context.Context, req models.CreateRentRequest) (*models.Rent, error) {
+func (r *Rental) Create(ctx context.Context, req models.CreateRentRequest, source models.ChangeSource) (*models.Rent, error) {
 	err := req.Validate()
 	if err != nil {
 		return nil, fmt.Errorf("validate request: %w", err)
 	}
 
+	err = r.validatePeriod(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("validate rent period: %w", err)
+	}
+
+	quote, err := r.getQuote(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("get rent quote: %w", err)
+	}
+
 	rentToCreate := models.Rent{
 		UUID:        uuid.New(),
 		Username:    req.Username,
@@ -40,36 +115,301 @@ func (r *Rental) Create(ctx context.Context, req models.CreateRentRequest) (*mod
 		CarUUID:     req.CarUUID,
 		DateFrom:    req.DateFrom,
 		DateTo:      req.DateTo,
-		Status:      models.InProgress,
+		Status:      models.Reserved,
+		QuoteUUID:   &quote.UUID,
+		Price:       quote.TotalPrice,
+		Currency:    quote.Currency,
+		PriceItems:  quote.Items,
 	}
 
-	rent, err := r.repo.Create(ctx, rentToCreate)
+	rent, err := r.repo.Create(ctx, rentToCreate, newRentEvent(rentToCreate.UUID, nil, rentToCreate.Status, source),
+		models.NewRentalEvent(models.RentalCreatedEvent, rentToCreate, source, traceContext(ctx)))
 	if err != nil {
+		if errors.Is(err, models.ErrRentOverlaps) {
+			return nil, fmt.Errorf("create rent: %w (%w)", overlapError(), models.ErrInvalidRent)
+		}
+
 		return nil, fmt.Errorf("create rent: %w", err)
 	}
 
 	return rent, nil
 }
 
-func (r *Rental) Cancel(ctx context.Context, uid uuid.UUID, username string) error {
-	rent, err := r.repo.Get(ctx, uid)
+func (r *Rental) Cancel(ctx context.Context, uid uuid.UUID, username string, source models.ChangeSource) error {
+	err := r.changeUserRentStatus(ctx, uid, username, models.Canceled, source)
+	if err != nil {
+		return fmt.Errorf("cancel rent: %w", err)
+	}
+
+	return nil
+}
+
+// Start hands the car over to the client and records its readings at pickup.
+func (r *Rental) Start(ctx context.Context, uid uuid.UUID, username string, readings models.CarReadings, source models.ChangeSource) error {
+	err := validator.New().Struct(readings)
+	if err != nil {
+		return fmt.Errorf("validate car readings: %w (%w)", err, models.ErrInvalidRent)
+	}
+
+	rent, err := r.Get(ctx, uid, username)
 	if err != nil {
 		return fmt.Errorf("get rent: %w", err)
 	}
 
-	if rent.Username != username {
-		return fmt.Errorf("check user: %w", models.ErrForbidden)
+	if !rent.Status.CanTransitionTo(models.InProgress) {
+		return fmt.Errorf("start rent: %s -> %s: %w", rent.Status, models.InProgress, models.ErrTransition)
 	}
 
-	err = r.repo.ChangeStatus(ctx, uid, models.Canceled)
+	from := rent.Status
+	started := *rent
+	started.Status = models.InProgress
+	started.StartOdometer = readings.Odometer
+	started.StartFuelLevel = readings.FuelLevel
+
+	err = r.repo.Start(ctx, newRentEvent(rent.UUID, &from, models.InProgress, source), readings,
+		models.NewRentalEvent(models.RentalStartedEvent, started, source, traceContext(ctx)))
 	if err != nil {
-		return fmt.Errorf("change rent status: %w", err)
+		return fmt.Errorf("start rent: %w", err)
+	}
+
+	return nil
+}
+
+// Finish records the car return and calculates extra charges by the tariff.
+func (r *Rental) Finish(ctx context.Context, uid uuid.UUID, username string, req models.FinishRentRequest, source models.ChangeSource) (*models.Rent, error) {
+	err := validator.New().Struct(req.CarReadings)
+	if err != nil {
+		return nil, fmt.Errorf("validate car readings: %w (%w)", err, models.ErrInvalidRent)
+	}
+
+	rent, err := r.Get(ctx, uid, username)
+	if err != nil {
+		return nil, fmt.Errorf("get rent: %w", err)
+	}
+
+	return r.finish(ctx, rent, req, source)
+}
+
+func (r *Rental) finish(ctx context.Context, rent *models.Rent, req models.FinishRentRequest, source models.ChangeSource) (*models.Rent, error) {
+	if !rent.Status.CanTransitionTo(models.Finished) {
+		return nil, fmt.Errorf("finish rent: %s -> %s: %w", rent.Status, models.Finished, models.ErrTransition)
+	}
+
+	now := time.Now().UTC()
+	if req.ReturnedAt.IsZero() {
+		req.ReturnedAt = now
+	}
+
+	fieldErrors := validateReturn(*rent, req, now)
+	if len(fieldErrors) > 0 {
+		return nil, fmt.Errorf("validate return: %w (%w)", fieldErrors, models.ErrInvalidRent)
+	}
+
+	from := rent.Status
+	rent.Status = models.Finished
+	rent.ReturnedAt = &req.ReturnedAt
+	rent.EndOdometer = req.Odometer
+	rent.EndFuelLevel = req.FuelLevel
+	rent.Charges = calculateCharges(r.tariff, *rent)
+
+	err := r.repo.Finish(ctx, *rent, newRentEvent(rent.UUID, &from, models.Finished, source),
+		models.NewRentalEvent(models.RentalFinishedEvent, *rent, source, traceContext(ctx)))
+	if err != nil {
+		return nil, fmt.Errorf("finish rent: %w", err)
+	}
+
+	return rent, nil
+}
+
+func validateReturn(rent models.Rent, req models.FinishRentRequest, now time.Time) models.ValidationErrors {
+	var fieldErrors models.ValidationErrors
+
+	if req.ReturnedAt.Before(rent.DateFrom) || req.ReturnedAt.After(now) {
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "ReturnedAt",
+			Error: "return time must be between the rent start and now",
+		})
+	}
+
+	if req.Odometer != nil && rent.StartOdometer != nil && *req.Odometer < *rent.StartOdometer {
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "Odometer",
+			Error: fmt.Sprintf("odometer must not be less than %d km at pickup", *rent.StartOdometer),
+		})
+	}
+
+	return fieldErrors
+}
+
+// ChangeDateTo moves the end date of the rent. The new price keeps the average daily price of the rent,
+// so the difference is the price of the added or removed days. The caller charges or refunds the difference
+// and reverts the change if it fails.
+func (r *Rental) ChangeDateTo(ctx context.Context, uid uuid.UUID, username string, req models.ChangeRentRequest, source models.ChangeSource) (*models.RentChange, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("validate request: %w", err)
+	}
+
+	rent, err := r.Get(ctx, uid, username)
+	if err != nil {
+		return nil, fmt.Errorf("get rent: %w", err)
+	}
+
+	if rent.Status != models.Reserved && rent.Status != models.InProgress {
+		return nil, fmt.Errorf("change date of %s rent: %w", rent.Status, models.ErrTransition)
+	}
+
+	err = r.validateDateTo(ctx, *rent, req.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("validate date to: %w", err)
+	}
+
+	// Rents shorter than a day were booked before the minimum was checked, they are priced as one day.
+	oldDays := max(int(rent.DateTo.Sub(rent.DateFrom)/day), 1)
+	newDays := int(req.DateTo.Sub(rent.DateFrom) / day)
+	price := int(math.Round(float64(rent.Price) * float64(newDays) / float64(oldDays)))
+
+	change := models.RentChange{
+		UUID:           uuid.New(),
+		RentalUUID:     rent.UUID,
+		PreviousDateTo: rent.DateTo,
+		DateTo:         req.DateTo,
+		PreviousPrice:  rent.Price,
+		Price:          price,
+		Currency:       rent.Currency,
+		Actor:          source.Actor,
+		RequestID:      source.RequestID,
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	description := fmt.Sprintf("extended by %d days", newDays-oldDays)
+	if newDays < oldDays {
+		description = fmt.Sprintf("shortened by %d days", oldDays-newDays)
+	}
+
+	rent.DateTo = change.DateTo
+	rent.Price = change.Price
+	rent.PriceItems = append(rent.PriceItems, models.PriceItem{
+		Kind:        models.PriceDateChange,
+		Description: description,
+		Quantity:    max(newDays-oldDays, oldDays-newDays),
+		Amount:      change.Difference(),
+	})
+
+	err = r.repo.ChangeDateTo(ctx, *rent, change, models.NewRentalEvent(models.RentalDatesChangedEvent, *rent, source, traceContext(ctx)))
+	if err != nil {
+		if errors.Is(err, models.ErrRentOverlaps) {
+			return nil, fmt.Errorf("change rent date: %w (%w)", overlapError(), models.ErrInvalidRent)
+		}
+
+		return nil, fmt.Errorf("change rent date: %w", err)
+	}
+
+	return &change, nil
+}
+
+// validateDateTo checks the new end date against the rent limits and other rents of the car.
+func (r *Rental) validateDateTo(ctx context.Context, rent models.Rent, dateTo time.Time) error {
+	var fieldErrors models.ValidationErrors
+
+	days := int(dateTo.Sub(rent.DateFrom) / day)
+	today := time.Now().UTC().Truncate(day)
+
+	switch {
+	case dateTo.Equal(rent.DateTo):
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: "date to is not changed",
+		})
+	case dateTo.Before(today):
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: "date to must not be in the past",
+		})
+	case !dateTo.After(rent.DateFrom):
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: "date to must be after date from",
+		})
+	case days < r.limits.MinDays:
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: fmt.Sprintf("rent must last at least %d days", r.limits.MinDays),
+		})
+	case r.limits.MaxDays > 0 && days > r.limits.MaxDays:
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: fmt.Sprintf("rent must last at most %d days", r.limits.MaxDays),
+		})
+	}
+
+	if len(fieldErrors) > 0 {
+		return fmt.Errorf("check dates: %w (%w)", fieldErrors, models.ErrInvalidRent)
+	}
+
+	if !dateTo.After(rent.DateTo) {
+		return nil
+	}
+
+	// Only added days may overlap other rents, the rent itself ends at the current date to.
+	overlaps, err := r.repo.HasOverlapping(ctx, rent.CarUUID, rent.DateTo, dateTo)
+	if err != nil {
+		return fmt.Errorf("check overlapping rents: %w", err)
+	}
+
+	if overlaps {
+		return fmt.Errorf("check overlapping rents: %w (%w)", overlapError(), models.ErrInvalidRent)
 	}
 
 	return nil
 }
 
-func (r *Rental) Finish(ctx context.Context, uid uuid.UUID, username string) error {
+// RevertChange restores the end date and the price of the rent from before the change.
+// Reverting a reverted change does nothing, the change of a rent changed after it is not reverted.
+func (r *Rental) RevertChange(ctx context.Context, uid, changeUID uuid.UUID, username string) error {
+	rent, err := r.Get(ctx, uid, username)
+	if err != nil {
+		return fmt.Errorf("get rent: %w", err)
+	}
+
+	change, err := r.repo.GetChange(ctx, changeUID)
+	if err != nil {
+		return fmt.Errorf("get rent change: %w", err)
+	}
+
+	if change.RentalUUID != rent.UUID {
+		return fmt.Errorf("check rent change: %w", models.ErrChangeNotFound)
+	}
+
+	if change.RevertedAt != nil {
+		return nil
+	}
+
+	if !rent.DateTo.Equal(change.DateTo) || rent.Price != change.Price {
+		return fmt.Errorf("check rent change: %w", models.ErrRentChanged)
+	}
+
+	rent.DateTo = change.PreviousDateTo
+	rent.Price = change.PreviousPrice
+	if n := len(rent.PriceItems); n > 0 && rent.PriceItems[n-1].Kind == models.PriceDateChange {
+		rent.PriceItems = rent.PriceItems[:n-1]
+	}
+
+	now := time.Now().UTC()
+	change.RevertedAt = &now
+
+	err = r.repo.RevertChange(ctx, *rent, *change, models.NewRentalEvent(models.RentalDatesChangedEvent, *rent, models.ChangeSource{
+		Actor:  username,
+		Reason: fmt.Sprintf("change %s is reverted", change.UUID),
+	}, traceContext(ctx)))
+	if err != nil {
+		return fmt.Errorf("revert rent change: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Rental) changeUserRentStatus(ctx context.Context, uid uuid.UUID, username string, status models.RentStatus, source models.ChangeSource) error {
 	rent, err := r.repo.Get(ctx, uid)
 	if err != nil {
 		return fmt.Errorf("get rent: %w", err)
@@ -79,11 +419,35 @@ func (r *Rental) Finish(ctx context.Context, uid uuid.UUID, username string) err
 		return fmt.Errorf("check user: %w", models.ErrForbidden)
 	}
 
-	err = r.repo.ChangeStatus(ctx, uid, models.Finished)
+	err = r.transition(ctx, rent, status, source)
+	if err != nil {
+		return fmt.Errorf("transition rent: %w", err)
+	}
+
+	return nil
+}
+
+// transition moves the rent to the new status if the transition table allows it.
+// Repository changes the status only if it wasn't changed concurrently and records the change in the rent history.
+// The events are saved to the outbox after the event of the status.
+func (r *Rental) transition(ctx context.Context, rent *models.Rent, status models.RentStatus, source models.ChangeSource, events ...outbox.DomainEvent) error {
+	if !rent.Status.CanTransitionTo(status) {
+		return fmt.Errorf("%s -> %s: %w", rent.Status, status, models.ErrTransition)
+	}
+
+	from := rent.Status
+	changed := *rent
+	changed.Status = status
+
+	events = append([]outbox.DomainEvent{models.NewRentalEvent(models.StatusEvents[status], changed, source, traceContext(ctx))}, events...)
+
+	err := r.repo.ChangeStatus(ctx, newRentEvent(rent.UUID, &from, status, source), events...)
 	if err != nil {
 		return fmt.Errorf("change rent status: %w", err)
 	}
 
+	rent.Status = status
+
 	return nil
 }
 
@@ -100,11 +464,132 @@ func (r *Rental) Get(ctx context.Context, uid uuid.UUID, username string) (*mode
 	return rent, nil
 }
 
+func (r *Rental) GetHistory(ctx context.Context, uid uuid.UUID, username string) ([]models.RentEvent, error) {
+	_, err := r.Get(ctx, uid, username)
+	if err != nil {
+		return nil, fmt.Errorf("get rent: %w", err)
+	}
+
+	events, err := r.repo.GetHistory(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get rent history: %w", err)
+	}
+
+	return events, nil
+}
+
+func newRentEvent(uid uuid.UUID, from *models.RentStatus, to models.RentStatus, source models.ChangeSource) models.RentEvent {
+	return models.RentEvent{
+		RentalUUID: uid,
+		FromStatus: from,
+		ToStatus:   to,
+		Actor:      source.Actor,
+		Reason:     source.Reason,
+		RequestID:  source.RequestID,
+		CreatedAt:  time.Now().UTC(),
+	}
+}
+
+// validatePeriod checks dates order, rent length and that the car is not rented for the same period.
+func (r *Rental) validatePeriod(ctx context.Context, req models.CreateRentRequest) error {
+	var fieldErrors models.ValidationErrors
+
+	today := time.Now().UTC().Truncate(day)
+	if req.DateFrom.Before(today) {
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateFrom",
+			Error: "date from must not be in the past",
+		})
+	}
+
+	days := int(req.DateTo.Sub(req.DateFrom) / day)
+	switch {
+	case !req.DateTo.After(req.DateFrom):
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: "date to must be after date from",
+		})
+	case days < r.limits.MinDays:
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: fmt.Sprintf("rent must last at least %d days", r.limits.MinDays),
+		})
+	case r.limits.MaxDays > 0 && days > r.limits.MaxDays:
+		fieldErrors = append(fieldErrors, models.FieldError{
+			Field: "DateTo",
+			Error: fmt.Sprintf("rent must last at most %d days", r.limits.MaxDays),
+		})
+	}
+
+	if len(fieldErrors) > 0 {
+		return fmt.Errorf("check dates: %w (%w)", fieldErrors, models.ErrInvalidRent)
+	}
+
+	overlaps, err := r.repo.HasOverlapping(ctx, req.CarUUID, req.DateFrom, req.DateTo)
+	if err != nil {
+		return fmt.Errorf("check overlapping rents: %w", err)
+	}
+
+	if overlaps {
+		return fmt.Errorf("check overlapping rents: %w (%w)", overlapError(), models.ErrInvalidRent)
+	}
+
+	return nil
+}
+
+// getQuote returns the quote locking the price of the rent if it is still valid for the car and the period.
+func (r *Rental) getQuote(ctx context.Context, req models.CreateRentRequest) (*models.Quote, error) {
+	quote, err := r.repo.GetQuote(ctx, req.QuoteUUID)
+	if err != nil {
+		if errors.Is(err, models.ErrQuoteNotFound) {
+			return nil, fmt.Errorf("get quote: %w (%w)", quoteError(err.Error()), models.ErrInvalidRent)
+		}
+
+		return nil, fmt.Errorf("get quote: %w", err)
+	}
+
+	if quote.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("check quote: %w (%w)", quoteError("quote has expired"), models.ErrInvalidRent)
+	}
+
+	if quote.CarUUID != req.CarUUID || !quote.DateFrom.Equal(req.DateFrom) || !quote.DateTo.Equal(req.DateTo) {
+		return nil, fmt.Errorf("check quote: %w (%w)", quoteError("quote was made for another car or period"), models.ErrInvalidRent)
+	}
+
+	return quote, nil
+}
+
+func quoteError(message string) models.ValidationErrors {
+	return models.ValidationErrors{{
+		Field: "QuoteUUID",
+		Error: message,
+	}}
+}
+
+func overlapError() models.ValidationErrors {
+	return models.ValidationErrors{{
+		Field: "CarUUID",
+		Error: models.ErrRentOverlaps.Error(),
+	}}
+}
+
 //go:generate mockery --all --with-expecter --exported --output mocks/
 
 type rentalRepo interface {
 	Get(ctx context.Context, uid uuid.UUID) (*models.Rent, error)
-	GetUserRentals(ctx context.Context, username string) ([]models.Rent, error)
-	Create(ctx context.Context, rent models.Rent) (*models.Rent, error)
-	ChangeStatus(ctx context.Context, uid uuid.UUID, status models.RentStatus) error
+	Find(ctx context.Context, filter models.RentFilter) (*models.RentList, error)
+	GetByPayments(ctx context.Context, paymentUUIDs, rentalUUIDs []uuid.UUID) ([]models.Rent, error)
+	Create(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent) (*models.Rent, error)
+	ChangeStatus(ctx context.Context, event models.RentEvent, events ...outbox.DomainEvent) error
+	Start(ctx context.Context, event models.RentEvent, readings models.CarReadings, events ...outbox.DomainEvent) error
+	Finish(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent) error
+	GetHistory(ctx context.Context, uid uuid.UUID) ([]models.RentEvent, error)
+	ChangeDateTo(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent) error
+	RevertChange(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent) error
+	GetChange(ctx context.Context, uid uuid.UUID) (*models.RentChange, error)
+	HasOverlapping(ctx context.Context, carUID uuid.UUID, from, to time.Time) (bool, error)
+	GetQuote(ctx context.Context, uid uuid.UUID) (*models.Quote, error)
+	GetOverdue(ctx context.Context, now time.Time) ([]models.Rent, error)
+	GetNoShows(ctx context.Context, before time.Time) ([]models.Rent, error)
+	WithAdvisoryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error)
 }
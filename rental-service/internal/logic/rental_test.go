@@ -7,8 +7,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/logic/mocks"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/go-playground/assert.v1"
 )
@@ -33,7 +36,7 @@ func TestRentalLogic_Get(t *testing.T) {
 		repository := mocks.NewRentalRepo(t)
 		repository.EXPECT().Get(ctx, uuid).Return(want, nil)
 
-		p := New(repository)
+		p := New(repository, models.RentLimits{}, models.Tariff{})
 		got, err := p.Get(ctx, uuid, want.Username)
 		require.NoError(t, err)
 		assert.Equal(t, want, got)
@@ -46,9 +49,517 @@ func TestRentalLogic_Get(t *testing.T) {
 		repository := mocks.NewRentalRepo(t)
 		repository.EXPECT().Get(ctx, uuid).Return(nil, errors.New("error"))
 
-		p := New(repository)
+		p := New(repository, models.RentLimits{}, models.Tariff{})
 		got, err := p.Get(ctx, uuid, "user")
 		require.Error(t, err)
 		require.Nil(t, got)
 	})
 }
+
+func TestRentalLogic_GetUserRentals(t *testing.T) {
+	t.Run("default page", func(t *testing.T) {
+		ctx := context.Background()
+		want := &models.RentList{Items: []models.Rent{{UUID: uuid.New(), Username: "user"}}, Total: 1, PageSize: models.DefaultRentPageSize}
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Find(ctx, models.RentFilter{
+			Username: "user",
+			Sort:     models.SortDateFromDesc,
+			PageSize: models.DefaultRentPageSize,
+		}).Return(want, nil)
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		got, err := p.GetUserRentals(ctx, models.RentFilter{Username: "user"})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		ctx := context.Background()
+		dateFrom := time.Date(2024, 11, 10, 0, 0, 0, 0, time.UTC)
+
+		tests := map[string]models.RentFilter{
+			"unknown status":         {Username: "user", Statuses: []models.RentStatus{"PAID"}},
+			"too large page":         {Username: "user", PageSize: 101},
+			"date to before from":    {Username: "user", DateFrom: &dateFrom, DateTo: lo.ToPtr(dateFrom.AddDate(0, 0, -1))},
+			"unknown sort direction": {Username: "user", Sort: "PRICE"},
+		}
+
+		for name, filter := range tests {
+			t.Run(name, func(t *testing.T) {
+				p := New(mocks.NewRentalRepo(t), models.RentLimits{}, models.Tariff{})
+				got, err := p.GetUserRentals(ctx, filter)
+				require.ErrorIs(t, err, models.ErrInvalidRent)
+				require.Nil(t, got)
+			})
+		}
+	})
+}
+
+func TestRentalLogic_GetOwners(t *testing.T) {
+	t.Run("got owners", func(t *testing.T) {
+		ctx := context.Background()
+
+		paymentUUID, rentalUUID := uuid.New(), uuid.New()
+		want := []models.Rent{
+			{UUID: uuid.New(), PaymentUUID: paymentUUID, Username: "user"},
+			{UUID: rentalUUID, PaymentUUID: uuid.New(), Username: "other"},
+		}
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().GetByPayments(ctx, []uuid.UUID{paymentUUID}, []uuid.UUID{rentalUUID}).Return(want, nil)
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		got, err := p.GetOwners(ctx, []uuid.UUID{paymentUUID}, []uuid.UUID{rentalUUID})
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("nothing to look up", func(t *testing.T) {
+		p := New(mocks.NewRentalRepo(t), models.RentLimits{}, models.Tariff{})
+		got, err := p.GetOwners(context.Background(), nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+
+	t.Run("too many uuids", func(t *testing.T) {
+		uuids := make([]uuid.UUID, maxOwnersLookup+1)
+
+		p := New(mocks.NewRentalRepo(t), models.RentLimits{}, models.Tariff{})
+		_, err := p.GetOwners(context.Background(), uuids, nil)
+		require.ErrorIs(t, err, models.ErrInvalidRent)
+	})
+}
+
+func TestRentalLogic_Create(t *testing.T) {
+	limits := models.RentLimits{MinDays: 1, MaxDays: 30}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	newRequest := func(from, to time.Time) models.CreateRentRequest {
+		return models.CreateRentRequest{
+			Username:    "user",
+			PaymentUUID: uuid.New(),
+			CarUUID:     uuid.New(),
+			DateFrom:    from,
+			DateTo:      to,
+			QuoteUUID:   uuid.New(),
+		}
+	}
+
+	newQuote := func(req models.CreateRentRequest, expiresAt time.Time) *models.Quote {
+		return &models.Quote{
+			UUID:       req.QuoteUUID,
+			CarUUID:    req.CarUUID,
+			DateFrom:   req.DateFrom,
+			DateTo:     req.DateTo,
+			Currency:   "RUB",
+			TotalPrice: 7000,
+			ExpiresAt:  expiresAt,
+		}
+	}
+
+	t.Run("created rental", func(t *testing.T) {
+		ctx := context.Background()
+		req := newRequest(today.AddDate(0, 0, 1), today.AddDate(0, 0, 3))
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().HasOverlapping(ctx, req.CarUUID, req.DateFrom, req.DateTo).Return(false, nil)
+		repository.EXPECT().GetQuote(ctx, req.QuoteUUID).Return(newQuote(req, time.Now().Add(time.Minute)), nil)
+		repository.EXPECT().Create(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent) (*models.Rent, error) {
+			assert.Equal(t, rent.UUID, event.RentalUUID)
+			assert.Equal(t, (*models.RentStatus)(nil), event.FromStatus)
+			assert.Equal(t, models.Reserved, event.ToStatus)
+			assert.Equal(t, "user", event.Actor)
+
+			require.Len(t, events, 1)
+			assert.Equal(t, models.RentalCreatedEvent, events[0].Type)
+			assert.Equal(t, rent.UUID, events[0].AggregateID)
+			assert.Equal(t, models.RentalEventVersion, events[0].Version)
+
+			return &rent, nil
+		})
+
+		p := New(repository, limits, models.Tariff{})
+		got, err := p.Create(ctx, req, models.ChangeSource{Actor: "user"})
+		require.NoError(t, err)
+		assert.Equal(t, models.Reserved, got.Status)
+		assert.Equal(t, 7000, got.Price)
+		assert.Equal(t, req.QuoteUUID, *got.QuoteUUID)
+	})
+
+	t.Run("quote is expired or made for another car", func(t *testing.T) {
+		ctx := context.Background()
+		req := newRequest(today.AddDate(0, 0, 1), today.AddDate(0, 0, 3))
+
+		expired := newQuote(req, time.Now().Add(-time.Minute))
+		anotherCar := newQuote(req, time.Now().Add(time.Minute))
+		anotherCar.CarUUID = uuid.New()
+
+		for _, quote := range []*models.Quote{expired, anotherCar} {
+			repository := mocks.NewRentalRepo(t)
+			repository.EXPECT().HasOverlapping(ctx, req.CarUUID, req.DateFrom, req.DateTo).Return(false, nil)
+			repository.EXPECT().GetQuote(ctx, req.QuoteUUID).Return(quote, nil)
+
+			p := New(repository, limits, models.Tariff{})
+			got, err := p.Create(ctx, req, models.ChangeSource{})
+			require.ErrorIs(t, err, models.ErrInvalidRent)
+			require.Nil(t, got)
+
+			var fieldErrors models.ValidationErrors
+			require.ErrorAs(t, err, &fieldErrors)
+			assert.Equal(t, "QuoteUUID", fieldErrors[0].Field)
+		}
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		ctx := context.Background()
+
+		tests := map[string]struct {
+			req   models.CreateRentRequest
+			field string
+		}{
+			"date to before date from": {newRequest(today.AddDate(0, 0, 3), today.AddDate(0, 0, 1)), "DateTo"},
+			"date from in the past":    {newRequest(today.AddDate(0, 0, -2), today.AddDate(0, 0, 1)), "DateFrom"},
+			"too long":                 {newRequest(today, today.AddDate(0, 0, 31)), "DateTo"},
+		}
+
+		for name, tt := range tests {
+			t.Run(name, func(t *testing.T) {
+				p := New(mocks.NewRentalRepo(t), limits, models.Tariff{})
+				got, err := p.Create(ctx, tt.req, models.ChangeSource{})
+				require.ErrorIs(t, err, models.ErrInvalidRent)
+				require.Nil(t, got)
+
+				var fieldErrors models.ValidationErrors
+				require.ErrorAs(t, err, &fieldErrors)
+				assert.Equal(t, tt.field, fieldErrors[0].Field)
+			})
+		}
+	})
+
+	t.Run("car is already rented", func(t *testing.T) {
+		ctx := context.Background()
+		req := newRequest(today.AddDate(0, 0, 1), today.AddDate(0, 0, 3))
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().HasOverlapping(ctx, req.CarUUID, req.DateFrom, req.DateTo).Return(true, nil)
+
+		p := New(repository, limits, models.Tariff{})
+		got, err := p.Create(ctx, req, models.ChangeSource{})
+		require.ErrorIs(t, err, models.ErrInvalidRent)
+		require.Nil(t, got)
+	})
+}
+
+func TestRentalLogic_Finish(t *testing.T) {
+	dateFrom := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+	dateTo := dateFrom.AddDate(0, 0, 2)
+
+	tariff := models.Tariff{
+		LateGracePeriod:  30 * time.Minute,
+		LateFeePerHour:   500,
+		LateFeePerDay:    5000,
+		IncludedKmPerDay: 300,
+		MileageRate:      10,
+		RefuelRate:       50,
+	}
+
+	newRent := func(status models.RentStatus) *models.Rent {
+		return &models.Rent{
+			UUID:           uuid.New(),
+			Username:       "user",
+			DateFrom:       dateFrom,
+			DateTo:         dateTo,
+			Status:         status,
+			StartOdometer:  lo.ToPtr(10000),
+			StartFuelLevel: lo.ToPtr(80),
+		}
+	}
+
+	t.Run("finished rental with charges", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.Overdue)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().Finish(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, got models.Rent, event models.RentEvent, events ...outbox.DomainEvent) error {
+			assert.Equal(t, rent.UUID, event.RentalUUID)
+			assert.Equal(t, models.Overdue, *event.FromStatus)
+			assert.Equal(t, models.Finished, event.ToStatus)
+			assert.Equal(t, "request", event.RequestID)
+			assert.Equal(t, models.Finished, got.Status)
+
+			require.Len(t, events, 1)
+			assert.Equal(t, models.RentalFinishedEvent, events[0].Type)
+			data := events[0].Data.(models.RentalEventData)
+			assert.Equal(t, models.Finished, data.Status)
+			assert.Equal(t, got.Charges, data.Charges)
+
+			return nil
+		})
+
+		p := New(repository, models.RentLimits{}, tariff)
+		got, err := p.Finish(ctx, rent.UUID, "user", models.FinishRentRequest{
+			CarReadings: models.CarReadings{
+				Odometer:  lo.ToPtr(10700),
+				FuelLevel: lo.ToPtr(50),
+			},
+			ReturnedAt: dateTo.Add(26*time.Hour + 10*time.Minute),
+		}, models.ChangeSource{Actor: "user", RequestID: "request"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []models.Charge{
+			{Kind: models.ChargeLateReturn, Description: "late return, full days", Quantity: 1, Unit: models.UnitDay, Amount: 5000},
+			{Kind: models.ChargeLateReturn, Description: "late return, hours", Quantity: 3, Unit: models.UnitHour, Amount: 1500},
+			{Kind: models.ChargeMileage, Description: "mileage over 300 km per day", Quantity: 100, Unit: models.UnitKm, Amount: 1000},
+			{Kind: models.ChargeRefuel, Description: "refuel to the pickup level", Quantity: 30, Unit: models.UnitPercent, Amount: 1500},
+		}, got.Charges)
+	})
+
+	t.Run("returned in time", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.InProgress)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().Finish(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		p := New(repository, models.RentLimits{}, tariff)
+		got, err := p.Finish(ctx, rent.UUID, "user", models.FinishRentRequest{
+			CarReadings: models.CarReadings{
+				Odometer:  lo.ToPtr(10300),
+				FuelLevel: lo.ToPtr(80),
+			},
+			ReturnedAt: dateTo.Add(20 * time.Minute),
+		}, models.ChangeSource{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(got.Charges))
+	})
+
+	t.Run("odometer less than at pickup", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.InProgress)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+
+		p := New(repository, models.RentLimits{}, tariff)
+		got, err := p.Finish(ctx, rent.UUID, "user", models.FinishRentRequest{
+			CarReadings: models.CarReadings{Odometer: lo.ToPtr(9000)},
+			ReturnedAt:  dateTo,
+		}, models.ChangeSource{})
+		require.ErrorIs(t, err, models.ErrInvalidRent)
+		require.Nil(t, got)
+
+		var fieldErrors models.ValidationErrors
+		require.ErrorAs(t, err, &fieldErrors)
+		assert.Equal(t, "Odometer", fieldErrors[0].Field)
+	})
+
+	t.Run("canceled rental can't be finished", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.Canceled)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+
+		p := New(repository, models.RentLimits{}, tariff)
+		_, err := p.Finish(ctx, rent.UUID, "user", models.FinishRentRequest{}, models.ChangeSource{})
+		require.ErrorIs(t, err, models.ErrTransition)
+	})
+
+	t.Run("other user's rental", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.InProgress)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+
+		p := New(repository, models.RentLimits{}, tariff)
+		_, err := p.Finish(ctx, rent.UUID, "another user", models.FinishRentRequest{}, models.ChangeSource{})
+		require.ErrorIs(t, err, models.ErrForbidden)
+	})
+}
+
+func TestRentalLogic_ChangeDateTo(t *testing.T) {
+	limits := models.RentLimits{MinDays: 1, MaxDays: 30}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	newRent := func(status models.RentStatus) *models.Rent {
+		return &models.Rent{
+			UUID:       uuid.New(),
+			Username:   "user",
+			CarUUID:    uuid.New(),
+			DateFrom:   today.AddDate(0, 0, 1),
+			DateTo:     today.AddDate(0, 0, 4),
+			Status:     status,
+			Price:      9000,
+			Currency:   "RUB",
+			PriceItems: []models.PriceItem{{Kind: models.PriceBase, Description: "base rate", Quantity: 3, Amount: 9000}},
+		}
+	}
+
+	t.Run("extended rental", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.Reserved)
+		dateTo := today.AddDate(0, 0, 6)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().HasOverlapping(ctx, rent.CarUUID, rent.DateTo, dateTo).Return(false, nil)
+		repository.EXPECT().ChangeDateTo(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, got models.Rent, change models.RentChange, _ ...outbox.DomainEvent) error {
+			assert.Equal(t, dateTo, got.DateTo)
+			assert.Equal(t, 15000, got.Price)
+			assert.Equal(t, models.PriceItem{Kind: models.PriceDateChange, Description: "extended by 2 days", Quantity: 2, Amount: 6000}, got.PriceItems[1])
+			assert.Equal(t, "user", change.Actor)
+
+			return nil
+		})
+
+		p := New(repository, limits, models.Tariff{})
+		got, err := p.ChangeDateTo(ctx, rent.UUID, "user", models.ChangeRentRequest{DateTo: dateTo}, models.ChangeSource{Actor: "user"})
+		require.NoError(t, err)
+		assert.Equal(t, rent.UUID, got.RentalUUID)
+		assert.Equal(t, 9000, got.PreviousPrice)
+		assert.Equal(t, 6000, got.Difference())
+	})
+
+	t.Run("shortened rental", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.InProgress)
+		dateTo := today.AddDate(0, 0, 2)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().ChangeDateTo(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		p := New(repository, limits, models.Tariff{})
+		got, err := p.ChangeDateTo(ctx, rent.UUID, "user", models.ChangeRentRequest{DateTo: dateTo}, models.ChangeSource{})
+		require.NoError(t, err)
+		assert.Equal(t, 3000, got.Price)
+		assert.Equal(t, -6000, got.Difference())
+	})
+
+	t.Run("rental shorter than a day", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.Reserved)
+		rent.DateTo = rent.DateFrom
+		dateTo := today.AddDate(0, 0, 3)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().HasOverlapping(ctx, rent.CarUUID, rent.DateTo, dateTo).Return(false, nil)
+		repository.EXPECT().ChangeDateTo(ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		p := New(repository, limits, models.Tariff{})
+		got, err := p.ChangeDateTo(ctx, rent.UUID, "user", models.ChangeRentRequest{DateTo: dateTo}, models.ChangeSource{})
+		require.NoError(t, err)
+		assert.Equal(t, 18000, got.Price)
+		assert.Equal(t, 9000, got.Difference())
+	})
+
+	t.Run("car is rented for new days", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.Reserved)
+		dateTo := today.AddDate(0, 0, 6)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().HasOverlapping(ctx, rent.CarUUID, rent.DateTo, dateTo).Return(true, nil)
+
+		p := New(repository, limits, models.Tariff{})
+		got, err := p.ChangeDateTo(ctx, rent.UUID, "user", models.ChangeRentRequest{DateTo: dateTo}, models.ChangeSource{})
+		require.ErrorIs(t, err, models.ErrInvalidRent)
+		require.Nil(t, got)
+	})
+
+	t.Run("finished rental", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.Finished)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+
+		p := New(repository, limits, models.Tariff{})
+		got, err := p.ChangeDateTo(ctx, rent.UUID, "user", models.ChangeRentRequest{DateTo: today.AddDate(0, 0, 6)}, models.ChangeSource{})
+		require.ErrorIs(t, err, models.ErrTransition)
+		require.Nil(t, got)
+	})
+}
+
+func TestRentalLogic_RevertChange(t *testing.T) {
+	dateFrom := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	newChange := func() (*models.Rent, *models.RentChange) {
+		rent := &models.Rent{
+			UUID:     uuid.New(),
+			Username: "user",
+			DateFrom: dateFrom,
+			DateTo:   dateFrom.AddDate(0, 0, 5),
+			Status:   models.Reserved,
+			Price:    15000,
+			PriceItems: []models.PriceItem{
+				{Kind: models.PriceBase, Quantity: 3, Amount: 9000},
+				{Kind: models.PriceDateChange, Quantity: 2, Amount: 6000},
+			},
+		}
+
+		return rent, &models.RentChange{
+			UUID:           uuid.New(),
+			RentalUUID:     rent.UUID,
+			PreviousDateTo: dateFrom.AddDate(0, 0, 3),
+			DateTo:         rent.DateTo,
+			PreviousPrice:  9000,
+			Price:          rent.Price,
+		}
+	}
+
+	t.Run("reverted change", func(t *testing.T) {
+		ctx := context.Background()
+		rent, change := newChange()
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().GetChange(ctx, change.UUID).Return(change, nil)
+		repository.EXPECT().RevertChange(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, got models.Rent, reverted models.RentChange, _ ...outbox.DomainEvent) error {
+			assert.Equal(t, change.PreviousDateTo, got.DateTo)
+			assert.Equal(t, 9000, got.Price)
+			assert.Equal(t, 1, len(got.PriceItems))
+			require.NotNil(t, reverted.RevertedAt)
+
+			return nil
+		})
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		err := p.RevertChange(ctx, rent.UUID, change.UUID, "user")
+		require.NoError(t, err)
+	})
+
+	t.Run("already reverted", func(t *testing.T) {
+		ctx := context.Background()
+		rent, change := newChange()
+		change.RevertedAt = lo.ToPtr(time.Now())
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().GetChange(ctx, change.UUID).Return(change, nil)
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		err := p.RevertChange(ctx, rent.UUID, change.UUID, "user")
+		require.NoError(t, err)
+	})
+
+	t.Run("rental changed after", func(t *testing.T) {
+		ctx := context.Background()
+		rent, change := newChange()
+		rent.DateTo = rent.DateTo.AddDate(0, 0, 1)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().Get(ctx, rent.UUID).Return(rent, nil)
+		repository.EXPECT().GetChange(ctx, change.UUID).Return(change, nil)
+
+		p := New(repository, models.RentLimits{}, models.Tariff{})
+		err := p.RevertChange(ctx, rent.UUID, change.UUID, "user")
+		require.ErrorIs(t, err, models.ErrRentChanged)
+	})
+}
@@ -0,0 +1,135 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"go.uber.org/zap"
+)
+
+const (
+	// schedulerLockKey is the key of the postgres advisory lock which elects the replica running the scheduler.
+	schedulerLockKey int64 = 7_265_001
+
+	schedulerActor = "scheduler"
+)
+
+// Scheduler marks rents overdue and handles no-shows according to the policy. Events of the changes
+// are saved to the outbox with the status, so the gateway settles no-shows even if kafka was down.
+type Scheduler struct {
+	rental *Rental
+	policy models.SchedulerPolicy
+	logger *zap.SugaredLogger
+}
+
+func NewScheduler(rental *Rental, policy models.SchedulerPolicy, logger *zap.SugaredLogger) *Scheduler {
+	return &Scheduler{
+		rental: rental,
+		policy: policy,
+		logger: logger,
+	}
+}
+
+// Run processes rents every interval until the context is done.
+func (s *Scheduler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.Process(ctx)
+			if err != nil {
+				s.logger.Errorw("cannot process rents", "error", err)
+			}
+		}
+	}
+}
+
+// Process handles overdue rents and no-shows if this replica holds the scheduler lock.
+func (s *Scheduler) Process(ctx context.Context) error {
+	locked, err := s.rental.repo.WithAdvisoryLock(ctx, schedulerLockKey, func(ctx context.Context) error {
+		now := time.Now().UTC()
+
+		err := s.markOverdue(ctx, now)
+		if err != nil {
+			return fmt.Errorf("mark overdue rents: %w", err)
+		}
+
+		err = s.handleNoShows(ctx, now)
+		if err != nil {
+			return fmt.Errorf("handle no-shows: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("process rents under lock: %w", err)
+	}
+
+	if !locked {
+		s.logger.Debug("scheduler lock is held by another replica")
+	}
+
+	return nil
+}
+
+func (s *Scheduler) markOverdue(ctx context.Context, now time.Time) error {
+	rents, err := s.rental.repo.GetOverdue(ctx, now)
+	if err != nil {
+		return fmt.Errorf("get overdue rents: %w", err)
+	}
+
+	for _, rent := range rents {
+		err = s.rental.transition(ctx, &rent, models.Overdue, models.ChangeSource{
+			Actor:  schedulerActor,
+			Reason: "rent period has ended",
+		})
+		if err != nil {
+			if errors.Is(err, models.ErrTransition) {
+				continue
+			}
+
+			return fmt.Errorf("transition rent %s: %w", rent.UUID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Scheduler) handleNoShows(ctx context.Context, now time.Time) error {
+	if s.policy.NoShow == models.NoShowKeep || s.policy.NoShow == "" {
+		return nil
+	}
+
+	rents, err := s.rental.repo.GetNoShows(ctx, now.Add(-s.policy.NoShowGracePeriod))
+	if err != nil {
+		return fmt.Errorf("get no-show rents: %w", err)
+	}
+
+	for _, rent := range rents {
+		source := models.ChangeSource{
+			Actor:  schedulerActor,
+			Reason: fmt.Sprintf("car was not picked up, no-show policy %q", s.policy.NoShow),
+		}
+
+		canceled := rent
+		canceled.Status = models.Canceled
+
+		err = s.rental.transition(ctx, &rent, models.Canceled, source, models.NewNoShowEvent(canceled, source, s.policy.NoShow))
+		if err != nil {
+			if errors.Is(err, models.ErrTransition) {
+				continue
+			}
+
+			return fmt.Errorf("transition rent %s: %w", rent.UUID, err)
+		}
+	}
+
+	return nil
+}
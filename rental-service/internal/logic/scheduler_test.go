@@ -0,0 +1,94 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/logic/mocks"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestScheduler_Process(t *testing.T) {
+	newRent := func(status models.RentStatus) models.Rent {
+		return models.Rent{
+			UUID:     uuid.New(),
+			Username: "user",
+			Status:   status,
+		}
+	}
+
+	withLock := func(ctx context.Context, _ int64, fn func(ctx context.Context) error) (bool, error) {
+		return true, fn(ctx)
+	}
+
+	t.Run("overdue rental", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.InProgress)
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().WithAdvisoryLock(ctx, schedulerLockKey, mock.Anything).RunAndReturn(withLock)
+		repository.EXPECT().GetOverdue(ctx, mock.Anything).Return([]models.Rent{rent}, nil)
+		repository.EXPECT().ChangeStatus(ctx, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, event models.RentEvent, events ...outbox.DomainEvent) error {
+			assert.Equal(t, models.Overdue, event.ToStatus)
+			assert.Equal(t, schedulerActor, event.Actor)
+
+			require.Len(t, events, 1)
+			assert.Equal(t, models.RentalOverdueEvent, events[0].Type)
+			assert.Equal(t, outbox.TraceContext{}, events[0].Trace)
+
+			return nil
+		})
+
+		s := NewScheduler(New(repository, models.RentLimits{}, models.Tariff{}), models.SchedulerPolicy{NoShow: models.NoShowKeep}, zap.NewNop().Sugar())
+		err := s.Process(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("no-show rental is canceled", func(t *testing.T) {
+		ctx := context.Background()
+		rent := newRent(models.Reserved)
+		policy := models.SchedulerPolicy{NoShow: models.NoShowCancel, NoShowGracePeriod: time.Hour}
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().WithAdvisoryLock(ctx, schedulerLockKey, mock.Anything).RunAndReturn(withLock)
+		repository.EXPECT().GetOverdue(ctx, mock.Anything).Return(nil, nil)
+		repository.EXPECT().GetNoShows(ctx, mock.Anything).Return([]models.Rent{rent}, nil)
+		repository.EXPECT().ChangeStatus(ctx, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, event models.RentEvent, events ...outbox.DomainEvent) error {
+			assert.Equal(t, models.Canceled, event.ToStatus)
+
+			require.Len(t, events, 2)
+			assert.Equal(t, models.RentalCanceledEvent, events[0].Type)
+			assert.Equal(t, models.RentalNoShowEvent, events[1].Type)
+
+			data, ok := events[1].Data.(models.RentalEventData)
+			require.True(t, ok)
+			assert.Equal(t, rent.UUID, data.RentalUID)
+			assert.Equal(t, models.Canceled, data.Status)
+			assert.Equal(t, models.NoShowCancel, data.NoShowPolicy)
+
+			return nil
+		})
+
+		s := NewScheduler(New(repository, models.RentLimits{}, models.Tariff{}), policy, zap.NewNop().Sugar())
+		err := s.Process(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("lock is held by another replica", func(t *testing.T) {
+		ctx := context.Background()
+
+		repository := mocks.NewRentalRepo(t)
+		repository.EXPECT().WithAdvisoryLock(ctx, schedulerLockKey, mock.Anything).Return(false, nil)
+
+		s := NewScheduler(New(repository, models.RentLimits{}, models.Tariff{}), models.SchedulerPolicy{}, zap.NewNop().Sugar())
+		err := s.Process(ctx)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+)
+
+// fullTank is the fuel level which is assumed at pickup if it wasn't recorded.
+const fullTank = 100
+
+// calculateCharges returns extra charges for the returned car: late return, mileage over the included limit and refuel.
+func calculateCharges(tariff models.Tariff, rent models.Rent) []models.Charge {
+	var charges []models.Charge
+
+	if rent.ReturnedAt != nil {
+		charges = append(charges, lateReturnCharges(tariff, rent.DateTo, *rent.ReturnedAt)...)
+	}
+
+	if rent.StartOdometer != nil && rent.EndOdometer != nil && tariff.IncludedKmPerDay > 0 && tariff.MileageRate > 0 {
+		days := int(rent.DateTo.Sub(rent.DateFrom) / day)
+		overage := *rent.EndOdometer - *rent.StartOdometer - tariff.IncludedKmPerDay*days
+		if overage > 0 {
+			charges = append(charges, models.Charge{
+				Kind:        models.ChargeMileage,
+				Description: fmt.Sprintf("mileage over %d km per day", tariff.IncludedKmPerDay),
+				Quantity:    overage,
+				Unit:        models.UnitKm,
+				Amount:      overage * tariff.MileageRate,
+			})
+		}
+	}
+
+	if rent.EndFuelLevel != nil && tariff.RefuelRate > 0 {
+		startFuelLevel := fullTank
+		if rent.StartFuelLevel != nil {
+			startFuelLevel = *rent.StartFuelLevel
+		}
+
+		missing := startFuelLevel - *rent.EndFuelLevel
+		if missing > 0 {
+			charges = append(charges, models.Charge{
+				Kind:        models.ChargeRefuel,
+				Description: "refuel to the pickup level",
+				Quantity:    missing,
+				Unit:        models.UnitPercent,
+				Amount:      missing * tariff.RefuelRate,
+			})
+		}
+	}
+
+	return charges
+}
+
+// lateReturnCharges charges full days of delay by the daily fee and the rest by the hourly fee,
+// which never exceeds the daily one. The delay is counted from the end of the rent if it exceeds the grace period.
+func lateReturnCharges(tariff models.Tariff, dueAt, returnedAt time.Time) []models.Charge {
+	delay := returnedAt.Sub(dueAt)
+	if delay <= tariff.LateGracePeriod {
+		return nil
+	}
+
+	hours := int((delay + time.Hour - 1) / time.Hour)
+	days, hours := hours/24, hours%24
+
+	var charges []models.Charge
+
+	if days > 0 && tariff.LateFeePerDay > 0 {
+		charges = append(charges, models.Charge{
+			Kind:        models.ChargeLateReturn,
+			Description: "late return, full days",
+			Quantity:    days,
+			Unit:        models.UnitDay,
+			Amount:      days * tariff.LateFeePerDay,
+		})
+	} else {
+		hours += days * 24
+	}
+
+	if hours > 0 && tariff.LateFeePerHour > 0 {
+		amount := hours * tariff.LateFeePerHour
+		if tariff.LateFeePerDay > 0 {
+			amount = min(amount, tariff.LateFeePerDay)
+		}
+
+		charges = append(charges, models.Charge{
+			Kind:        models.ChargeLateReturn,
+			Description: "late return, hours",
+			Quantity:    hours,
+			Unit:        models.UnitHour,
+			Amount:      amount,
+		})
+	}
+
+	return charges
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrChangeNotFound = errors.New("rent change not found")
+	ErrRentChanged    = errors.New("rent is changed by another request")
+)
+
+// RentChange records a change of the rent end date. The difference of prices is charged or refunded by the caller,
+// the change is reverted if it fails.
+type RentChange struct {
+	ID             int        `gorm:"column:id;primaryKey"`
+	UUID           uuid.UUID  `gorm:"column:change_uid;type:uuid"`
+	RentalUUID     uuid.UUID  `gorm:"column:rental_uid;type:uuid"`
+	PreviousDateTo time.Time  `gorm:"column:previous_date_to;type:timestamptz"`
+	DateTo         time.Time  `gorm:"column:date_to;type:timestamptz"`
+	PreviousPrice  int        `gorm:"column:previous_price"`
+	Price          int        `gorm:"column:price"`
+	Currency       string     `gorm:"column:currency"`
+	Actor          string     `gorm:"column:actor"`
+	RequestID      string     `gorm:"column:request_id"`
+	CreatedAt      time.Time  `gorm:"column:created_at;type:timestamptz"`
+	RevertedAt     *time.Time `gorm:"column:reverted_at;type:timestamptz"`
+}
+
+// Difference is positive when the rent became more expensive.
+func (c RentChange) Difference() int {
+	return c.Price - c.PreviousPrice
+}
+
+type ChangeRentRequest struct {
+	DateTo time.Time `validate:"required"`
+}
+
+func (r *ChangeRentRequest) Validate() error {
+	err := validator.New().Struct(r)
+	if err != nil {
+		return fmt.Errorf("validate change rent: %w (%w)", err, ErrInvalidRent)
+	}
+
+	return nil
+}
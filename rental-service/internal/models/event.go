@@ -0,0 +1,93 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
+)
+
+// EventSource is the name of the service in the envelope of its domain events.
+const EventSource = "rental-service"
+
+const (
+	RentalCreatedEvent      outbox.EventType = "RentalCreated"
+	RentalStartedEvent      outbox.EventType = "RentalStarted"
+	RentalOverdueEvent      outbox.EventType = "RentalOverdue"
+	RentalFinishedEvent     outbox.EventType = "RentalFinished"
+	RentalCanceledEvent     outbox.EventType = "RentalCanceled"
+	RentalDatesChangedEvent outbox.EventType = "RentalDatesChanged"
+	// RentalNoShowEvent follows RentalCanceledEvent of the rent canceled by the scheduler,
+	// the gateway releases the car and settles the payment by the no-show policy.
+	RentalNoShowEvent outbox.EventType = "RentalNoShow"
+)
+
+// RentalEventVersion is the version of RentalEventData, it is increased on incompatible changes of the payload.
+const RentalEventVersion = 1
+
+// StatusEvents are the events of rents moved to the status.
+var StatusEvents = map[RentStatus]outbox.EventType{
+	InProgress: RentalStartedEvent,
+	Overdue:    RentalOverdueEvent,
+	Finished:   RentalFinishedEvent,
+	Canceled:   RentalCanceledEvent,
+}
+
+// RentalEventData is the state of the rent after the change with who changed it and why.
+type RentalEventData struct {
+	RentalUID  uuid.UUID   `json:"rental_uid"`
+	Username   string      `json:"username"`
+	CarUID     uuid.UUID   `json:"car_uid"`
+	PaymentUID uuid.UUID   `json:"payment_uid"`
+	DateFrom   time.Time   `json:"date_from"`
+	DateTo     time.Time   `json:"date_to"`
+	Status     RentStatus  `json:"status"`
+	Price      int         `json:"price"`
+	Currency   string      `json:"currency"`
+	PriceItems []PriceItem `json:"price_items,omitempty"`
+	ReturnedAt *time.Time  `json:"returned_at,omitempty"`
+	Charges    []Charge    `json:"charges,omitempty"`
+	Actor      string      `json:"actor,omitempty"`
+	Reason     string      `json:"reason,omitempty"`
+	// NoShowPolicy is set only in RentalNoShowEvent.
+	NoShowPolicy NoShowPolicy `json:"no_show_policy,omitempty"`
+}
+
+func NewRentalEvent(eventType outbox.EventType, rent Rent, source ChangeSource, trace outbox.TraceContext) outbox.DomainEvent {
+	return outbox.DomainEvent{
+		ID:          uuid.New(),
+		Type:        eventType,
+		Version:     RentalEventVersion,
+		Source:      EventSource,
+		AggregateID: rent.UUID,
+		OccurredAt:  time.Now().UTC(),
+		Trace:       trace,
+		Data: RentalEventData{
+			RentalUID:  rent.UUID,
+			Username:   rent.Username,
+			CarUID:     rent.CarUUID,
+			PaymentUID: rent.PaymentUUID,
+			DateFrom:   rent.DateFrom,
+			DateTo:     rent.DateTo,
+			Status:     rent.Status,
+			Price:      rent.Price,
+			Currency:   rent.Currency,
+			PriceItems: rent.PriceItems,
+			ReturnedAt: rent.ReturnedAt,
+			Charges:    rent.Charges,
+			Actor:      source.Actor,
+			Reason:     source.Reason,
+		},
+	}
+}
+
+// NewNoShowEvent creates the event of the rent canceled by the scheduler because the car wasn't picked up.
+func NewNoShowEvent(rent Rent, source ChangeSource, policy NoShowPolicy) outbox.DomainEvent {
+	event := NewRentalEvent(RentalNoShowEvent, rent, source, outbox.TraceContext{})
+
+	data := event.Data.(RentalEventData)
+	data.NoShowPolicy = policy
+	event.Data = data
+
+	return event
+}
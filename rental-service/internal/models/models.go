@@ -3,6 +3,8 @@ package models
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -10,28 +12,76 @@ import (
 )
 
 var (
-	ErrRentNotFound = errors.New("payment not found")
-	ErrInvalidRent  = errors.New("invalid rent")
-	ErrForbidden    = errors.New("forbidden")
+	ErrRentNotFound  = errors.New("payment not found")
+	ErrInvalidRent   = errors.New("invalid rent")
+	ErrForbidden     = errors.New("forbidden")
+	ErrRentOverlaps  = errors.New("car is already rented for this period")
+	ErrTransition    = errors.New("rent status transition is not allowed")
+	ErrQuoteNotFound = errors.New("quote not found")
 )
 
 type RentStatus string
 
 const (
+	Reserved   RentStatus = "RESERVED"
 	InProgress RentStatus = "IN_PROGRESS"
+	Overdue    RentStatus = "OVERDUE"
 	Finished   RentStatus = "FINISHED"
 	Canceled   RentStatus = "CANCELED"
 )
 
+// ActiveStatuses are statuses of rents which hold the car for their period.
+var ActiveStatuses = []RentStatus{Reserved, InProgress, Overdue}
+
+var transitions = map[RentStatus][]RentStatus{
+	Reserved:   {InProgress, Canceled},
+	InProgress: {Finished, Overdue},
+	Overdue:    {Finished},
+}
+
+func (s RentStatus) CanTransitionTo(to RentStatus) bool {
+	return slices.Contains(transitions[s], to)
+}
+
 type Rent struct {
-	ID          int        `gorm:"column:id;primaryKey"`
-	UUID        uuid.UUID  `gorm:"column:rental_uid;type:uuid"`
-	Username    string     `gorm:"username:price"`
-	PaymentUUID uuid.UUID  `gorm:"column:payment_uid;type:uuid"`
-	CarUUID     uuid.UUID  `gorm:"column:car_uid;type:uuid"`
-	DateFrom    time.Time  `gorm:"column:date_from;type:timestamptz"`
-	DateTo      time.Time  `gorm:"column:date_to;type:timestamptz"`
-	Status      RentStatus `gorm:"column:status"`
+	ID          int         `gorm:"column:id;primaryKey"`
+	UUID        uuid.UUID   `gorm:"column:rental_uid;type:uuid"`
+	Username    string      `gorm:"username:price"`
+	PaymentUUID uuid.UUID   `gorm:"column:payment_uid;type:uuid"`
+	CarUUID     uuid.UUID   `gorm:"column:car_uid;type:uuid"`
+	DateFrom    time.Time   `gorm:"column:date_from;type:timestamptz"`
+	DateTo      time.Time   `gorm:"column:date_to;type:timestamptz"`
+	Status      RentStatus  `gorm:"column:status"`
+	QuoteUUID   *uuid.UUID  `gorm:"column:quote_uid;type:uuid"`
+	Price       int         `gorm:"column:price"`
+	Currency    string      `gorm:"column:currency"`
+	PriceItems  []PriceItem `gorm:"column:price_items;type:jsonb;serializer:json"`
+	// Readings of the car at pickup and return, they are used to calculate charges.
+	StartOdometer  *int       `gorm:"column:start_odometer"`
+	StartFuelLevel *int       `gorm:"column:start_fuel_level"`
+	EndOdometer    *int       `gorm:"column:end_odometer"`
+	EndFuelLevel   *int       `gorm:"column:end_fuel_level"`
+	ReturnedAt     *time.Time `gorm:"column:returned_at;type:timestamptz"`
+	Charges        []Charge   `gorm:"column:charges;type:jsonb;serializer:json"`
+}
+
+// RentEvent is a record of a rent status change. FromStatus is nil for rent creation.
+type RentEvent struct {
+	ID         int         `gorm:"column:id;primaryKey"`
+	RentalUUID uuid.UUID   `gorm:"column:rental_uid;type:uuid"`
+	FromStatus *RentStatus `gorm:"column:from_status"`
+	ToStatus   RentStatus  `gorm:"column:to_status"`
+	Actor      string      `gorm:"column:actor"`
+	Reason     string      `gorm:"column:reason"`
+	RequestID  string      `gorm:"column:request_id"`
+	CreatedAt  time.Time   `gorm:"column:created_at;type:timestamptz"`
+}
+
+// ChangeSource describes who changes the rent status, why and within which request.
+type ChangeSource struct {
+	Actor     string
+	Reason    string
+	RequestID string
 }
 
 type CreateRentRequest struct {
@@ -40,6 +90,7 @@ type CreateRentRequest struct {
 	CarUUID     uuid.UUID `validate:"required"`
 	DateFrom    time.Time `validate:"required"`
 	DateTo      time.Time `validate:"required"`
+	QuoteUUID   uuid.UUID `validate:"required"`
 }
 
 func (r *CreateRentRequest) Validate() error {
@@ -50,3 +101,83 @@ func (r *CreateRentRequest) Validate() error {
 
 	return nil
 }
+
+type RentSort string
+
+const (
+	SortDateFromAsc  RentSort = "DATE_FROM_ASC"
+	SortDateFromDesc RentSort = "DATE_FROM_DESC"
+)
+
+// DefaultRentPageSize is used when the page size isn't requested.
+const DefaultRentPageSize = 20
+
+// RentFilter selects a page of rents. Rents are selected if their period intersects the dates range,
+// empty fields don't restrict the selection.
+type RentFilter struct {
+	Username string
+	CarUUID  *uuid.UUID
+	Statuses []RentStatus `validate:"dive,oneof=RESERVED IN_PROGRESS OVERDUE FINISHED CANCELED"`
+	DateFrom *time.Time
+	DateTo   *time.Time
+	Sort     RentSort `validate:"omitempty,oneof=DATE_FROM_ASC DATE_FROM_DESC"`
+	Page     int      `validate:"gte=0"`
+	PageSize int      `validate:"gte=0,lte=100"`
+}
+
+func (f *RentFilter) Validate() error {
+	err := validator.New().Struct(f)
+	if err != nil {
+		return fmt.Errorf("validate rent filter: %w (%w)", err, ErrInvalidRent)
+	}
+
+	return nil
+}
+
+type RentList struct {
+	Items    []Rent
+	Total    int
+	Page     int
+	PageSize int
+}
+
+// RentLimits restricts the length of a rent in days. Zero MaxDays means no upper limit.
+type RentLimits struct {
+	MinDays int
+	MaxDays int
+}
+
+// NoShowPolicy defines what happens to a reserved rent when the client didn't pick up the car.
+type NoShowPolicy string
+
+const (
+	// NoShowKeep leaves the rent reserved.
+	NoShowKeep NoShowPolicy = "keep"
+	// NoShowCancel cancels the rent and returns the payment.
+	NoShowCancel NoShowPolicy = "cancel"
+	// NoShowForfeit cancels the rent and keeps the payment.
+	NoShowForfeit NoShowPolicy = "forfeit"
+)
+
+// SchedulerPolicy configures the automatic handling of overdue rents and no-shows.
+type SchedulerPolicy struct {
+	NoShow            NoShowPolicy
+	NoShowGracePeriod time.Duration
+}
+
+type FieldError struct {
+	Field string
+	Error string
+}
+
+// ValidationErrors describes problems with request fields which can't be expressed with validator tags.
+type ValidationErrors []FieldError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldError := range e {
+		messages = append(messages, fmt.Sprintf("%s: %s", fieldError.Field, fieldError.Error))
+	}
+
+	return strings.Join(messages, "; ")
+}
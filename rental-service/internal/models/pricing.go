@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceItemKind string
+
+const (
+	PriceBase             PriceItemKind = "BASE"
+	PriceSeason           PriceItemKind = "SEASON"
+	PriceWeekend          PriceItemKind = "WEEKEND"
+	PriceDurationDiscount PriceItemKind = "DURATION_DISCOUNT"
+	// PriceDateChange is the price of days added to or removed from the rent after it was created.
+	PriceDateChange PriceItemKind = "DATE_CHANGE"
+)
+
+// PricingRules configure the price calculation. Amounts are in minor units of the currency.
+// Multipliers are applied to the base daily rate, so every surcharge is a separate line item.
+type PricingRules struct {
+	// Currency of the type rates and of cars priced without a currency.
+	Currency string
+	// QuoteTTL is how long the quoted price can be used for booking.
+	QuoteTTL time.Duration
+	// TypeRates are base daily rates by car type. The car's own price is used for types without a rate
+	// and for cars priced in another currency.
+	TypeRates         map[string]int
+	WeekendMultiplier float64
+	Seasons           []Season
+	DurationDiscounts []DurationDiscount
+}
+
+// Season is a period of the year in MM-DD format. From may be after To for seasons crossing the new year.
+type Season struct {
+	Name       string
+	From       string
+	To         string
+	Multiplier float64
+}
+
+func (s Season) Contains(day time.Time) bool {
+	monthDay := day.Format("01-02")
+	if s.From <= s.To {
+		return monthDay >= s.From && monthDay <= s.To
+	}
+
+	return monthDay >= s.From || monthDay <= s.To
+}
+
+// DurationDiscount is applied to rents lasting at least MinDays. The discount with the largest MinDays wins.
+type DurationDiscount struct {
+	MinDays int
+	Percent int
+}
+
+type QuoteRequest struct {
+	CarUUID    uuid.UUID `validate:"required"`
+	CarType    string    `validate:"required"`
+	DailyPrice int       `validate:"gt=0"`
+	Currency   string    `validate:"omitempty,iso4217"`
+	DateFrom   time.Time `validate:"required"`
+	DateTo     time.Time `validate:"required"`
+}
+
+type PriceItem struct {
+	Kind        PriceItemKind `json:"kind"`
+	Description string        `json:"description"`
+	Quantity    int           `json:"quantity"`
+	Amount      int           `json:"amount"`
+}
+
+// Quote is a calculated price which is locked for the car and the period until it expires.
+type Quote struct {
+	ID         int         `gorm:"column:id;primaryKey"`
+	UUID       uuid.UUID   `gorm:"column:quote_uid;type:uuid"`
+	CarUUID    uuid.UUID   `gorm:"column:car_uid;type:uuid"`
+	DateFrom   time.Time   `gorm:"column:date_from;type:timestamptz"`
+	DateTo     time.Time   `gorm:"column:date_to;type:timestamptz"`
+	Days       int         `gorm:"column:days"`
+	Currency   string      `gorm:"column:currency"`
+	Items      []PriceItem `gorm:"column:items;type:jsonb;serializer:json"`
+	TotalPrice int         `gorm:"column:total_price"`
+	ExpiresAt  time.Time   `gorm:"column:expires_at;type:timestamptz"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+)
+
+type ChargeKind string
+
+const (
+	ChargeLateReturn ChargeKind = "LATE_RETURN"
+	ChargeMileage    ChargeKind = "MILEAGE"
+	ChargeRefuel     ChargeKind = "REFUEL"
+)
+
+type ChargeUnit string
+
+const (
+	UnitHour    ChargeUnit = "HOUR"
+	UnitDay     ChargeUnit = "DAY"
+	UnitKm      ChargeUnit = "KM"
+	UnitPercent ChargeUnit = "PERCENT"
+)
+
+// Tariff configures extra charges calculated when the car is returned. Zero rates disable the charge.
+// Fees are in minor units of the rental currency.
+type Tariff struct {
+	// LateGracePeriod is how late the car can be returned without a fee.
+	LateGracePeriod time.Duration
+	LateFeePerHour  int
+	// LateFeePerDay is charged for every full day of delay and caps the hourly fee for the rest of the delay.
+	LateFeePerDay int
+	// IncludedKmPerDay is the mileage included into the rent price, zero means unlimited mileage.
+	IncludedKmPerDay int
+	MileageRate      int
+	// RefuelRate is charged for every percent of the tank which is missing compared to the pickup.
+	RefuelRate int
+}
+
+// Charge is an extra charge for the rent which is calculated on the car return.
+type Charge struct {
+	Kind        ChargeKind `json:"kind"`
+	Description string     `json:"description"`
+	Quantity    int        `json:"quantity"`
+	Unit        ChargeUnit `json:"unit"`
+	Amount      int        `json:"amount"`
+}
+
+// CarReadings are the odometer in kilometers and the fuel level in percent of the tank.
+type CarReadings struct {
+	Odometer  *int `validate:"omitempty,gte=0"`
+	FuelLevel *int `validate:"omitempty,gte=0,lte=100"`
+}
+
+type FinishRentRequest struct {
+	CarReadings
+	ReturnedAt time.Time
+}
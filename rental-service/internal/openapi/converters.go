@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/generated/openapi"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"github.com/samber/lo"
 )
 
 func fromRent(r models.Rent) openapi.RentalResponse {
@@ -19,10 +20,135 @@ func fromRent(r models.Rent) openapi.RentalResponse {
 		DateTo:     r.DateTo.Format(time.DateOnly),
 		PaymentUid: r.PaymentUUID,
 		RentalUid:  r.UUID,
+		Username:   lo.EmptyableToPtr(r.Username),
 		Status:     openapi.RentalResponseStatus(r.Status),
+		Price:      &r.Price,
+		Currency:   lo.EmptyableToPtr(r.Currency),
+		PriceItems: lo.ToPtr(fromPriceItems(r.PriceItems)),
+		ReturnedAt: r.ReturnedAt,
+		Charges:    lo.ToPtr(fromCharges(r.Charges)),
 	}
 }
 
+func fromRentList(list models.RentList) openapi.RentalPaginationResponse {
+	return openapi.RentalPaginationResponse{
+		Items: lo.Map(list.Items, func(r models.Rent, _ int) openapi.RentalResponse {
+			return fromRent(r)
+		}),
+		Page:          list.Page,
+		PageSize:      list.PageSize,
+		TotalElements: list.Total,
+	}
+}
+
+func toRentFilter(params openapi.GetUserRentalsParams, username string) (*models.RentFilter, error) {
+	dateFrom, dateTo, err := parseDateRange(params.DateFrom, params.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RentFilter{
+		Username: username,
+		Statuses: lo.Map(lo.FromPtr(params.Status), func(status openapi.GetUserRentalsParamsStatus, _ int) models.RentStatus {
+			return models.RentStatus(status)
+		}),
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+		Sort:     models.RentSort(lo.FromPtr(params.Sort)),
+		Page:     lo.FromPtr(params.Page),
+		PageSize: lo.FromPtr(params.Size),
+	}, nil
+}
+
+func toSearchFilter(params openapi.SearchRentalsParams) (*models.RentFilter, error) {
+	dateFrom, dateTo, err := parseDateRange(params.DateFrom, params.DateTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.RentFilter{
+		Username: lo.FromPtr(params.Username),
+		CarUUID:  params.CarUid,
+		Statuses: lo.Map(lo.FromPtr(params.Status), func(status openapi.SearchRentalsParamsStatus, _ int) models.RentStatus {
+			return models.RentStatus(status)
+		}),
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+		Sort:     models.RentSort(lo.FromPtr(params.Sort)),
+		Page:     lo.FromPtr(params.Page),
+		PageSize: lo.FromPtr(params.Size),
+	}, nil
+}
+
+func parseDateRange(from, to *string) (*time.Time, *time.Time, error) {
+	var dateFrom, dateTo *time.Time
+
+	if from != nil {
+		date, err := time.Parse(time.DateOnly, *from)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid date from (%w): %w", models.ErrInvalidRent, err)
+		}
+
+		dateFrom = &date
+	}
+
+	if to != nil {
+		date, err := time.Parse(time.DateOnly, *to)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid date to (%w): %w", models.ErrInvalidRent, err)
+		}
+
+		dateTo = &date
+	}
+
+	return dateFrom, dateTo, nil
+}
+
+func fromCharges(charges []models.Charge) []openapi.Charge {
+	return lo.Map(charges, func(charge models.Charge, _ int) openapi.Charge {
+		return openapi.Charge{
+			Amount:      charge.Amount,
+			Description: charge.Description,
+			Kind:        openapi.ChargeKind(charge.Kind),
+			Quantity:    charge.Quantity,
+			Unit:        openapi.ChargeUnit(charge.Unit),
+		}
+	})
+}
+
+func toCarReadings(r openapi.CarReadings) models.CarReadings {
+	return models.CarReadings{
+		Odometer:  r.Odometer,
+		FuelLevel: r.FuelLevel,
+	}
+}
+
+func toFinishRentRequest(r openapi.FinishRentalRequest) models.FinishRentRequest {
+	return models.FinishRentRequest{
+		CarReadings: models.CarReadings{
+			Odometer:  r.Odometer,
+			FuelLevel: r.FuelLevel,
+		},
+		ReturnedAt: lo.FromPtr(r.ReturnedAt),
+	}
+}
+
+func fromRentEvent(e models.RentEvent) openapi.RentalEvent {
+	event := openapi.RentalEvent{
+		Actor:     e.Actor,
+		CreatedAt: e.CreatedAt,
+		Reason:    lo.EmptyableToPtr(e.Reason),
+		RequestId: lo.EmptyableToPtr(e.RequestID),
+		ToStatus:  string(e.ToStatus),
+	}
+
+	if e.FromStatus != nil {
+		event.FromStatus = lo.ToPtr(string(*e.FromStatus))
+	}
+
+	return event
+}
+
 func toRentCreateRequest(r openapi.CreateRentalRequest, username string) (*models.CreateRentRequest, error) {
 	dateFrom, err := time.Parse(time.DateOnly, r.DateFrom)
 	if err != nil {
@@ -40,14 +166,103 @@ func toRentCreateRequest(r openapi.CreateRentalRequest, username string) (*model
 		CarUUID:     r.CarUid,
 		DateFrom:    dateFrom,
 		DateTo:      dateTo,
+		QuoteUUID:   r.QuoteUid,
 	}, nil
 }
 
+func toChangeRentRequest(r openapi.ChangeRentalRequest) (*models.ChangeRentRequest, error) {
+	dateTo, err := time.Parse(time.DateOnly, r.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date to (%w): %w", models.ErrInvalidRent, err)
+	}
+
+	return &models.ChangeRentRequest{
+		DateTo: dateTo,
+	}, nil
+}
+
+func fromRentChange(c models.RentChange) openapi.RentalChange {
+	return openapi.RentalChange{
+		ChangeUid:      c.UUID,
+		RentalUid:      c.RentalUUID,
+		PreviousDateTo: c.PreviousDateTo.Format(time.DateOnly),
+		DateTo:         c.DateTo.Format(time.DateOnly),
+		PreviousPrice:  c.PreviousPrice,
+		Price:          c.Price,
+		Difference:     c.Difference(),
+		Currency:       c.Currency,
+		CreatedAt:      c.CreatedAt,
+		RevertedAt:     c.RevertedAt,
+	}
+}
+
+func toQuoteRequest(r openapi.QuoteRequest) (*models.QuoteRequest, error) {
+	dateFrom, err := time.Parse(time.DateOnly, r.DateFrom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date from (%w): %w", models.ErrInvalidRent, err)
+	}
+
+	dateTo, err := time.Parse(time.DateOnly, r.DateTo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date to (%w): %w", models.ErrInvalidRent, err)
+	}
+
+	return &models.QuoteRequest{
+		CarUUID:    r.CarUid,
+		CarType:    r.CarType,
+		DailyPrice: r.DailyPrice,
+		Currency:   lo.FromPtr(r.Currency),
+		DateFrom:   dateFrom,
+		DateTo:     dateTo,
+	}, nil
+}
+
+func fromQuote(q models.Quote) openapi.QuoteResponse {
+	return openapi.QuoteResponse{
+		CarUid:     q.CarUUID,
+		Currency:   q.Currency,
+		DateFrom:   q.DateFrom.Format(time.DateOnly),
+		DateTo:     q.DateTo.Format(time.DateOnly),
+		Days:       q.Days,
+		ExpiresAt:  q.ExpiresAt,
+		Items:      fromPriceItems(q.Items),
+		QuoteUid:   q.UUID,
+		TotalPrice: q.TotalPrice,
+	}
+}
+
+func fromPriceItems(items []models.PriceItem) []openapi.PriceItem {
+	return lo.Map(items, func(item models.PriceItem, _ int) openapi.PriceItem {
+		return openapi.PriceItem{
+			Amount:      item.Amount,
+			Description: item.Description,
+			Kind:        openapi.PriceItemKind(item.Kind),
+			Quantity:    item.Quantity,
+		}
+	})
+}
+
 func processError(c echo.Context, err error, comment string) error {
 	err = fmt.Errorf("%s: %w", comment, err)
 
 	switch {
 	case errors.Is(err, models.ErrInvalidRent):
+		var fieldErrors models.ValidationErrors
+		if errors.As(err, &fieldErrors) {
+			errorSlice := make([]openapi.ErrorDescription, 0, len(fieldErrors))
+			for _, v := range fieldErrors {
+				errorSlice = append(errorSlice, openapi.ErrorDescription{
+					Error: v.Error,
+					Field: v.Field,
+				})
+			}
+
+			return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
+				Message: err.Error(),
+				Errors:  errorSlice,
+			})
+		}
+
 		var valErrors validator.ValidationErrors
 		if errors.As(err, &valErrors) {
 			errorSlice := make([]openapi.ErrorDescription, 0, len(valErrors))
@@ -66,7 +281,7 @@ func processError(c echo.Context, err error, comment string) error {
 		return c.JSON(http.StatusBadRequest, openapi.ValidationErrorResponse{
 			Message: err.Error(),
 		})
-	case errors.Is(err, models.ErrRentNotFound):
+	case errors.Is(err, models.ErrRentNotFound), errors.Is(err, models.ErrQuoteNotFound), errors.Is(err, models.ErrChangeNotFound):
 		return c.JSON(http.StatusNotFound, openapi.ErrorResponse{
 			Message: err.Error(),
 		})
@@ -74,6 +289,10 @@ func processError(c echo.Context, err error, comment string) error {
 		return c.JSON(http.StatusForbidden, openapi.ErrorResponse{
 			Message: err.Error(),
 		})
+	case errors.Is(err, models.ErrTransition), errors.Is(err, models.ErrRentChanged):
+		return c.JSON(http.StatusConflict, openapi.ErrorResponse{
+			Message: err.Error(),
+		})
 	default:
 		return c.JSON(http.StatusInternalServerError, openapi.ErrorResponse{
 			Message: err.Error(),
@@ -3,6 +3,9 @@ package openapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -11,28 +14,34 @@ import (
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/auth"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/generated/openapi"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/requestid"
 	"github.com/samber/lo"
 )
 
 type Server struct {
-	rentalLogic rentalLogic
+	rentalLogic  rentalLogic
+	pricingLogic pricingLogic
 }
 
-func New(rentalLogic rentalLogic) *Server {
+func New(rentalLogic rentalLogic, pricingLogic pricingLogic) *Server {
 	return &Server{
-		rentalLogic: rentalLogic,
+		rentalLogic:  rentalLogic,
+		pricingLogic: pricingLogic,
 	}
 }
 
-func (s *Server) GetUserRentals(c echo.Context) error {
-	rents, err := s.rentalLogic.GetUserRentals(c.Request().Context(), auth.GetUsername(c.Request().Context()))
+func (s *Server) GetUserRentals(c echo.Context, params openapi.GetUserRentalsParams) error {
+	filter, err := toRentFilter(params, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "validate request data")
+	}
+
+	list, err := s.rentalLogic.GetUserRentals(c.Request().Context(), *filter)
 	if err != nil {
 		return processError(c, err, "get user rentals")
 	}
 
-	return c.JSON(http.StatusOK, lo.Map(rents, func(r models.Rent, _ int) openapi.RentalResponse {
-		return fromRent(r)
-	}))
+	return c.JSON(http.StatusOK, fromRentList(*list))
 }
 
 func (s *Server) Create(c echo.Context) error {
@@ -47,7 +56,7 @@ func (s *Server) Create(c echo.Context) error {
 		return processError(c, err, "validate request data")
 	}
 
-	rent, err := s.rentalLogic.Create(c.Request().Context(), *logicReq)
+	rent, err := s.rentalLogic.Create(c.Request().Context(), *logicReq, changeSource(c.Request().Context(), ""))
 	if err != nil {
 		return processError(c, err, "create rent")
 	}
@@ -55,8 +64,10 @@ func (s *Server) Create(c echo.Context) error {
 	return c.JSON(http.StatusCreated, fromRent(*rent))
 }
 
-func (s *Server) Cancel(c echo.Context, rentalUid openapi_types.UUID) error {
-	err := s.rentalLogic.Cancel(c.Request().Context(), rentalUid, auth.GetUsername(c.Request().Context()))
+func (s *Server) Cancel(c echo.Context, rentalUid openapi_types.UUID, params openapi.CancelParams) error {
+	source := changeSource(c.Request().Context(), lo.FromPtr(params.Reason))
+
+	err := s.rentalLogic.Cancel(c.Request().Context(), rentalUid, auth.GetUsername(c.Request().Context()), source)
 	if err != nil {
 		return processError(c, err, "cancel rent")
 	}
@@ -73,23 +84,240 @@ func (s *Server) Get(c echo.Context, rentalUid openapi_types.UUID) error {
 	return c.JSON(http.StatusOK, fromRent(*rent))
 }
 
+func (s *Server) Start(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.CarReadings
+	err := decodeOptionalBody(c, &req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	err = s.rentalLogic.Start(c.Request().Context(), rentalUid, auth.GetUsername(c.Request().Context()), toCarReadings(req), changeSource(c.Request().Context(), ""))
+	if err != nil {
+		return processError(c, err, "start rent")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
 func (s *Server) Finish(c echo.Context, rentalUid openapi_types.UUID) error {
-	err := s.rentalLogic.Finish(c.Request().Context(), rentalUid, auth.GetUsername(c.Request().Context()))
+	var req openapi.FinishRentalRequest
+	err := decodeOptionalBody(c, &req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	rent, err := s.rentalLogic.Finish(c.Request().Context(), rentalUid, auth.GetUsername(c.Request().Context()), toFinishRentRequest(req), changeSource(c.Request().Context(), ""))
 	if err != nil {
 		return processError(c, err, "finish rent")
 	}
 
+	return c.JSON(http.StatusOK, fromRent(*rent))
+}
+
+func (s *Server) ChangeDates(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.ChangeRentalRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	logicReq, err := toChangeRentRequest(req)
+	if err != nil {
+		return processError(c, err, "validate request data")
+	}
+
+	change, err := s.rentalLogic.ChangeDateTo(c.Request().Context(), rentalUid, auth.GetUsername(c.Request().Context()), *logicReq, changeSource(c.Request().Context(), ""))
+	if err != nil {
+		return processError(c, err, "change rent dates")
+	}
+
+	return c.JSON(http.StatusOK, fromRentChange(*change))
+}
+
+func (s *Server) RevertChange(c echo.Context, rentalUid openapi_types.UUID, changeUid openapi_types.UUID) error {
+	err := s.rentalLogic.RevertChange(c.Request().Context(), rentalUid, changeUid, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "revert rent change")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// decodeOptionalBody leaves v empty if the request has no body.
+func decodeOptionalBody(c echo.Context, v any) error {
+	err := json.NewDecoder(c.Request().Body).Decode(v)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w (%w)", err, models.ErrInvalidRent)
+	}
+
+	return nil
+}
+
+func (s *Server) GetHistory(c echo.Context, rentalUid openapi_types.UUID) error {
+	events, err := s.rentalLogic.GetHistory(c.Request().Context(), rentalUid, auth.GetUsername(c.Request().Context()))
+	if err != nil {
+		return processError(c, err, "get rent history")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(events, func(e models.RentEvent, _ int) openapi.RentalEvent {
+		return fromRentEvent(e)
+	}))
+}
+
+// FindOwners is called by the payment service to fill owners of payments made before they were stored.
+func (s *Server) FindOwners(c echo.Context) error {
+	if auth.GetActor(c.Request().Context()) != auth.ServiceActor {
+		return processError(c, fmt.Errorf("service only: %w", models.ErrForbidden), "find owners")
+	}
+
+	var req openapi.OwnersRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidRent), "cannot unmarshal request body")
+	}
+
+	rents, err := s.rentalLogic.GetOwners(c.Request().Context(), lo.FromPtr(req.PaymentUids), lo.FromPtr(req.RentalUids))
+	if err != nil {
+		return processError(c, err, "find owners")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(rents, func(r models.Rent, _ int) openapi.RentalOwner {
+		return openapi.RentalOwner{
+			RentalUid:  r.UUID,
+			PaymentUid: r.PaymentUUID,
+			Username:   r.Username,
+		}
+	}))
+}
+
+func (s *Server) CreateQuote(c echo.Context) error {
+	var req openapi.QuoteRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, err, "cannot unmarshal request body")
+	}
+
+	logicReq, err := toQuoteRequest(req)
+	if err != nil {
+		return processError(c, err, "validate request data")
+	}
+
+	quote, err := s.pricingLogic.Quote(c.Request().Context(), *logicReq)
+	if err != nil {
+		return processError(c, err, "quote rent")
+	}
+
+	return c.JSON(http.StatusOK, fromQuote(*quote))
+}
+
+func (s *Server) GetQuote(c echo.Context, quoteUid openapi_types.UUID) error {
+	quote, err := s.pricingLogic.GetQuote(c.Request().Context(), quoteUid)
+	if err != nil {
+		return processError(c, err, "get quote")
+	}
+
+	return c.JSON(http.StatusOK, fromQuote(*quote))
+}
+
+func (s *Server) SearchRentals(c echo.Context, params openapi.SearchRentalsParams) error {
+	filter, err := toSearchFilter(params)
+	if err != nil {
+		return processError(c, err, "validate request data")
+	}
+
+	list, err := s.rentalLogic.Search(c.Request().Context(), *filter)
+	if err != nil {
+		return processError(c, err, "search rentals")
+	}
+
+	return c.JSON(http.StatusOK, fromRentList(*list))
+}
+
+func (s *Server) GetAnyRental(c echo.Context, rentalUid openapi_types.UUID) error {
+	rent, err := s.rentalLogic.GetAny(c.Request().Context(), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rent")
+	}
+
+	return c.JSON(http.StatusOK, fromRent(*rent))
+}
+
+func (s *Server) GetAnyRentalHistory(c echo.Context, rentalUid openapi_types.UUID) error {
+	events, err := s.rentalLogic.GetAnyHistory(c.Request().Context(), rentalUid)
+	if err != nil {
+		return processError(c, err, "get rent history")
+	}
+
+	return c.JSON(http.StatusOK, lo.Map(events, func(e models.RentEvent, _ int) openapi.RentalEvent {
+		return fromRentEvent(e)
+	}))
+}
+
+func (s *Server) ForceCancel(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.ForceCancelRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidRent), "cannot unmarshal request body")
+	}
+
+	err = s.rentalLogic.ForceCancel(c.Request().Context(), rentalUid, changeSource(c.Request().Context(), req.Reason))
+	if err != nil {
+		return processError(c, err, "force cancel rent")
+	}
+
 	return c.NoContent(http.StatusNoContent)
 }
 
+func (s *Server) ForceFinish(c echo.Context, rentalUid openapi_types.UUID) error {
+	var req openapi.ForceFinishRequest
+	err := json.NewDecoder(c.Request().Body).Decode(&req)
+	if err != nil {
+		return processError(c, fmt.Errorf("%w (%w)", err, models.ErrInvalidRent), "cannot unmarshal request body")
+	}
+
+	rent, err := s.rentalLogic.ForceFinish(c.Request().Context(), rentalUid, toFinishRentRequest(openapi.FinishRentalRequest{
+		FuelLevel:  req.FuelLevel,
+		Odometer:   req.Odometer,
+		ReturnedAt: req.ReturnedAt,
+	}), changeSource(c.Request().Context(), req.Reason))
+	if err != nil {
+		return processError(c, err, "force finish rent")
+	}
+
+	return c.JSON(http.StatusOK, fromRent(*rent))
+}
+
+func changeSource(ctx context.Context, reason string) models.ChangeSource {
+	return models.ChangeSource{
+		Actor:     auth.GetActor(ctx),
+		Reason:    reason,
+		RequestID: requestid.Get(ctx),
+	}
+}
+
 func (s *Server) Live(c echo.Context) error {
 	return c.NoContent(http.StatusOK)
 }
 
 type rentalLogic interface {
-	GetUserRentals(ctx context.Context, username string) ([]models.Rent, error)
-	Create(ctx context.Context, req models.CreateRentRequest) (*models.Rent, error)
-	Cancel(ctx context.Context, uid uuid.UUID, username string) error
-	Finish(ctx context.Context, uid uuid.UUID, username string) error
+	GetUserRentals(ctx context.Context, filter models.RentFilter) (*models.RentList, error)
+	GetOwners(ctx context.Context, paymentUUIDs, rentalUUIDs []uuid.UUID) ([]models.Rent, error)
+	Create(ctx context.Context, req models.CreateRentRequest, source models.ChangeSource) (*models.Rent, error)
+	Cancel(ctx context.Context, uid uuid.UUID, username string, source models.ChangeSource) error
+	Start(ctx context.Context, uid uuid.UUID, username string, readings models.CarReadings, source models.ChangeSource) error
+	Finish(ctx context.Context, uid uuid.UUID, username string, req models.FinishRentRequest, source models.ChangeSource) (*models.Rent, error)
 	Get(ctx context.Context, uid uuid.UUID, username string) (*models.Rent, error)
+	GetHistory(ctx context.Context, uid uuid.UUID, username string) ([]models.RentEvent, error)
+	ChangeDateTo(ctx context.Context, uid uuid.UUID, username string, req models.ChangeRentRequest, source models.ChangeSource) (*models.RentChange, error)
+	RevertChange(ctx context.Context, uid, changeUID uuid.UUID, username string) error
+	Search(ctx context.Context, filter models.RentFilter) (*models.RentList, error)
+	GetAny(ctx context.Context, uid uuid.UUID) (*models.Rent, error)
+	GetAnyHistory(ctx context.Context, uid uuid.UUID) ([]models.RentEvent, error)
+	ForceCancel(ctx context.Context, uid uuid.UUID, source models.ChangeSource) error
+	ForceFinish(ctx context.Context, uid uuid.UUID, req models.FinishRentRequest, source models.ChangeSource) (*models.Rent, error)
+}
+
+type pricingLogic interface {
+	Quote(ctx context.Context, req models.QuoteRequest) (*models.Quote, error)
+	GetQuote(ctx context.Context, uid uuid.UUID) (*models.Quote, error)
 }
@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
+	"gorm.io/gorm"
+)
+
+func (r *Rental) CreateQuote(ctx context.Context, quote models.Quote) (*models.Quote, error) {
+	err := r.db.Table("quotes").WithContext(ctx).Create(&quote).Error
+	if err != nil {
+		return nil, fmt.Errorf("create quote in db: %w", err)
+	}
+
+	return &quote, nil
+}
+
+func (r *Rental) GetQuote(ctx context.Context, uid uuid.UUID) (*models.Quote, error) {
+	var quote models.Quote
+
+	err := r.db.Table("quotes").WithContext(ctx).First(&quote, "quote_uid = ?", uid).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get quote from db: %w", models.ErrQuoteNotFound)
+		}
+
+		return nil, fmt.Errorf("get quote from db: %w", err)
+	}
+
+	return &quote, nil
+}
@@ -2,14 +2,20 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/polnaya-katuxa/ds-lab-02/outbox"
 	"github.com/polnaya-katuxa/ds-lab-02/rental-service/internal/models"
 	"gorm.io/gorm"
 )
 
+const exclusionViolationCode = "23P01"
+
 type Rental struct {
 	db *gorm.DB
 }
@@ -35,35 +41,330 @@ func (r *Rental) Get(ctx context.Context, uid uuid.UUID) (*models.Rent, error) {
 	return &rent, nil
 }
 
-func (r *Rental) GetUserRentals(ctx context.Context, username string) ([]models.Rent, error) {
+// Find returns a page of rents, they are ordered by the rent id when start dates are equal
+// so that pages don't overlap.
+func (r *Rental) Find(ctx context.Context, filter models.RentFilter) (*models.RentList, error) {
 	var rents []models.Rent
+	var total int64
+
+	query := r.db.Table("rental").WithContext(ctx)
+	if filter.Username != "" {
+		query = query.Where("username = ?", filter.Username)
+	}
+	if filter.CarUUID != nil {
+		query = query.Where("car_uid = ?", *filter.CarUUID)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if filter.DateFrom != nil {
+		query = query.Where("date_to >= ?", *filter.DateFrom)
+	}
+	if filter.DateTo != nil {
+		query = query.Where("date_from <= ?", *filter.DateTo)
+	}
+	query = query.Session(&gorm.Session{})
+
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, fmt.Errorf("count rentals in db: %w", err)
+	}
+
+	order := "date_from DESC, id DESC"
+	if filter.Sort == models.SortDateFromAsc {
+		order = "date_from ASC, id ASC"
+	}
 
-	err := r.db.Table("rental").WithContext(ctx).Where("username = ?", username).Find(&rents).Error
+	err = query.Order(order).Offset(filter.Page * filter.PageSize).Limit(filter.PageSize).Find(&rents).Error
 	if err != nil {
 		return nil, fmt.Errorf("find rentals in db: %w", err)
 	}
 
+	return &models.RentList{
+		Items:    rents,
+		Total:    int(total),
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+	}, nil
+}
+
+// GetByPayments returns rents paid by the payments or having the UUIDs.
+func (r *Rental) GetByPayments(ctx context.Context, paymentUUIDs, rentalUUIDs []uuid.UUID) ([]models.Rent, error) {
+	var rents []models.Rent
+
+	query := r.db.Table("rental").WithContext(ctx).Where("false")
+	if len(paymentUUIDs) > 0 {
+		query = query.Or("payment_uid IN ?", paymentUUIDs)
+	}
+	if len(rentalUUIDs) > 0 {
+		query = query.Or("rental_uid IN ?", rentalUUIDs)
+	}
+
+	err := query.Find(&rents).Error
+	if err != nil {
+		return nil, fmt.Errorf("find rentals by payments in db: %w", err)
+	}
+
 	return rents, nil
 }
 
-func (r *Rental) Create(ctx context.Context, rent models.Rent) (*models.Rent, error) {
-	err := r.db.Table("rental").WithContext(ctx).Create(&rent).Error
+// Create saves the rent together with its creation event and domain events.
+func (r *Rental) Create(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent) (*models.Rent, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table("rental").Create(&rent).Error
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == exclusionViolationCode {
+				return fmt.Errorf("create rental in db: %w", models.ErrRentOverlaps)
+			}
+
+			return fmt.Errorf("create rental in db: %w", err)
+		}
+
+		err = tx.Table("rental_events").Create(&event).Error
+		if err != nil {
+			return fmt.Errorf("create rental event in db: %w", err)
+		}
+
+		return outbox.Save(tx, events)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create rental in db: %w", err)
+		return nil, fmt.Errorf("transaction: %w", err)
 	}
 
 	return &rent, nil
 }
 
-func (r *Rental) ChangeStatus(ctx context.Context, uid uuid.UUID, status models.RentStatus) error {
-	res := r.db.Table("rental").WithContext(ctx).Where("rental_uid = ?", uid).Update("status", status)
+// ChangeStatus moves the rent from event.FromStatus to event.ToStatus only if the rent is still
+// in the from status and records the event and domain events in the same transaction.
+func (r *Rental) ChangeStatus(ctx context.Context, event models.RentEvent, events ...outbox.DomainEvent) error {
+	return r.changeStatus(ctx, event, map[string]any{}, events)
+}
+
+// Start changes the status like ChangeStatus and saves the car readings at pickup.
+func (r *Rental) Start(ctx context.Context, event models.RentEvent, readings models.CarReadings, events ...outbox.DomainEvent) error {
+	return r.changeStatus(ctx, event, map[string]any{
+		"start_odometer":   readings.Odometer,
+		"start_fuel_level": readings.FuelLevel,
+	}, events)
+}
+
+// Finish changes the status like ChangeStatus and saves the car return with the charges.
+func (r *Rental) Finish(ctx context.Context, rent models.Rent, event models.RentEvent, events ...outbox.DomainEvent) error {
+	charges, err := json.Marshal(rent.Charges)
+	if err != nil {
+		return fmt.Errorf("marshal charges: %w", err)
+	}
+
+	return r.changeStatus(ctx, event, map[string]any{
+		"returned_at":    rent.ReturnedAt,
+		"end_odometer":   rent.EndOdometer,
+		"end_fuel_level": rent.EndFuelLevel,
+		"charges":        string(charges),
+	}, events)
+}
+
+func (r *Rental) changeStatus(ctx context.Context, event models.RentEvent, updates map[string]any, events []outbox.DomainEvent) error {
+	updates["status"] = event.ToStatus
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Table("rental").
+			Where("rental_uid = ? AND status = ?", event.RentalUUID, event.FromStatus).
+			Updates(updates)
+		if res.Error != nil {
+			return fmt.Errorf("update rental in db: %w", res.Error)
+		}
+
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("update rental in db: status is not %s anymore: %w", *event.FromStatus, models.ErrTransition)
+		}
+
+		err := tx.Table("rental_events").Create(&event).Error
+		if err != nil {
+			return fmt.Errorf("create rental event in db: %w", err)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ChangeDateTo saves the new end date and price of the rent with the change record. The rent is changed only
+// if it wasn't changed concurrently, the period is checked against other rents of the car by the exclusion constraint.
+func (r *Rental) ChangeDateTo(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := updateDates(tx, rent, change.PreviousDateTo, change.PreviousPrice)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Table("rental_changes").Create(&change).Error
+		if err != nil {
+			return fmt.Errorf("create rental change in db: %w", err)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RevertChange restores the end date and price of the rent from before the change and marks the change reverted.
+func (r *Rental) RevertChange(ctx context.Context, rent models.Rent, change models.RentChange, events ...outbox.DomainEvent) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := updateDates(tx, rent, change.DateTo, change.Price)
+		if err != nil {
+			return err
+		}
+
+		res := tx.Table("rental_changes").
+			Where("change_uid = ? AND reverted_at IS NULL", change.UUID).
+			Update("reverted_at", change.RevertedAt)
+		if res.Error != nil {
+			return fmt.Errorf("update rental change in db: %w", res.Error)
+		}
+
+		if res.RowsAffected == 0 {
+			return fmt.Errorf("update rental change in db: %w", models.ErrRentChanged)
+		}
+
+		return outbox.Save(tx, events)
+	})
+	if err != nil {
+		return fmt.Errorf("transaction: %w", err)
+	}
+
+	return nil
+}
+
+// updateDates saves the end date and the price of the rent if they are still the given ones.
+func updateDates(tx *gorm.DB, rent models.Rent, dateTo time.Time, price int) error {
+	items, err := json.Marshal(rent.PriceItems)
+	if err != nil {
+		return fmt.Errorf("marshal price items: %w", err)
+	}
+
+	res := tx.Table("rental").
+		Where("rental_uid = ? AND status = ? AND date_to = ? AND price = ?", rent.UUID, rent.Status, dateTo, price).
+		Updates(map[string]any{
+			"date_to":     rent.DateTo,
+			"price":       rent.Price,
+			"price_items": string(items),
+		})
 	if res.Error != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(res.Error, &pgErr) && pgErr.Code == exclusionViolationCode {
+			return fmt.Errorf("update rental in db: %w", models.ErrRentOverlaps)
+		}
+
 		return fmt.Errorf("update rental in db: %w", res.Error)
 	}
 
 	if res.RowsAffected == 0 {
-		return fmt.Errorf("update rental in db: %w", models.ErrRentNotFound)
+		return fmt.Errorf("update rental in db: %w", models.ErrRentChanged)
 	}
 
 	return nil
 }
+
+func (r *Rental) GetChange(ctx context.Context, uid uuid.UUID) (*models.RentChange, error) {
+	var change models.RentChange
+
+	err := r.db.Table("rental_changes").WithContext(ctx).First(&change, "change_uid = ?", uid).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get rental change from db: %w", models.ErrChangeNotFound)
+		}
+
+		return nil, fmt.Errorf("get rental change from db: %w", err)
+	}
+
+	return &change, nil
+}
+
+func (r *Rental) GetHistory(ctx context.Context, uid uuid.UUID) ([]models.RentEvent, error) {
+	var events []models.RentEvent
+
+	err := r.db.Table("rental_events").WithContext(ctx).
+		Where("rental_uid = ?", uid).
+		Order("created_at, id").
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("find rental events in db: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r *Rental) HasOverlapping(ctx context.Context, carUID uuid.UUID, from, to time.Time) (bool, error) {
+	var count int64
+
+	err := r.db.Table("rental").WithContext(ctx).
+		Where("car_uid = ? AND status IN ?", carUID, models.ActiveStatuses).
+		Where("date_from < ? AND date_to > ?", to, from).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("count overlapping rentals in db: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// GetOverdue returns rents which are still in progress after their end date.
+func (r *Rental) GetOverdue(ctx context.Context, now time.Time) ([]models.Rent, error) {
+	var rents []models.Rent
+
+	err := r.db.Table("rental").WithContext(ctx).
+		Where("status = ? AND date_to < ?", models.InProgress, now).
+		Find(&rents).Error
+	if err != nil {
+		return nil, fmt.Errorf("find overdue rentals in db: %w", err)
+	}
+
+	return rents, nil
+}
+
+// GetNoShows returns reserved rents which should have been started before the given time.
+func (r *Rental) GetNoShows(ctx context.Context, before time.Time) ([]models.Rent, error) {
+	var rents []models.Rent
+
+	err := r.db.Table("rental").WithContext(ctx).
+		Where("status = ? AND date_from < ?", models.Reserved, before).
+		Find(&rents).Error
+	if err != nil {
+		return nil, fmt.Errorf("find no-show rentals in db: %w", err)
+	}
+
+	return rents, nil
+}
+
+// WithAdvisoryLock runs fn only if the transaction-level advisory lock with the key is acquired.
+// The lock is released when fn returns, so only one replica runs fn at a time.
+func (r *Rental) WithAdvisoryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	var locked bool
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", key).Scan(&locked).Error
+		if err != nil {
+			return fmt.Errorf("try advisory lock: %w", err)
+		}
+
+		if !locked {
+			return nil
+		}
+
+		return fn(ctx)
+	})
+	if err != nil {
+		return locked, fmt.Errorf("transaction: %w", err)
+	}
+
+	return locked, nil
+}